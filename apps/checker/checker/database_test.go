@@ -0,0 +1,352 @@
+package checker_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+)
+
+// listen accepts connections for the lifetime of the test and hands each one
+// to serve on its own goroutine.
+func listen(t *testing.T, serve func(net.Conn)) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				serve(conn)
+			}()
+		}
+	}()
+
+	return ln.Addr().String()
+}
+
+// fakePostgres speaks just enough of the v3 protocol for a cleartext login
+// followed by simple queries, all of which return a single int4 `1`.
+func fakePostgres(password string) func(net.Conn) {
+	return func(conn net.Conn) {
+		be := pgproto3.NewBackend(conn, conn)
+		msg, err := be.ReceiveStartupMessage()
+		if err != nil {
+			return
+		}
+		if _, ok := msg.(*pgproto3.SSLRequest); ok {
+			if _, err := conn.Write([]byte("N")); err != nil {
+				return
+			}
+			if _, err := be.ReceiveStartupMessage(); err != nil {
+				return
+			}
+		}
+
+		be.Send(&pgproto3.AuthenticationCleartextPassword{})
+		if err := be.Flush(); err != nil {
+			return
+		}
+		_ = be.SetAuthType(pgproto3.AuthTypeCleartextPassword)
+		msg, err = be.Receive()
+		if err != nil {
+			return
+		}
+		if pw, ok := msg.(*pgproto3.PasswordMessage); !ok || pw.Password != password {
+			be.Send(&pgproto3.ErrorResponse{Severity: "FATAL", Code: "28P01", Message: "password authentication failed"})
+			_ = be.Flush()
+			return
+		}
+
+		be.Send(&pgproto3.AuthenticationOk{})
+		be.Send(&pgproto3.ParameterStatus{Name: "server_version", Value: "16.0"})
+		be.Send(&pgproto3.ParameterStatus{Name: "standard_conforming_strings", Value: "on"})
+		be.Send(&pgproto3.ParameterStatus{Name: "client_encoding", Value: "UTF8"})
+		be.Send(&pgproto3.BackendKeyData{ProcessID: 1, SecretKey: []byte{0, 0, 0, 2}})
+		be.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'})
+		if err := be.Flush(); err != nil {
+			return
+		}
+
+		for {
+			msg, err := be.Receive()
+			if err != nil {
+				return
+			}
+			switch msg.(type) {
+			case *pgproto3.Query:
+				be.Send(&pgproto3.RowDescription{Fields: []pgproto3.FieldDescription{
+					{Name: []byte("?column?"), DataTypeOID: 23, DataTypeSize: 4, TypeModifier: -1},
+				}})
+				be.Send(&pgproto3.DataRow{Values: [][]byte{[]byte("1")}})
+				be.Send(&pgproto3.CommandComplete{CommandTag: []byte("SELECT 1")})
+				be.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'})
+				if err := be.Flush(); err != nil {
+					return
+				}
+			case *pgproto3.Terminate:
+				return
+			}
+		}
+	}
+}
+
+type mysqlConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+	seq  byte
+}
+
+func (c *mysqlConn) read() ([]byte, error) {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(c.r, header); err != nil {
+		return nil, err
+	}
+	size := int(header[0]) | int(header[1])<<8 | int(header[2])<<16
+	c.seq = header[3] + 1
+	payload := make([]byte, size)
+	_, err := io.ReadFull(c.r, payload)
+	return payload, err
+}
+
+func (c *mysqlConn) write(payload []byte) error {
+	header := []byte{byte(len(payload)), byte(len(payload) >> 8), byte(len(payload) >> 16), c.seq}
+	c.seq++
+	_, err := c.conn.Write(append(header, payload...))
+	return err
+}
+
+func lenencString(s string) []byte {
+	return append([]byte{byte(len(s))}, s...)
+}
+
+var (
+	mysqlOK  = []byte{0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00}
+	mysqlEOF = []byte{0xfe, 0x00, 0x00, 0x02, 0x00}
+)
+
+// fakeMySQL accepts any password for user and answers every COM_QUERY with a
+// single BIGINT column holding `1`.
+func fakeMySQL(user string) func(net.Conn) {
+	return func(conn net.Conn) {
+		c := &mysqlConn{conn: conn, r: bufio.NewReader(conn)}
+
+		const caps = 0x1 | 0x4 | 0x8 | 0x200 | 0x2000 | 0x8000 | 0x20000 | 0x80000
+		var handshake bytes.Buffer
+		handshake.WriteByte(0x0a)
+		handshake.WriteString("8.0.0-fake\x00")
+		_ = binary.Write(&handshake, binary.LittleEndian, uint32(1))
+		handshake.WriteString("abcdefgh\x00")
+		_ = binary.Write(&handshake, binary.LittleEndian, uint16(caps&0xffff))
+		handshake.WriteByte(0x21)
+		_ = binary.Write(&handshake, binary.LittleEndian, uint16(0x0002))
+		_ = binary.Write(&handshake, binary.LittleEndian, uint16(caps>>16))
+		handshake.WriteByte(21)
+		handshake.Write(make([]byte, 10))
+		handshake.WriteString("ijklmnopqrst\x00")
+		handshake.WriteString("mysql_native_password\x00")
+		if err := c.write(handshake.Bytes()); err != nil {
+			return
+		}
+
+		response, err := c.read()
+		if err != nil || len(response) < 33 {
+			return
+		}
+		name, _, _ := strings.Cut(string(response[32:]), "\x00")
+		if name != user {
+			_ = c.write(append([]byte{0xff, 0x15, 0x04, '#'}, "28000Access denied"...))
+			return
+		}
+		if err := c.write(mysqlOK); err != nil {
+			return
+		}
+
+		for {
+			packet, err := c.read()
+			if err != nil || len(packet) == 0 {
+				return
+			}
+			switch packet[0] {
+			case 0x01: // COM_QUIT
+				return
+			case 0x0e: // COM_PING
+				_ = c.write(mysqlOK)
+			case 0x03: // COM_QUERY
+				column := bytes.Join([][]byte{
+					lenencString("def"), lenencString(""), lenencString(""), lenencString(""),
+					lenencString("1"), lenencString(""),
+					{0x0c, 0x3f, 0x00, 0x01, 0x00, 0x00, 0x00, 0x08, 0x81, 0x00, 0x00, 0x00, 0x00},
+				}, nil)
+				for _, p := range [][]byte{{0x01}, column, mysqlEOF, lenencString("1"), mysqlEOF} {
+					if err := c.write(p); err != nil {
+						return
+					}
+				}
+			default:
+				_ = c.write(append([]byte{0xff, 0x17, 0x04, '#'}, "HY000unsupported"...))
+			}
+		}
+	}
+}
+
+// fakeRedis understands HELLO (refused, forcing RESP2), AUTH, SELECT, PING
+// and GET of a single key.
+func fakeRedis(password string) func(net.Conn) {
+	return func(conn net.Conn) {
+		r := bufio.NewReader(conn)
+		for {
+			args, err := readRESP(r)
+			if err != nil {
+				return
+			}
+			var reply string
+			switch strings.ToUpper(args[0]) {
+			case "HELLO":
+				reply = "-ERR unknown command 'HELLO'\r\n"
+			case "AUTH":
+				if args[len(args)-1] == password {
+					reply = "+OK\r\n"
+				} else {
+					reply = "-WRONGPASS invalid username-password pair\r\n"
+				}
+			case "SELECT":
+				reply = "+OK\r\n"
+			case "PING":
+				reply = "+PONG\r\n"
+			case "GET":
+				reply = "$5\r\nhello\r\n"
+			default:
+				reply = fmt.Sprintf("-ERR unknown command '%s'\r\n", args[0])
+			}
+			if _, err := conn.Write([]byte(reply)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func readRESP(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "*") {
+		return nil, fmt.Errorf("unexpected %q", line)
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		if _, err := r.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		arg, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, strings.TrimSuffix(arg, "\r\n"))
+	}
+	return args, nil
+}
+
+func assertPhases(t *testing.T, timing checker.TCPResponseTiming) {
+	t.Helper()
+	require.Len(t, timing.Phases, 3)
+	for i, name := range []string{"connect", "auth", "query"} {
+		assert.Equal(t, name, timing.Phases[i].Name)
+		assert.LessOrEqual(t, timing.Phases[i].Start, timing.Phases[i].Done)
+	}
+	assert.LessOrEqual(t, timing.TCPStart, timing.Phases[0].Start)
+	assert.GreaterOrEqual(t, timing.TCPDone, timing.Phases[2].Done)
+}
+
+func TestPingDatabase(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("postgres", func(t *testing.T) {
+		addr := listen(t, fakePostgres("secret"))
+
+		res, err := checker.PingDatabase(ctx, checker.DatabasePostgres, "postgres://monitor:secret@"+addr+"/app?sslmode=disable", "", time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), res.RowCount)
+		assert.Equal(t, "1", res.Value)
+		assertPhases(t, res.Timing)
+
+		_, err = checker.PingDatabase(ctx, checker.DatabasePostgres, "postgres://monitor:wrong@"+addr+"/app?sslmode=disable", "", time.Second)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "password authentication failed")
+	})
+
+	t.Run("mysql", func(t *testing.T) {
+		addr := listen(t, fakeMySQL("monitor"))
+
+		res, err := checker.PingDatabase(ctx, checker.DatabaseMySQL, "mysql://monitor:secret@"+addr+"/app", "SELECT 1", time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), res.RowCount)
+		assert.Equal(t, "1", res.Value)
+		assertPhases(t, res.Timing)
+
+		_, err = checker.PingDatabase(ctx, checker.DatabaseMySQL, "mysql://intruder:secret@"+addr+"/app", "", time.Second)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Access denied")
+	})
+
+	t.Run("redis", func(t *testing.T) {
+		addr := listen(t, fakeRedis("secret"))
+
+		res, err := checker.PingDatabase(ctx, checker.DatabaseRedis, "redis://:secret@"+addr+"/0", "", time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, "PONG", res.Value)
+		assertPhases(t, res.Timing)
+
+		res, err = checker.PingDatabase(ctx, checker.DatabaseRedis, "redis://:secret@"+addr+"/0", "GET greeting", time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", res.Value)
+
+		_, err = checker.PingDatabase(ctx, checker.DatabaseRedis, "redis://:wrong@"+addr+"/0", "", time.Second)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "WRONGPASS")
+	})
+
+	t.Run("connection refused", func(t *testing.T) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := ln.Addr().String()
+		ln.Close()
+
+		_, err = checker.PingDatabase(ctx, checker.DatabasePostgres, "postgres://monitor:secret@"+addr+"/app?sslmode=disable", "", time.Second)
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown engine", func(t *testing.T) {
+		_, err := checker.PingDatabase(ctx, "oracle", "oracle://db", "", time.Second)
+		assert.Error(t, err)
+	})
+}
+
+func TestRedactURI(t *testing.T) {
+	assert.Equal(t, "postgres://monitor:xxxxx@db:5432/app", checker.RedactURI("postgres://monitor:secret@db:5432/app"))
+	assert.Equal(t, "redis://db:6379", checker.RedactURI("redis://db:6379"))
+}
@@ -0,0 +1,206 @@
+package checker
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// DiagnosisTimeout bounds a whole diagnostics pass.
+const DiagnosisTimeout = 5 * time.Second
+
+// At most this many resolved addresses are probed.
+const maxDiagnosedAddresses = 8
+
+// Connect outcomes of an AddressDiagnosis.
+const (
+	ConnectOK          = "ok"
+	ConnectRefused     = "refused"
+	ConnectTimeout     = "timeout"
+	ConnectUnreachable = "unreachable"
+	ConnectError       = "error"
+)
+
+type AddressDiagnosis struct {
+	IP        string `json:"ip"`
+	Connect   string `json:"connect"`
+	ConnectMs int64  `json:"connectMs,omitempty"`
+	// TLS is "ok" or the handshake error; empty for plain HTTP or when the
+	// connection failed.
+	TLS   string `json:"tls,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// Diagnosis breaks a failed HTTP check down into DNS, TCP and TLS so the
+// failing layer is visible at a glance.
+type Diagnosis struct {
+	Host string `json:"host"`
+	// DNS is "ok", "skipped" for IP literals, or the resolver error.
+	DNS       string             `json:"dns"`
+	Addresses []AddressDiagnosis `json:"addresses,omitempty"`
+	Summary   string             `json:"summary"`
+}
+
+func classifyConnectError(err error) string {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return ConnectRefused
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return ConnectTimeout
+	case errors.Is(err, syscall.EHOSTUNREACH), errors.Is(err, syscall.ENETUNREACH):
+		return ConnectUnreachable
+	default:
+		return ConnectError
+	}
+}
+
+// Diagnose resolves the host of rawURL on its own, then connects to every
+// address and, for https, completes a TLS handshake on each. It is meant to
+// run once a check has failed, so it never returns an error: every failure
+// is part of the diagnosis.
+func Diagnose(ctx context.Context, rawURL string, timeout time.Duration) Diagnosis {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Hostname() == "" {
+		return Diagnosis{DNS: "skipped", Summary: "invalid URL"}
+	}
+	host := u.Hostname()
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	d := Diagnosis{Host: host}
+
+	var ips []string
+	if ip := net.ParseIP(host); ip != nil {
+		d.DNS = "skipped"
+		ips = []string{ip.String()}
+	} else {
+		addrs, err := resolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			d.DNS = err.Error()
+			d.Summary = "DNS failed: " + err.Error()
+			return d
+		}
+		d.DNS = "ok"
+		for _, addr := range addrs {
+			ips = append(ips, addr.IP.String())
+		}
+	}
+	if len(ips) > maxDiagnosedAddresses {
+		ips = ips[:maxDiagnosedAddresses]
+	}
+
+	d.Addresses = make([]AddressDiagnosis, len(ips))
+	var wg sync.WaitGroup
+	for i, ip := range ips {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.Addresses[i] = diagnoseAddress(ctx, host, ip, port, u.Scheme == "https")
+		}()
+	}
+	wg.Wait()
+
+	d.Summary = d.summary()
+	return d
+}
+
+func diagnoseAddress(ctx context.Context, host, ip, port string, useTLS bool) AddressDiagnosis {
+	res := AddressDiagnosis{IP: ip}
+
+	start := time.Now()
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", net.JoinHostPort(ip, port))
+	if err != nil {
+		res.Connect = classifyConnectError(err)
+		res.Error = err.Error()
+		return res
+	}
+	defer conn.Close()
+	res.Connect = ConnectOK
+	res.ConnectMs = time.Since(start).Milliseconds()
+
+	if useTLS {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: host})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			res.TLS = err.Error()
+		} else {
+			res.TLS = "ok"
+		}
+	}
+	return res
+}
+
+func plural(n int, word string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, word)
+	}
+	return fmt.Sprintf("%d %ss", n, word)
+}
+
+// summary renders the diagnosis as one line, e.g. "DNS ok (3 IPs), 2/3 IPs
+// refuse connections, TLS ok on 1/1 reachable IPs".
+func (d Diagnosis) summary() string {
+	var parts []string
+	switch d.DNS {
+	case "ok":
+		parts = append(parts, fmt.Sprintf("DNS ok (%s)", plural(len(d.Addresses), "IP")))
+	case "skipped":
+		parts = append(parts, "DNS skipped")
+	}
+
+	total := len(d.Addresses)
+	counts := map[string]int{}
+	var tlsChecked, tlsOK int
+	var tlsErr string
+	for _, a := range d.Addresses {
+		counts[a.Connect]++
+		if a.TLS == "" {
+			continue
+		}
+		tlsChecked++
+		if a.TLS == "ok" {
+			tlsOK++
+		} else if tlsErr == "" {
+			tlsErr = a.TLS
+		}
+	}
+
+	if counts[ConnectOK] == total {
+		parts = append(parts, "TCP ok on "+plural(total, "IP"))
+	}
+	for _, outcome := range []struct{ connect, verb string }{
+		{ConnectRefused, "refuse connections"},
+		{ConnectTimeout, "time out"},
+		{ConnectUnreachable, "are unreachable"},
+		{ConnectError, "fail to connect"},
+	} {
+		if n := counts[outcome.connect]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d/%d IPs %s", n, total, outcome.verb))
+		}
+	}
+
+	switch {
+	case tlsChecked == 0:
+	case tlsOK == tlsChecked:
+		parts = append(parts, fmt.Sprintf("TLS ok on %d/%d reachable IPs", tlsOK, tlsChecked))
+	default:
+		parts = append(parts, fmt.Sprintf("TLS failed on %d/%d reachable IPs: %s", tlsChecked-tlsOK, tlsChecked, tlsErr))
+	}
+
+	return strings.Join(parts, ", ")
+}
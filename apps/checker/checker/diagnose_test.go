@@ -0,0 +1,66 @@
+package checker_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+)
+
+// closedPort returns a loopback port nothing listens on.
+func closedPort(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	_, port, _ := net.SplitHostPort(ln.Addr().String())
+	ln.Close()
+	return port
+}
+
+func TestDiagnose(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("refused connection", func(t *testing.T) {
+		d := checker.Diagnose(ctx, "http://127.0.0.1:"+closedPort(t)+"/health", time.Second)
+		assert.Equal(t, "skipped", d.DNS)
+		require.Len(t, d.Addresses, 1)
+		assert.Equal(t, checker.ConnectRefused, d.Addresses[0].Connect)
+		assert.Equal(t, "DNS skipped, 1/1 IPs refuse connections", d.Summary)
+	})
+
+	t.Run("untrusted certificate", func(t *testing.T) {
+		srv := httptest.NewTLSServer(http.NotFoundHandler())
+		t.Cleanup(srv.Close)
+
+		d := checker.Diagnose(ctx, srv.URL, time.Second)
+		require.Len(t, d.Addresses, 1)
+		assert.Equal(t, checker.ConnectOK, d.Addresses[0].Connect)
+		assert.Contains(t, d.Addresses[0].TLS, "certificate")
+		assert.True(t, strings.HasPrefix(d.Summary, "DNS skipped, TCP ok on 1 IP, TLS failed on 1/1 reachable IPs: "), d.Summary)
+	})
+
+	t.Run("plain http skips tls", func(t *testing.T) {
+		srv := httptest.NewServer(http.NotFoundHandler())
+		t.Cleanup(srv.Close)
+
+		d := checker.Diagnose(ctx, srv.URL, time.Second)
+		require.Len(t, d.Addresses, 1)
+		assert.Empty(t, d.Addresses[0].TLS)
+		assert.Equal(t, "DNS skipped, TCP ok on 1 IP", d.Summary)
+	})
+
+	t.Run("resolver failure", func(t *testing.T) {
+		useBlackholeResolver(t)
+
+		d := checker.Diagnose(ctx, "https://openstatus.dev", 250*time.Millisecond)
+		assert.Empty(t, d.Addresses)
+		assert.True(t, strings.HasPrefix(d.Summary, "DNS failed: "), d.Summary)
+	})
+}
@@ -0,0 +1,137 @@
+package checker
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"time"
+)
+
+// ErrorCode is the cause of a failed check. Unlike the free-text message it
+// is stable, so results can be aggregated and alerted on by cause.
+type ErrorCode string
+
+const (
+	ErrorDNSNXDomain       ErrorCode = "dns_nxdomain"
+	ErrorDNSTimeout        ErrorCode = "dns_timeout"
+	ErrorDNS               ErrorCode = "dns_error"
+	ErrorConnectionRefused ErrorCode = "connection_refused"
+	ErrorConnectionReset   ErrorCode = "connection_reset"
+	ErrorHostUnreachable   ErrorCode = "host_unreachable"
+	ErrorTLSHandshake      ErrorCode = "tls_handshake"
+	ErrorTLSCertInvalid    ErrorCode = "tls_cert_invalid"
+	ErrorTimeoutConnect    ErrorCode = "timeout_connect"
+	ErrorTimeoutRead       ErrorCode = "timeout_read"
+	// ErrorTimeout is a timeout whose phase is unknown.
+	ErrorTimeout         ErrorCode = "timeout"
+	ErrorAuthFailed      ErrorCode = "auth_failed"
+	ErrorHTTPStatus      ErrorCode = "http_status"
+	ErrorAssertionFailed ErrorCode = "assertion_failed"
+	ErrorUnknown         ErrorCode = "unknown"
+)
+
+// CheckError keeps the short message a check reports while carrying the
+// cause it was derived from, so it can still be classified.
+type CheckError struct {
+	Msg string
+	Err error
+}
+
+func (e *CheckError) Error() string { return e.Msg }
+
+func (e *CheckError) Unwrap() error { return e.Err }
+
+// ErrAuthFailed is wrapped by the checks when the server rejects the
+// credentials.
+var ErrAuthFailed = errors.New("authentication failed")
+
+// timeoutError reports a timeout of the whole check, keeping its cause.
+func timeoutError(err error, timeout time.Duration) error {
+	return &CheckError{Msg: fmt.Sprintf("timeout after %d ms", timeout.Milliseconds()), Err: err}
+}
+
+// dialError reports a failed connection with the short messages of
+// PingTCP, keeping its cause.
+func dialError(err error, timeout time.Duration) error {
+	switch Classify(err) {
+	case ErrorTimeoutConnect, ErrorTimeout:
+		return timeoutError(err, timeout)
+	case ErrorConnectionRefused:
+		return &CheckError{Msg: "connection refused", Err: err}
+	}
+	return fmt.Errorf("dial error: %w", err)
+}
+
+// Classify maps an error to its ErrorCode from the typed errors in its
+// chain; nil yields the empty code.
+func Classify(err error) ErrorCode {
+	if err == nil {
+		return ""
+	}
+
+	if errors.Is(err, ErrAuthFailed) || errors.Is(err, ErrHostKeyMismatch) {
+		return ErrorAuthFailed
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		switch {
+		case dnsErr.IsNotFound:
+			return ErrorDNSNXDomain
+		case dnsErr.IsTimeout:
+			return ErrorDNSTimeout
+		default:
+			return ErrorDNS
+		}
+	}
+
+	var certErr *tls.CertificateVerificationError
+	var unknownAuthority x509.UnknownAuthorityError
+	var invalidCert x509.CertificateInvalidError
+	var hostnameErr x509.HostnameError
+	if errors.As(err, &certErr) || errors.As(err, &unknownAuthority) ||
+		errors.As(err, &invalidCert) || errors.As(err, &hostnameErr) {
+		return ErrorTLSCertInvalid
+	}
+	var recordErr tls.RecordHeaderError
+	var alertErr tls.AlertError
+	if errors.As(err, &recordErr) || errors.As(err, &alertErr) {
+		return ErrorTLSHandshake
+	}
+
+	switch {
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return ErrorConnectionRefused
+	case errors.Is(err, syscall.ECONNRESET), errors.Is(err, syscall.EPIPE),
+		errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return ErrorConnectionReset
+	case errors.Is(err, syscall.EHOSTUNREACH), errors.Is(err, syscall.ENETUNREACH):
+		return ErrorHostUnreachable
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		// TLS alerts sent by the server surface as a "remote error".
+		if opErr.Op == "remote error" {
+			return ErrorTLSHandshake
+		}
+		if opErr.Timeout() {
+			if opErr.Op == "dial" {
+				return ErrorTimeoutConnect
+			}
+			return ErrorTimeoutRead
+		}
+	}
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return ErrorTimeout
+	}
+
+	return ErrorUnknown
+}
@@ -0,0 +1,134 @@
+package checker_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/request"
+)
+
+func TestClassify(t *testing.T) {
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}
+
+	tests := []struct {
+		name string
+		err  error
+		want checker.ErrorCode
+	}{
+		{"nil", nil, ""},
+		{"nxdomain", &net.DNSError{Err: "no such host", Name: "nope.invalid", IsNotFound: true}, checker.ErrorDNSNXDomain},
+		{"dns timeout", &net.DNSError{Err: "i/o timeout", Name: "slow.example", IsTimeout: true}, checker.ErrorDNSTimeout},
+		{"dns server failure", &net.DNSError{Err: "server misbehaving", Name: "broken.example"}, checker.ErrorDNS},
+		{"refused", refused, checker.ErrorConnectionRefused},
+		{"wrapped refused", fmt.Errorf("unable to check tcp %w", refused), checker.ErrorConnectionRefused},
+		{"message kept by CheckError", &checker.CheckError{Msg: "connection refused", Err: refused}, checker.ErrorConnectionRefused},
+		{"reset", &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}, checker.ErrorConnectionReset},
+		{"unreachable", &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.EHOSTUNREACH)}, checker.ErrorHostUnreachable},
+		{"deadline", context.DeadlineExceeded, checker.ErrorTimeout},
+		{"rejected credentials", fmt.Errorf("%w: 535 5.7.8", checker.ErrAuthFailed), checker.ErrorAuthFailed},
+		{"changed host key", fmt.Errorf("%w: expected a, got b", checker.ErrHostKeyMismatch), checker.ErrorAuthFailed},
+		{"unclassified", errors.New("boom"), checker.ErrorUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, checker.Classify(tt.err))
+		})
+	}
+}
+
+func TestClassify_Checks(t *testing.T) {
+	t.Run("a closed port is refused", func(t *testing.T) {
+		_, err := checker.PingTCP(1000, "127.0.0.1:"+closedPort(t))
+		require.Error(t, err)
+		assert.Equal(t, "connection refused", err.Error())
+		assert.Equal(t, checker.ErrorConnectionRefused, checker.Classify(err))
+	})
+
+	t.Run("an untrusted certificate is invalid", func(t *testing.T) {
+		srv := httptest.NewTLSServer(http.NotFoundHandler())
+		t.Cleanup(srv.Close)
+
+		res, err := checker.Http(t.Context(), &http.Client{Timeout: time.Second}, request.HttpCheckerRequest{Method: http.MethodGet, URL: srv.URL})
+		require.NoError(t, err)
+		assert.Equal(t, checker.ErrorTLSCertInvalid, res.ErrorCode)
+	})
+
+	t.Run("a slow response times out while reading", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(300 * time.Millisecond)
+		}))
+		t.Cleanup(srv.Close)
+
+		res, err := checker.Http(t.Context(), &http.Client{Timeout: 50 * time.Millisecond}, request.HttpCheckerRequest{Method: http.MethodGet, URL: srv.URL})
+		require.NoError(t, err)
+		assert.Equal(t, checker.ErrorTimeoutRead, res.ErrorCode)
+	})
+
+	// silent accepts connections and never answers.
+	silent := func(conn net.Conn) { io.Copy(io.Discard, conn) }
+
+	t.Run("ssh failures are classified", func(t *testing.T) {
+		_, err := checker.PingSSH(t.Context(), "127.0.0.1:"+closedPort(t), checker.SSHOptions{}, time.Second)
+		require.Error(t, err)
+		assert.Equal(t, "connection refused", err.Error())
+		assert.Equal(t, checker.ErrorConnectionRefused, checker.Classify(err))
+
+		_, err = checker.PingSSH(t.Context(), listen(t, silent), checker.SSHOptions{}, 100*time.Millisecond)
+		require.Error(t, err)
+		assert.Equal(t, "timeout after 100 ms", err.Error())
+		assert.Equal(t, checker.ErrorTimeoutRead, checker.Classify(err))
+
+		privateKey, publicKey := newClientKey(t)
+		addr, _ := fakeSSH(t, publicKey)
+		_, err = checker.PingSSH(t.Context(), addr, checker.SSHOptions{HostKeyFingerprint: "SHA256:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"}, 5*time.Second)
+		assert.Equal(t, checker.ErrorAuthFailed, checker.Classify(err))
+
+		otherKey, _ := newClientKey(t)
+		_, err = checker.PingSSH(t.Context(), addr, checker.SSHOptions{Username: "probe", PrivateKey: otherKey}, 5*time.Second)
+		assert.Equal(t, checker.ErrorAuthFailed, checker.Classify(err))
+		_, err = checker.PingSSH(t.Context(), addr, checker.SSHOptions{Username: "probe", PrivateKey: privateKey}, 5*time.Second)
+		assert.NoError(t, err)
+	})
+
+	t.Run("mail failures are classified", func(t *testing.T) {
+		_, err := checker.PingMail(t.Context(), checker.MailSMTP, "127.0.0.1:"+closedPort(t), checker.MailOptions{}, time.Second)
+		require.Error(t, err)
+		assert.Equal(t, "connection refused", err.Error())
+		assert.Equal(t, checker.ErrorConnectionRefused, checker.Classify(err))
+
+		_, err = checker.PingMail(t.Context(), checker.MailSMTP, listen(t, silent), checker.MailOptions{}, 100*time.Millisecond)
+		require.Error(t, err)
+		assert.Equal(t, "timeout after 100 ms", err.Error())
+		assert.Equal(t, checker.ErrorTimeoutRead, checker.Classify(err))
+
+		serverTLS, clientTLS := selfSigned(t)
+		_, err = checker.PingMail(t.Context(), checker.MailSMTP, listen(t, fakeSMTP(serverTLS, "220 mail.test ESMTP")), checker.MailOptions{
+			StartTLS:  true,
+			Username:  "probe",
+			Password:  "wrong",
+			TLSConfig: clientTLS,
+		}, 5*time.Second)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "authentication failed")
+		assert.Equal(t, checker.ErrorAuthFailed, checker.Classify(err))
+	})
+
+	t.Run("a non 2xx status is an http_status failure", func(t *testing.T) {
+		assert.Equal(t, checker.ErrorHTTPStatus, checker.HTTPErrorCode(checker.Response{Status: 503}))
+		assert.Equal(t, checker.ErrorAssertionFailed, checker.HTTPErrorCode(checker.Response{Status: 200}))
+	})
+}
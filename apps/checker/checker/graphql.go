@@ -0,0 +1,86 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/openstatushq/openstatus/apps/checker/request"
+)
+
+type GraphQLError struct {
+	Message string `json:"message"`
+	Path    []any  `json:"path,omitempty"`
+}
+
+type GraphQLResponse struct {
+	Response
+	Data   json.RawMessage `json:"data,omitempty"`
+	Errors []GraphQLError  `json:"errors,omitempty"`
+}
+
+type graphQLPayload struct {
+	Query         string          `json:"query"`
+	Variables     json.RawMessage `json:"variables,omitempty"`
+	OperationName string          `json:"operationName,omitempty"`
+}
+
+// GraphQL sends the operation as a JSON POST through AuthorizedHttp and
+// decodes the GraphQL envelope. A body that is not a GraphQL response leaves
+// Data and Errors empty, so the HTTP assertions still decide the outcome.
+func GraphQL(ctx context.Context, client *http.Client, inputData request.GraphQLCheckerRequest, tokens OAuth2Tokens) (GraphQLResponse, error) {
+	payload, err := json.Marshal(graphQLPayload{
+		Query:         inputData.Query,
+		Variables:     inputData.Variables,
+		OperationName: inputData.OperationName,
+	})
+	if err != nil {
+		return GraphQLResponse{}, fmt.Errorf("unable to encode graphql payload: %w", err)
+	}
+
+	req := inputData.HttpCheckerRequest
+	req.Method = http.MethodPost
+	req.Body = string(payload)
+	// Defaults go first so a monitor's own headers can still override them.
+	req.Headers = append([]struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}{
+		{Key: "Content-Type", Value: "application/json"},
+		{Key: "Accept", Value: "application/graphql-response+json, application/json"},
+	}, inputData.Headers...)
+
+	res, err := AuthorizedHttp(ctx, client, req, tokens)
+	if err != nil {
+		return GraphQLResponse{Response: res}, err
+	}
+
+	result := GraphQLResponse{Response: res}
+	if res.Error != "" || res.Body == "" {
+		return result, nil
+	}
+
+	var envelope struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []GraphQLError  `json:"errors"`
+	}
+	if err := json.Unmarshal([]byte(res.Body), &envelope); err == nil {
+		result.Data = envelope.Data
+		result.Errors = envelope.Errors
+	}
+
+	return result, nil
+}
+
+// ErrorMessage summarises the errors array for the check's failure message.
+func (r GraphQLResponse) ErrorMessage() string {
+	switch len(r.Errors) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("GraphQL error: %s", r.Errors[0].Message)
+	default:
+		return fmt.Sprintf("GraphQL error: %s (and %d more)", r.Errors[0].Message, len(r.Errors)-1)
+	}
+}
@@ -0,0 +1,124 @@
+package checker_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/request"
+)
+
+func TestGraphQL(t *testing.T) {
+	t.Run("sends the operation as a JSON POST", func(t *testing.T) {
+		client := NewTestClient(func(req *http.Request) *http.Response {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+			assert.Equal(t, "secret", req.Header.Get("X-Api-Key"))
+
+			var payload map[string]json.RawMessage
+			body, _ := io.ReadAll(req.Body)
+			require.NoError(t, json.Unmarshal(body, &payload))
+			assert.JSONEq(t, `"query Me($id: ID!) { user(id: $id) { name } }"`, string(payload["query"]))
+			assert.JSONEq(t, `{"id":"1"}`, string(payload["variables"]))
+			assert.JSONEq(t, `"Me"`, string(payload["operationName"]))
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"data":{"user":{"name":"openstatus"}}}`)),
+				Header:     make(http.Header),
+			}
+		})
+
+		req := request.GraphQLCheckerRequest{
+			HttpCheckerRequest: request.HttpCheckerRequest{URL: "https://api.openstatus.dev/graphql", Method: http.MethodGet},
+			Query:              "query Me($id: ID!) { user(id: $id) { name } }",
+			Variables:          json.RawMessage(`{"id":"1"}`),
+			OperationName:      "Me",
+		}
+		req.Headers = append(req.Headers, struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		}{Key: "X-Api-Key", Value: "secret"})
+
+		res, err := checker.GraphQL(context.Background(), client, req, checker.OAuth2Fetcher{})
+		require.NoError(t, err)
+		assert.Equal(t, 200, res.Status)
+		assert.JSONEq(t, `{"user":{"name":"openstatus"}}`, string(res.Data))
+		assert.Empty(t, res.Errors)
+		assert.Empty(t, res.ErrorMessage())
+	})
+
+	t.Run("decodes the errors array of a 200 response", func(t *testing.T) {
+		client := NewTestClient(func(req *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"data":null,"errors":[{"message":"not authorised","path":["user"]},{"message":"boom"}]}`)),
+				Header:     make(http.Header),
+			}
+		})
+
+		res, err := checker.GraphQL(context.Background(), client, request.GraphQLCheckerRequest{
+			HttpCheckerRequest: request.HttpCheckerRequest{URL: "https://api.openstatus.dev/graphql"},
+			Query:              "{ user { name } }",
+		}, checker.OAuth2Fetcher{})
+		require.NoError(t, err)
+		require.Len(t, res.Errors, 2)
+		assert.Equal(t, "not authorised", res.Errors[0].Message)
+		assert.Equal(t, "GraphQL error: not authorised (and 1 more)", res.ErrorMessage())
+	})
+
+	t.Run("tolerates a body that is not a GraphQL response", func(t *testing.T) {
+		client := NewTestClient(func(req *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusBadGateway,
+				Body:       io.NopCloser(bytes.NewBufferString(`<html>bad gateway</html>`)),
+				Header:     make(http.Header),
+			}
+		})
+
+		res, err := checker.GraphQL(context.Background(), client, request.GraphQLCheckerRequest{
+			HttpCheckerRequest: request.HttpCheckerRequest{URL: "https://api.openstatus.dev/graphql"},
+			Query:              "{ user { name } }",
+		}, checker.OAuth2Fetcher{})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadGateway, res.Status)
+		assert.Nil(t, res.Data)
+		assert.Empty(t, res.Errors)
+	})
+
+	t.Run("authenticates with OAuth2 like HTTP monitors", func(t *testing.T) {
+		tokenURL, _ := identityProvider(t, 3600)
+		api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"data":{"authorization":%q}}`, r.Header.Get("Authorization"))
+		}))
+		t.Cleanup(api.Close)
+
+		req := request.GraphQLCheckerRequest{
+			HttpCheckerRequest: request.HttpCheckerRequest{
+				URL: api.URL,
+				Auth: &request.HTTPAuth{OAuth2: &request.OAuth2ClientCredentials{
+					TokenURL:     tokenURL,
+					ClientID:     "probe",
+					ClientSecret: "s3cret",
+					Scopes:       []string{"read:health"},
+					Audience:     "https://api.example.com",
+				}},
+			},
+			Query: "{ authorization }",
+		}
+
+		res, err := checker.GraphQL(context.Background(), &http.Client{Timeout: time.Second}, req, checker.OAuth2Fetcher{})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"authorization":"Bearer token-1"}`, string(res.Data))
+	})
+}
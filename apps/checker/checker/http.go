@@ -3,6 +3,7 @@ package checker
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"crypto/tls"
 	"encoding/base64"
 	"errors"
@@ -16,6 +17,8 @@ import (
 
 	"github.com/openstatushq/openstatus/apps/checker/request"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Timing struct {
@@ -41,6 +44,68 @@ type Response struct {
 	Timestamp int64             `json:"timestamp"`
 	Status    int               `json:"status,omitempty"`
 	Timing    Timing            `json:"timing"`
+	// Diagnosis is only set on a failed check that got no response.
+	Diagnosis *Diagnosis `json:"diagnosis,omitempty"`
+	// ErrorCode classifies Error.
+	ErrorCode ErrorCode `json:"errorCode,omitempty"`
+	// TraceID and SpanID are the W3C trace context sent in traceparent.
+	TraceID string `json:"traceId,omitempty"`
+	SpanID  string `json:"spanId,omitempty"`
+}
+
+// HTTPErrorCode classifies a failed HTTP check: the transport error when
+// there was no response, otherwise the status code or the assertions.
+func HTTPErrorCode(res Response) ErrorCode {
+	switch {
+	case res.ErrorCode != "":
+		return res.ErrorCode
+	case res.Status < 200 || res.Status >= 300:
+		return ErrorHTTPStatus
+	default:
+		return ErrorAssertionFailed
+	}
+}
+
+// httpProgress records which phases of a request completed, to tell where a
+// client timeout struck.
+type httpProgress struct {
+	resolving, resolved, connected, handshaking, handshaked bool
+}
+
+// classify is Classify with timeouts attributed to the phase they struck in.
+func (p httpProgress) classify(err error) ErrorCode {
+	code := Classify(err)
+	if code != ErrorTimeout {
+		return code
+	}
+	switch {
+	case p.resolving && !p.resolved:
+		return ErrorDNSTimeout
+	case !p.connected:
+		return ErrorTimeoutConnect
+	case p.handshaking && !p.handshaked:
+		return ErrorTLSHandshake
+	default:
+		return ErrorTimeoutRead
+	}
+}
+
+// newSpanContext returns a sampled span context with a random span ID. It
+// joins the trace of parent when there is one, and starts a new trace
+// otherwise.
+func newSpanContext(parent trace.SpanContext) trace.SpanContext {
+	traceID := parent.TraceID()
+	if !parent.IsValid() {
+		_, _ = rand.Read(traceID[:])
+	}
+	var spanID trace.SpanID
+	_, _ = rand.Read(spanID[:])
+
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
 }
 
 // decodeBase64Body decodes a data URL base64 body if needed
@@ -52,6 +117,8 @@ func decodeBase64Body(body string) ([]byte, error) {
 	return nil, fmt.Errorf("invalid base64 data url format")
 }
 
+// Http sends the request as it is: callers render its template expressions
+// with RenderRequest first.
 // FIXME: This should only return the TCP Timing Data;
 func Http(ctx context.Context, client *http.Client, inputData request.HttpCheckerRequest) (Response, error) {
 	logger := log.Ctx(ctx).With().Str("monitor", inputData.URL).Logger()
@@ -96,15 +163,46 @@ func Http(ctx context.Context, client *http.Client, inputData request.HttpChecke
 		req.Header.Set("Content-Type", "application/json")
 	}
 
+	// Every attempt gets its own span, so a failed check links to the backend
+	// trace of exactly that request. Attempts share the trace of the check
+	// when ctx carries one, and start their own otherwise. A tracestate or
+	// baggage header configured on the monitor is sent along unchanged.
+	spanContext := newSpanContext(trace.SpanContextFromContext(ctx))
+	propagation.TraceContext{}.Inject(trace.ContextWithSpanContext(ctx, spanContext), propagation.HeaderCarrier(req.Header))
+	traceID, spanID := spanContext.TraceID().String(), spanContext.SpanID().String()
+
+	// Signing goes last: the signature covers the final headers and body.
+	if signer := NewRequestSigner(inputData.Auth); signer != nil {
+		if err := signer.Sign(req, bodyBytes, time.Now()); err != nil {
+			return Response{}, fmt.Errorf("unable to sign request: %w", err)
+		}
+	}
+
 	timing := Timing{}
+	var progress httpProgress
 
 	trace := &httptrace.ClientTrace{
-		DNSStart:          func(_ httptrace.DNSStartInfo) { timing.DnsStart = time.Now().UTC().UnixMilli() },
-		DNSDone:           func(_ httptrace.DNSDoneInfo) { timing.DnsDone = time.Now().UTC().UnixMilli() },
-		ConnectStart:      func(_, _ string) { timing.ConnectStart = time.Now().UTC().UnixMilli() },
-		ConnectDone:       func(_, _ string, _ error) { timing.ConnectDone = time.Now().UTC().UnixMilli() },
-		TLSHandshakeStart: func() { timing.TlsHandshakeStart = time.Now().UTC().UnixMilli() },
-		TLSHandshakeDone:  func(_ tls.ConnectionState, _ error) { timing.TlsHandshakeDone = time.Now().UTC().UnixMilli() },
+		DNSStart: func(_ httptrace.DNSStartInfo) {
+			timing.DnsStart = time.Now().UTC().UnixMilli()
+			progress.resolving = true
+		},
+		DNSDone: func(info httptrace.DNSDoneInfo) {
+			timing.DnsDone = time.Now().UTC().UnixMilli()
+			progress.resolved = info.Err == nil
+		},
+		ConnectStart: func(_, _ string) { timing.ConnectStart = time.Now().UTC().UnixMilli() },
+		ConnectDone: func(_, _ string, err error) {
+			timing.ConnectDone = time.Now().UTC().UnixMilli()
+			progress.connected = progress.connected || err == nil
+		},
+		TLSHandshakeStart: func() {
+			timing.TlsHandshakeStart = time.Now().UTC().UnixMilli()
+			progress.handshaking = true
+		},
+		TLSHandshakeDone: func(_ tls.ConnectionState, err error) {
+			timing.TlsHandshakeDone = time.Now().UTC().UnixMilli()
+			progress.handshaked = err == nil
+		},
 		GotConn: func(_ httptrace.GotConnInfo) {
 			timing.FirstByteStart = time.Now().UTC().UnixMilli()
 		},
@@ -139,6 +237,9 @@ func Http(ctx context.Context, client *http.Client, inputData request.HttpChecke
 			Timing:    timing,
 			Timestamp: start.UTC().UnixMilli(),
 			Error:     errorMsg,
+			ErrorCode: progress.classify(err),
+			TraceID:   traceID,
+			SpanID:    spanID,
 			Status:    0,
 		}, nil
 	}
@@ -155,6 +256,9 @@ func Http(ctx context.Context, client *http.Client, inputData request.HttpChecke
 			Timing:    timing,
 			Timestamp: start.UTC().UnixMilli(),
 			Error:     fmt.Sprintf("Cannot read response body: %s", err.Error()),
+			ErrorCode: progress.classify(err),
+			TraceID:   traceID,
+			SpanID:    spanID,
 		}, err
 	}
 
@@ -170,6 +274,8 @@ func Http(ctx context.Context, client *http.Client, inputData request.HttpChecke
 		Timing:    timing,
 		Latency:   latency,
 		Body:      string(body),
+		TraceID:   traceID,
+		SpanID:    spanID,
 	}, nil
 
 }
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/openstatushq/openstatus/apps/checker/checker"
 	"github.com/openstatushq/openstatus/apps/checker/request"
@@ -99,3 +100,44 @@ func Test_ping(t *testing.T) {
 		})
 	}
 }
+
+func TestHttp_TraceContext(t *testing.T) {
+	var traceparents []string
+	var tracestate string
+	client := NewTestClient(func(req *http.Request) *http.Response {
+		traceparents = append(traceparents, req.Header.Get("traceparent"))
+		tracestate = req.Header.Get("tracestate")
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBufferString("OK")), Header: make(http.Header)}
+	})
+
+	req := request.HttpCheckerRequest{URL: "https://openstat.us", CronTimestamp: 1}
+	req.Headers = append(req.Headers, struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}{Key: "tracestate", Value: "vendor=openstatus"})
+
+	first, err := checker.Http(context.Background(), client, req)
+	assert.NoError(t, err)
+	second, err := checker.Http(context.Background(), client, req)
+	assert.NoError(t, err)
+
+	assert.Len(t, first.TraceID, 32)
+	assert.Len(t, first.SpanID, 16)
+	assert.Equal(t, "00-"+first.TraceID+"-"+first.SpanID+"-01", traceparents[0])
+	assert.Equal(t, "00-"+second.TraceID+"-"+second.SpanID+"-01", traceparents[1])
+	assert.NotEqual(t, first.TraceID, second.TraceID)
+	assert.Equal(t, "vendor=openstatus", tracestate)
+
+	t.Run("attempts join the trace of the check", func(t *testing.T) {
+		check := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{0x4b, 0xf9, 0x2f, 0x35},
+			SpanID:  trace.SpanID{0x00, 0xf0, 0x67, 0xaa},
+		})
+		ctx := trace.ContextWithSpanContext(context.Background(), check)
+
+		res, err := checker.Http(ctx, client, req)
+		assert.NoError(t, err)
+		assert.Equal(t, check.TraceID().String(), res.TraceID)
+		assert.NotEqual(t, check.SpanID().String(), res.SpanID)
+	})
+}
@@ -0,0 +1,409 @@
+package checker
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/textproto"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	MailSMTP = "smtp"
+	MailIMAP = "imap"
+	MailPOP3 = "pop3"
+)
+
+type MailOptions struct {
+	StartTLS    bool
+	ImplicitTLS bool
+	Username    string
+	Password    string
+	EhloName    string
+	// TLSConfig overrides the client TLS config; nil verifies against the
+	// system roots with the host as server name.
+	TLSConfig *tls.Config
+}
+
+type MailResult struct {
+	Timing TCPResponseTiming
+	// ReplyCode is the SMTP greeting code; IMAP and POP3 have none.
+	ReplyCode    int
+	Greeting     string
+	Capabilities []string
+	TLS          bool
+}
+
+// mailSession wraps the connection with the phase bookkeeping shared by the
+// three protocols.
+type mailSession struct {
+	conn   net.Conn
+	text   *textproto.Conn
+	phases []PhaseTiming
+	last   time.Time
+	tag    int
+}
+
+func (s *mailSession) phase(name string) {
+	now := time.Now()
+	s.phases = append(s.phases, PhaseTiming{Name: name, Start: s.last.UnixMilli(), Done: now.UnixMilli()})
+	s.last = now
+}
+
+func (s *mailSession) upgrade(cfg *tls.Config) error {
+	tlsConn := tls.Client(s.conn, cfg)
+	if err := tlsConn.Handshake(); err != nil {
+		return fmt.Errorf("tls handshake failed: %w", err)
+	}
+	s.conn = tlsConn
+	s.text = textproto.NewConn(tlsConn)
+	return nil
+}
+
+// PingMail connects to the mail server at addr (host:port), reads the
+// greeting, asks for the capabilities and optionally upgrades the connection
+// and authenticates. Each step is reported as a phase.
+func PingMail(ctx context.Context, protocol, addr string, opts MailOptions, timeout time.Duration) (MailResult, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return MailResult{}, fmt.Errorf("invalid address %q: %w", addr, err)
+	}
+	if opts.StartTLS && opts.ImplicitTLS {
+		return MailResult{}, errors.New("starttls and implicit tls are mutually exclusive")
+	}
+
+	tlsConfig := opts.TLSConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{ServerName: host}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return MailResult{}, dialError(err, timeout)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	s := &mailSession{conn: conn, text: textproto.NewConn(conn), last: start}
+	s.phase("connect")
+
+	result := MailResult{}
+	if opts.ImplicitTLS {
+		if err := s.upgrade(tlsConfig); err != nil {
+			return MailResult{}, err
+		}
+		s.phase("tls")
+		result.TLS = true
+	}
+
+	switch protocol {
+	case MailSMTP:
+		err = pingSMTP(s, &result, opts, tlsConfig, host)
+	case MailIMAP:
+		err = pingIMAP(s, &result, opts, tlsConfig, host)
+	case MailPOP3:
+		err = pingPOP3(s, &result, opts, tlsConfig, host)
+	default:
+		return MailResult{}, fmt.Errorf("unsupported mail protocol %q", protocol)
+	}
+	if err != nil {
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return MailResult{}, timeoutError(err, timeout)
+		}
+		return MailResult{}, err
+	}
+
+	result.Timing = TCPResponseTiming{
+		TCPStart: start.UnixMilli(),
+		TCPDone:  s.last.UnixMilli(),
+		Phases:   s.phases,
+	}
+	return result, nil
+}
+
+// HasCapability reports whether the server advertised name, ignoring case and
+// any parameters, e.g. "AUTH" matches "AUTH PLAIN LOGIN".
+func (r MailResult) HasCapability(name string) bool {
+	return slices.ContainsFunc(r.Capabilities, func(c string) bool {
+		keyword, _, _ := strings.Cut(c, " ")
+		return strings.EqualFold(keyword, name)
+	})
+}
+
+func ehloName(name string) string {
+	if name != "" {
+		return name
+	}
+	if hostname, err := os.Hostname(); err == nil && hostname != "" {
+		return hostname
+	}
+	return "localhost"
+}
+
+func smtpCommand(s *mailSession, expect int, format string, args ...any) (int, string, error) {
+	id, err := s.text.Cmd(format, args...)
+	if err != nil {
+		return 0, "", err
+	}
+	s.text.StartResponse(id)
+	defer s.text.EndResponse(id)
+	return s.text.ReadResponse(expect)
+}
+
+func smtpEhlo(s *mailSession, result *MailResult, name string) error {
+	_, msg, err := smtpCommand(s, 250, "EHLO %s", name)
+	if err != nil {
+		return fmt.Errorf("EHLO failed: %w", err)
+	}
+	// The first line echoes the server name, the rest are extensions.
+	lines := strings.Split(msg, "\n")
+	result.Capabilities = lines[1:]
+	return nil
+}
+
+func pingSMTP(s *mailSession, result *MailResult, opts MailOptions, tlsConfig *tls.Config, host string) error {
+	code, msg, err := s.text.ReadResponse(0)
+	if err != nil && code == 0 {
+		return fmt.Errorf("unable to read greeting: %w", err)
+	}
+	result.ReplyCode = code
+	result.Greeting = msg
+	s.phase("greeting")
+
+	// A refusing server (421, 554) is reported as is; the job decides
+	// whether the code is acceptable.
+	if code/100 != 2 {
+		return nil
+	}
+
+	name := ehloName(opts.EhloName)
+	if err := smtpEhlo(s, result, name); err != nil {
+		return err
+	}
+	s.phase("capabilities")
+
+	if opts.StartTLS {
+		if !result.HasCapability("STARTTLS") {
+			return errors.New("server does not advertise STARTTLS")
+		}
+		if _, _, err := smtpCommand(s, 220, "STARTTLS"); err != nil {
+			return fmt.Errorf("STARTTLS failed: %w", err)
+		}
+		if err := s.upgrade(tlsConfig); err != nil {
+			return err
+		}
+		result.TLS = true
+		// Capabilities may change once the channel is encrypted.
+		if err := smtpEhlo(s, result, name); err != nil {
+			return err
+		}
+		s.phase("starttls")
+	}
+
+	if opts.Username != "" {
+		if err := requireTLS(result, host); err != nil {
+			return err
+		}
+		token := base64.StdEncoding.EncodeToString([]byte("\x00" + opts.Username + "\x00" + opts.Password))
+		if _, _, err := smtpCommand(s, 235, "AUTH PLAIN %s", token); err != nil {
+			return fmt.Errorf("%w: %w", ErrAuthFailed, err)
+		}
+		s.phase("auth")
+	}
+
+	smtpCommand(s, 221, "QUIT")
+	return nil
+}
+
+// requireTLS keeps credentials off cleartext connections, except to the
+// loopback interface, the same rule net/smtp applies.
+func requireTLS(result *MailResult, host string) error {
+	if result.TLS || host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+	return errors.New("refusing to authenticate over an unencrypted connection")
+}
+
+// imapQuote renders s as an IMAP quoted string.
+func imapQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// imapCommand sends a tagged command and returns the untagged lines once the
+// tagged OK arrives.
+func imapCommand(s *mailSession, format string, args ...any) ([]string, error) {
+	s.tag++
+	tag := fmt.Sprintf("a%d", s.tag)
+	if err := s.text.PrintfLine("%s "+format, append([]any{tag}, args...)...); err != nil {
+		return nil, err
+	}
+	var untagged []string
+	for {
+		line, err := s.text.ReadLine()
+		if err != nil {
+			return nil, err
+		}
+		if rest, ok := strings.CutPrefix(line, tag+" "); ok {
+			status, text, _ := strings.Cut(rest, " ")
+			if !strings.EqualFold(status, "OK") {
+				return nil, fmt.Errorf("%s %s", status, text)
+			}
+			return untagged, nil
+		}
+		untagged = append(untagged, line)
+	}
+}
+
+func imapCapabilities(s *mailSession, result *MailResult) error {
+	lines, err := imapCommand(s, "CAPABILITY")
+	if err != nil {
+		return fmt.Errorf("CAPABILITY failed: %w", err)
+	}
+	result.Capabilities = nil
+	for _, line := range lines {
+		if rest, ok := strings.CutPrefix(line, "* CAPABILITY "); ok {
+			result.Capabilities = append(result.Capabilities, strings.Fields(rest)...)
+		}
+	}
+	return nil
+}
+
+func pingIMAP(s *mailSession, result *MailResult, opts MailOptions, tlsConfig *tls.Config, host string) error {
+	greeting, err := s.text.ReadLine()
+	if err != nil {
+		return fmt.Errorf("unable to read greeting: %w", err)
+	}
+	result.Greeting = greeting
+	if !strings.HasPrefix(greeting, "* OK") && !strings.HasPrefix(greeting, "* PREAUTH") {
+		return fmt.Errorf("unexpected greeting: %s", greeting)
+	}
+	s.phase("greeting")
+
+	if err := imapCapabilities(s, result); err != nil {
+		return err
+	}
+	s.phase("capabilities")
+
+	if opts.StartTLS {
+		if !result.HasCapability("STARTTLS") {
+			return errors.New("server does not advertise STARTTLS")
+		}
+		if _, err := imapCommand(s, "STARTTLS"); err != nil {
+			return fmt.Errorf("STARTTLS failed: %w", err)
+		}
+		if err := s.upgrade(tlsConfig); err != nil {
+			return err
+		}
+		result.TLS = true
+		if err := imapCapabilities(s, result); err != nil {
+			return err
+		}
+		s.phase("starttls")
+	}
+
+	if opts.Username != "" {
+		if err := requireTLS(result, host); err != nil {
+			return err
+		}
+		if _, err := imapCommand(s, "LOGIN %s %s", imapQuote(opts.Username), imapQuote(opts.Password)); err != nil {
+			return fmt.Errorf("%w: %w", ErrAuthFailed, err)
+		}
+		s.phase("auth")
+	}
+
+	imapCommand(s, "LOGOUT")
+	return nil
+}
+
+// pop3Command sends a command and fails unless the server answers +OK.
+func pop3Command(s *mailSession, format string, args ...any) (string, error) {
+	if err := s.text.PrintfLine(format, args...); err != nil {
+		return "", err
+	}
+	line, err := s.text.ReadLine()
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(line, "+OK") {
+		return "", errors.New(line)
+	}
+	return line, nil
+}
+
+func pop3Capabilities(s *mailSession, result *MailResult) error {
+	if _, err := pop3Command(s, "CAPA"); err != nil {
+		return fmt.Errorf("CAPA failed: %w", err)
+	}
+	lines, err := s.text.ReadDotLines()
+	if err != nil {
+		return fmt.Errorf("CAPA failed: %w", err)
+	}
+	result.Capabilities = lines
+	return nil
+}
+
+func pingPOP3(s *mailSession, result *MailResult, opts MailOptions, tlsConfig *tls.Config, host string) error {
+	greeting, err := s.text.ReadLine()
+	if err != nil {
+		return fmt.Errorf("unable to read greeting: %w", err)
+	}
+	result.Greeting = greeting
+	if !strings.HasPrefix(greeting, "+OK") {
+		return fmt.Errorf("unexpected greeting: %s", greeting)
+	}
+	s.phase("greeting")
+
+	if err := pop3Capabilities(s, result); err != nil {
+		return err
+	}
+	s.phase("capabilities")
+
+	if opts.StartTLS {
+		if !result.HasCapability("STLS") {
+			return errors.New("server does not advertise STLS")
+		}
+		if _, err := pop3Command(s, "STLS"); err != nil {
+			return fmt.Errorf("STLS failed: %w", err)
+		}
+		if err := s.upgrade(tlsConfig); err != nil {
+			return err
+		}
+		result.TLS = true
+		if err := pop3Capabilities(s, result); err != nil {
+			return err
+		}
+		s.phase("starttls")
+	}
+
+	if opts.Username != "" {
+		if err := requireTLS(result, host); err != nil {
+			return err
+		}
+		if _, err := pop3Command(s, "USER %s", opts.Username); err != nil {
+			return fmt.Errorf("%w: %w", ErrAuthFailed, err)
+		}
+		if _, err := pop3Command(s, "PASS %s", opts.Password); err != nil {
+			return fmt.Errorf("%w: %w", ErrAuthFailed, err)
+		}
+		s.phase("auth")
+	}
+
+	pop3Command(s, "QUIT")
+	return nil
+}
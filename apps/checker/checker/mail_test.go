@@ -0,0 +1,278 @@
+package checker_test
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+)
+
+// selfSigned returns a server config for 127.0.0.1 and a client config
+// trusting it.
+func selfSigned(t *testing.T) (*tls.Config, *tls.Config) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "mail.test"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	server := &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}
+	client := &tls.Config{RootCAs: pool, ServerName: "127.0.0.1"}
+	return server, client
+}
+
+// mailConn lets a fake server swap the plain connection for TLS mid-session.
+type mailConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+	tls  *tls.Config
+}
+
+func (c *mailConn) line() (string, bool) {
+	line, err := c.r.ReadString('\n')
+	return strings.TrimRight(line, "\r\n"), err == nil
+}
+
+func (c *mailConn) write(lines ...string) {
+	for _, l := range lines {
+		fmt.Fprintf(c.conn, "%s\r\n", l)
+	}
+}
+
+func (c *mailConn) upgrade() bool {
+	conn := tls.Server(c.conn, c.tls)
+	if err := conn.Handshake(); err != nil {
+		return false
+	}
+	c.conn = conn
+	c.r = bufio.NewReader(conn)
+	return true
+}
+
+func fakeSMTP(tlsConfig *tls.Config, greeting string) func(net.Conn) {
+	return func(conn net.Conn) {
+		c := &mailConn{conn: conn, r: bufio.NewReader(conn), tls: tlsConfig}
+		c.write(greeting)
+		secure := false
+		for {
+			line, ok := c.line()
+			if !ok {
+				return
+			}
+			verb, arg, _ := strings.Cut(line, " ")
+			switch strings.ToUpper(verb) {
+			case "EHLO":
+				if secure {
+					c.write("250-mail.test", "250-PIPELINING", "250 AUTH PLAIN")
+				} else {
+					c.write("250-mail.test", "250-PIPELINING", "250 STARTTLS")
+				}
+			case "STARTTLS":
+				c.write("220 Ready to start TLS")
+				if !c.upgrade() {
+					return
+				}
+				secure = true
+			case "AUTH":
+				if arg == "PLAIN "+base64.StdEncoding.EncodeToString([]byte("\x00probe\x00secret")) {
+					c.write("235 Authentication successful")
+				} else {
+					c.write("535 Authentication credentials invalid")
+				}
+			case "QUIT":
+				c.write("221 Bye")
+				return
+			default:
+				c.write("502 Command not implemented")
+			}
+		}
+	}
+}
+
+func fakeIMAP(tlsConfig *tls.Config) func(net.Conn) {
+	return func(conn net.Conn) {
+		c := &mailConn{conn: conn, r: bufio.NewReader(conn), tls: tlsConfig}
+		c.write("* OK IMAP4rev1 ready")
+		for {
+			line, ok := c.line()
+			if !ok {
+				return
+			}
+			fields := strings.Fields(line)
+			if len(fields) < 2 {
+				return
+			}
+			tag := fields[0]
+			switch strings.ToUpper(fields[1]) {
+			case "CAPABILITY":
+				c.write("* CAPABILITY IMAP4rev1 STARTTLS AUTH=PLAIN", tag+" OK CAPABILITY completed")
+			case "STARTTLS":
+				c.write(tag + " OK Begin TLS negotiation now")
+				if !c.upgrade() {
+					return
+				}
+			case "LOGIN":
+				if strings.HasSuffix(line, `"probe" "secret"`) {
+					c.write(tag + " OK LOGIN completed")
+				} else {
+					c.write(tag + " NO [AUTHENTICATIONFAILED] Invalid credentials")
+				}
+			case "LOGOUT":
+				c.write("* BYE", tag+" OK LOGOUT completed")
+				return
+			default:
+				c.write(tag + " BAD unknown command")
+			}
+		}
+	}
+}
+
+func fakePOP3(conn net.Conn) {
+	c := &mailConn{conn: conn, r: bufio.NewReader(conn)}
+	c.write("+OK POP3 ready")
+	for {
+		line, ok := c.line()
+		if !ok {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "CAPA":
+			c.write("+OK Capability list follows", "USER", "UIDL", ".")
+		case "USER":
+			c.write("+OK")
+		case "PASS":
+			if arg == "secret" {
+				c.write("+OK Logged in")
+			} else {
+				c.write("-ERR [AUTH] Invalid credentials")
+			}
+		case "QUIT":
+			c.write("+OK Bye")
+			return
+		default:
+			c.write("-ERR unknown command")
+		}
+	}
+}
+
+func phaseNames(timing checker.TCPResponseTiming) []string {
+	var names []string
+	for _, p := range timing.Phases {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+func TestPingMail(t *testing.T) {
+	serverTLS, clientTLS := selfSigned(t)
+	ctx := t.Context()
+
+	t.Run("smtp with starttls and auth", func(t *testing.T) {
+		addr := listen(t, fakeSMTP(serverTLS, "220 mail.test ESMTP"))
+		res, err := checker.PingMail(ctx, checker.MailSMTP, addr, checker.MailOptions{
+			StartTLS:  true,
+			Username:  "probe",
+			Password:  "secret",
+			TLSConfig: clientTLS,
+		}, 5*time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, 220, res.ReplyCode)
+		assert.True(t, res.TLS)
+		// Capabilities are the ones advertised after the upgrade.
+		assert.True(t, res.HasCapability("auth"))
+		assert.False(t, res.HasCapability("STARTTLS"))
+		assert.Equal(t, []string{"connect", "greeting", "capabilities", "starttls", "auth"}, phaseNames(res.Timing))
+		assert.GreaterOrEqual(t, res.Timing.TCPDone, res.Timing.TCPStart)
+	})
+
+	t.Run("smtp refusing greeting is reported", func(t *testing.T) {
+		addr := listen(t, fakeSMTP(serverTLS, "554 No SMTP service here"))
+		res, err := checker.PingMail(ctx, checker.MailSMTP, addr, checker.MailOptions{}, 5*time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, 554, res.ReplyCode)
+		assert.Equal(t, []string{"connect", "greeting"}, phaseNames(res.Timing))
+	})
+
+	t.Run("smtp wrong password", func(t *testing.T) {
+		addr := listen(t, fakeSMTP(serverTLS, "220 mail.test ESMTP"))
+		_, err := checker.PingMail(ctx, checker.MailSMTP, addr, checker.MailOptions{
+			StartTLS:  true,
+			Username:  "probe",
+			Password:  "wrong",
+			TLSConfig: clientTLS,
+		}, 5*time.Second)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "authentication failed")
+	})
+
+	t.Run("imap with starttls and login", func(t *testing.T) {
+		addr := listen(t, fakeIMAP(serverTLS))
+		res, err := checker.PingMail(ctx, checker.MailIMAP, addr, checker.MailOptions{
+			StartTLS:  true,
+			Username:  "probe",
+			Password:  "secret",
+			TLSConfig: clientTLS,
+		}, 5*time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"IMAP4rev1", "STARTTLS", "AUTH=PLAIN"}, res.Capabilities)
+		assert.Equal(t, []string{"connect", "greeting", "capabilities", "starttls", "auth"}, phaseNames(res.Timing))
+	})
+
+	t.Run("pop3 login on loopback", func(t *testing.T) {
+		addr := listen(t, fakePOP3)
+		res, err := checker.PingMail(ctx, checker.MailPOP3, addr, checker.MailOptions{
+			Username: "probe",
+			Password: "secret",
+		}, 5*time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"USER", "UIDL"}, res.Capabilities)
+		assert.Equal(t, []string{"connect", "greeting", "capabilities", "auth"}, phaseNames(res.Timing))
+	})
+
+	t.Run("pop3 without stls", func(t *testing.T) {
+		addr := listen(t, fakePOP3)
+		_, err := checker.PingMail(ctx, checker.MailPOP3, addr, checker.MailOptions{StartTLS: true}, 5*time.Second)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not advertise STLS")
+	})
+
+	t.Run("connection refused", func(t *testing.T) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := ln.Addr().String()
+		ln.Close()
+
+		_, err = checker.PingMail(ctx, checker.MailSMTP, addr, checker.MailOptions{}, time.Second)
+		require.Error(t, err)
+		assert.Equal(t, "connection refused", err.Error())
+	})
+}
@@ -0,0 +1,123 @@
+package checker
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+const defaultMQTTTopic = "openstatus/probe"
+
+type MQTTOptions struct {
+	// Topic the probe publishes to and subscribes on; every run uses a fresh
+	// payload so retained or concurrent messages are not mistaken for ours.
+	Topic    string
+	Username string
+	Password string
+	TLS      bool
+	// TLSConfig overrides the client TLS config; nil verifies against the
+	// system roots.
+	TLSConfig *tls.Config
+}
+
+// PingMQTT connects to the broker at addr (host:port), subscribes to the
+// test topic and waits for its own publish to come back. The phases are the
+// connect (TCP, TLS and CONNACK), the subscription and the round trip.
+func PingMQTT(ctx context.Context, addr string, opts MQTTOptions, timeout time.Duration) (TCPResponseTiming, error) {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		return TCPResponseTiming{}, fmt.Errorf("invalid address %q: %w", addr, err)
+	}
+	topic := opts.Topic
+	if topic == "" {
+		topic = defaultMQTTTopic
+	}
+
+	nonce := make([]byte, 8)
+	if _, err := rand.Read(nonce); err != nil {
+		return TCPResponseTiming{}, fmt.Errorf("unable to generate payload: %w", err)
+	}
+	payload := "openstatus-" + hex.EncodeToString(nonce)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	scheme := "tcp"
+	if opts.TLS {
+		scheme = "tls"
+	}
+	clientOpts := mqtt.NewClientOptions().
+		AddBroker(fmt.Sprintf("%s://%s", scheme, addr)).
+		SetClientID(payload).
+		SetUsername(opts.Username).
+		SetPassword(opts.Password).
+		SetCleanSession(true).
+		SetAutoReconnect(false).
+		SetConnectRetry(false).
+		SetConnectTimeout(timeout).
+		SetWriteTimeout(timeout)
+	if opts.TLS {
+		clientOpts.SetTLSConfig(opts.TLSConfig)
+	}
+	client := mqtt.NewClient(clientOpts)
+
+	start := time.Now()
+	if err := waitToken(ctx, client.Connect(), timeout); err != nil {
+		return TCPResponseTiming{}, fmt.Errorf("unable to connect: %w", err)
+	}
+	defer client.Disconnect(0)
+	connected := time.Now()
+
+	received := make(chan struct{}, 1)
+	handler := func(_ mqtt.Client, msg mqtt.Message) {
+		if string(msg.Payload()) == payload {
+			select {
+			case received <- struct{}{}:
+			default:
+			}
+		}
+	}
+	if err := waitToken(ctx, client.Subscribe(topic, 1, handler), timeout); err != nil {
+		return TCPResponseTiming{}, fmt.Errorf("unable to subscribe to %s: %w", topic, err)
+	}
+	subscribed := time.Now()
+
+	if err := waitToken(ctx, client.Publish(topic, 1, false, payload), timeout); err != nil {
+		return TCPResponseTiming{}, fmt.Errorf("unable to publish to %s: %w", topic, err)
+	}
+	select {
+	case <-received:
+	case <-ctx.Done():
+		return TCPResponseTiming{}, fmt.Errorf("message not received on %s within %d ms", topic, timeout.Milliseconds())
+	}
+	done := time.Now()
+
+	return TCPResponseTiming{
+		TCPStart: start.UnixMilli(),
+		TCPDone:  done.UnixMilli(),
+		Phases: []PhaseTiming{
+			{Name: "connect", Start: start.UnixMilli(), Done: connected.UnixMilli()},
+			{Name: "subscribe", Start: connected.UnixMilli(), Done: subscribed.UnixMilli()},
+			{Name: "roundtrip", Start: subscribed.UnixMilli(), Done: done.UnixMilli()},
+		},
+	}, nil
+}
+
+// waitToken waits for the broker to acknowledge an operation.
+func waitToken(ctx context.Context, token mqtt.Token, timeout time.Duration) error {
+	select {
+	case <-token.Done():
+		return token.Error()
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return timeoutError(ctx.Err(), timeout)
+		}
+		return ctx.Err()
+	}
+}
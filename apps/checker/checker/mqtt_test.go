@@ -0,0 +1,124 @@
+package checker_test
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+)
+
+func readMQTTPacket(r *bufio.Reader) (byte, []byte, error) {
+	header, err := r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	var length, shift int
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, nil, err
+		}
+		length |= int(b&0x7f) << shift
+		if b&0x80 == 0 {
+			break
+		}
+		shift += 7
+	}
+	body := make([]byte, length)
+	_, err = io.ReadFull(r, body)
+	return header, body, err
+}
+
+func mqttString(b []byte) (string, []byte) {
+	n := binary.BigEndian.Uint16(b)
+	return string(b[2 : 2+n]), b[2+n:]
+}
+
+// fakeBroker speaks enough MQTT 3.1.1 for a single client: it checks the
+// credentials and, when echo is set, delivers every publish back to the
+// publisher.
+func fakeBroker(username, password string, echo bool) func(net.Conn) {
+	return func(conn net.Conn) {
+		r := bufio.NewReader(conn)
+		for {
+			header, body, err := readMQTTPacket(r)
+			if err != nil {
+				return
+			}
+			switch header >> 4 {
+			case 1: // CONNECT
+				_, rest := mqttString(body)
+				flags := rest[1]
+				_, rest = mqttString(rest[4:])
+				var user, pass string
+				if flags&0x80 != 0 {
+					user, rest = mqttString(rest)
+				}
+				if flags&0x40 != 0 {
+					pass, _ = mqttString(rest)
+				}
+				if user != username || pass != password {
+					conn.Write([]byte{0x20, 0x02, 0x00, 0x05})
+					return
+				}
+				conn.Write([]byte{0x20, 0x02, 0x00, 0x00})
+			case 8: // SUBSCRIBE
+				conn.Write([]byte{0x90, 0x03, body[0], body[1], 0x01})
+			case 3: // PUBLISH
+				qos := (header >> 1) & 0x03
+				topic, rest := mqttString(body)
+				if qos > 0 {
+					conn.Write([]byte{0x40, 0x02, rest[0], rest[1]})
+					rest = rest[2:]
+				}
+				if echo {
+					packet := append([]byte{0, byte(len(topic))}, topic...)
+					packet = append(packet, rest...)
+					conn.Write(append([]byte{0x30, byte(len(packet))}, packet...))
+				}
+			case 12: // PINGREQ
+				conn.Write([]byte{0xd0, 0x00})
+			case 14: // DISCONNECT
+				return
+			}
+		}
+	}
+}
+
+func TestPingMQTT(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("round trip", func(t *testing.T) {
+		addr := listen(t, fakeBroker("probe", "secret", true))
+		timing, err := checker.PingMQTT(ctx, addr, checker.MQTTOptions{
+			Topic:    "fleet/health",
+			Username: "probe",
+			Password: "secret",
+		}, 5*time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"connect", "subscribe", "roundtrip"}, phaseNames(timing))
+		assert.GreaterOrEqual(t, timing.TCPDone, timing.TCPStart)
+	})
+
+	t.Run("bad credentials", func(t *testing.T) {
+		addr := listen(t, fakeBroker("probe", "secret", true))
+		_, err := checker.PingMQTT(ctx, addr, checker.MQTTOptions{Username: "probe", Password: "wrong"}, 5*time.Second)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to connect")
+	})
+
+	t.Run("message never delivered", func(t *testing.T) {
+		addr := listen(t, fakeBroker("", "", false))
+		_, err := checker.PingMQTT(ctx, addr, checker.MQTTOptions{}, 500*time.Millisecond)
+		require.Error(t, err)
+		assert.True(t, strings.HasPrefix(err.Error(), "message not received"), err.Error())
+	})
+}
@@ -0,0 +1,129 @@
+package checker
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+const ntpPacketSize = 48
+
+// Seconds between the NTP era (1900) and the unix epoch.
+const ntpEpochOffset = 2208988800
+
+type NTPResult struct {
+	Timing  TCPResponseTiming
+	Stratum int
+	// Offset is the server clock minus the probe clock.
+	Offset time.Duration
+	// Delay is the round trip without the server's processing time.
+	Delay       time.Duration
+	ReferenceID string
+}
+
+func fromNTPTime(v uint64) time.Time {
+	secs := int64(v>>32) - ntpEpochOffset
+	nanos := (int64(v&0xffffffff) * 1e9) >> 32
+	return time.Unix(secs, nanos)
+}
+
+// referenceID renders the reference identifier: a four letter code for
+// stratum 0 and 1 servers, the upstream IPv4 address otherwise.
+func referenceID(stratum int, id []byte) string {
+	if stratum <= 1 {
+		return strings.TrimRight(string(id), "\x00")
+	}
+	return net.IP(id).String()
+}
+
+// PingNTP sends a single SNTP (RFC 4330) client request to addr and derives
+// the clock offset and round-trip delay from the four timestamps. The port
+// defaults to 123.
+func PingNTP(ctx context.Context, addr string, timeout time.Duration) (NTPResult, error) {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, "123")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	conn, err := (&net.Dialer{}).DialContext(ctx, "udp", addr)
+	if err != nil {
+		return NTPResult{}, fmt.Errorf("dial error: %w", err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	// A random transmit timestamp is echoed back as the originate timestamp,
+	// which ties the reply to this request without exposing our clock.
+	req := make([]byte, ntpPacketSize)
+	req[0] = 0<<6 | 4<<3 | 3 // no leap warning, version 4, client mode
+	if _, err := rand.Read(req[40:48]); err != nil {
+		return NTPResult{}, fmt.Errorf("unable to generate request: %w", err)
+	}
+
+	t1 := time.Now()
+	if _, err := conn.Write(req); err != nil {
+		return NTPResult{}, fmt.Errorf("unable to send request: %w", err)
+	}
+
+	res := make([]byte, 512)
+	var n int
+	for {
+		n, err = conn.Read(res)
+		if err != nil {
+			switch Classify(err) {
+			case ErrorTimeoutRead:
+				return NTPResult{}, &CheckError{Msg: fmt.Sprintf("timeout after %d ms", timeout.Milliseconds()), Err: err}
+			case ErrorConnectionRefused:
+				return NTPResult{}, &CheckError{Msg: "connection refused", Err: err}
+			}
+			return NTPResult{}, fmt.Errorf("unable to read response: %w", err)
+		}
+		// Ignore stray datagrams that do not answer our request.
+		if n >= ntpPacketSize && string(res[24:32]) == string(req[40:48]) {
+			break
+		}
+	}
+	t4 := time.Now()
+
+	if mode := res[0] & 0x07; mode != 4 {
+		return NTPResult{}, fmt.Errorf("unexpected mode %d in response", mode)
+	}
+	stratum := int(res[1])
+	if stratum == 0 {
+		return NTPResult{}, fmt.Errorf("kiss-o'-death from server: %s", referenceID(0, res[12:16]))
+	}
+	if leap := res[0] >> 6; leap == 3 {
+		return NTPResult{}, errors.New("server clock is not synchronized")
+	}
+
+	t2 := fromNTPTime(binary.BigEndian.Uint64(res[32:40]))
+	t3 := fromNTPTime(binary.BigEndian.Uint64(res[40:48]))
+
+	result := NTPResult{
+		Stratum:     stratum,
+		Offset:      (t2.Sub(t1) + t3.Sub(t4)) / 2,
+		Delay:       t4.Sub(t1) - t3.Sub(t2),
+		ReferenceID: referenceID(stratum, res[12:16]),
+		Timing: TCPResponseTiming{
+			TCPStart: start.UnixMilli(),
+			TCPDone:  t4.UnixMilli(),
+			Phases: []PhaseTiming{
+				{Name: "roundtrip", Start: t1.UnixMilli(), Done: t4.UnixMilli()},
+			},
+		},
+	}
+	if result.Delay < 0 {
+		result.Delay = 0
+	}
+	return result, nil
+}
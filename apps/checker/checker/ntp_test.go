@@ -0,0 +1,94 @@
+package checker_test
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+)
+
+func ntpTime(t time.Time) uint64 {
+	secs := uint64(t.Unix() + 2208988800)
+	frac := (uint64(t.Nanosecond()) << 32) / 1e9
+	return secs<<32 | frac
+}
+
+// fakeNTP answers every request with a server clock skewed by skew. reply
+// may adjust the packet before it is sent.
+func fakeNTP(t *testing.T, skew time.Duration, reply func([]byte)) string {
+	t.Helper()
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, 48)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if n < 48 {
+				continue
+			}
+			now := time.Now().Add(skew)
+			res := make([]byte, 48)
+			res[0] = 4<<3 | 4 // version 4, server mode
+			res[1] = 2
+			copy(res[12:16], net.IPv4(192, 0, 2, 1).To4())
+			copy(res[24:32], buf[40:48])
+			binary.BigEndian.PutUint64(res[32:40], ntpTime(now))
+			binary.BigEndian.PutUint64(res[40:48], ntpTime(now))
+			if reply != nil {
+				reply(res)
+			}
+			conn.WriteTo(res, addr)
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+func TestPingNTP(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("offset and stratum", func(t *testing.T) {
+		addr := fakeNTP(t, 2*time.Second, nil)
+		res, err := checker.PingNTP(ctx, addr, time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, 2, res.Stratum)
+		assert.Equal(t, "192.0.2.1", res.ReferenceID)
+		assert.InDelta(t, (2 * time.Second).Milliseconds(), res.Offset.Milliseconds(), 50)
+		assert.GreaterOrEqual(t, res.Delay, time.Duration(0))
+		assert.Equal(t, []string{"roundtrip"}, phaseNames(res.Timing))
+	})
+
+	t.Run("kiss-o'-death", func(t *testing.T) {
+		addr := fakeNTP(t, 0, func(res []byte) {
+			res[1] = 0
+			copy(res[12:16], "RATE")
+		})
+		_, err := checker.PingNTP(ctx, addr, time.Second)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "RATE")
+	})
+
+	t.Run("unsynchronized server", func(t *testing.T) {
+		addr := fakeNTP(t, 0, func(res []byte) { res[0] |= 3 << 6 })
+		_, err := checker.PingNTP(ctx, addr, time.Second)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not synchronized")
+	})
+
+	t.Run("reply to another request is ignored", func(t *testing.T) {
+		addr := fakeNTP(t, 0, func(res []byte) { res[24] ^= 0xff })
+		_, err := checker.PingNTP(ctx, addr, 200*time.Millisecond)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "timeout")
+	})
+}
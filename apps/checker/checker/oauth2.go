@@ -0,0 +1,102 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/openstatushq/openstatus/apps/checker/request"
+)
+
+// OAuth2Tokens hands out the bearer token of an HTTP check.
+type OAuth2Tokens interface {
+	Token(ctx context.Context, client *http.Client, creds request.OAuth2ClientCredentials) (*oauth2.Token, error)
+}
+
+// OAuth2Fetcher fetches a new token for every check. The public checker runs
+// each check in a fresh request, so there is nothing to reuse.
+type OAuth2Fetcher struct{}
+
+func (OAuth2Fetcher) Token(ctx context.Context, client *http.Client, creds request.OAuth2ClientCredentials) (*oauth2.Token, error) {
+	cfg := &clientcredentials.Config{
+		ClientID:     creds.ClientID,
+		ClientSecret: creds.ClientSecret,
+		TokenURL:     creds.TokenURL,
+		Scopes:       creds.Scopes,
+	}
+	if creds.Audience != "" {
+		cfg.EndpointParams = url.Values{"audience": {creds.Audience}}
+	}
+
+	return cfg.Token(context.WithValue(ctx, oauth2.HTTPClient, client))
+}
+
+// OAuth2TokenCache reuses a token across checks until it is about to expire,
+// so a long-running probe does not hit the identity provider on every check.
+type OAuth2TokenCache struct {
+	mu     sync.Mutex
+	tokens map[string]*oauth2.Token
+	fetch  OAuth2Fetcher
+}
+
+func NewOAuth2TokenCache() *OAuth2TokenCache {
+	return &OAuth2TokenCache{tokens: make(map[string]*oauth2.Token)}
+}
+
+func (c *OAuth2TokenCache) Token(ctx context.Context, client *http.Client, creds request.OAuth2ClientCredentials) (*oauth2.Token, error) {
+	key := strings.Join([]string{creds.TokenURL, creds.ClientID, creds.ClientSecret, strings.Join(creds.Scopes, " "), creds.Audience}, "\x00")
+
+	c.mu.Lock()
+	token, ok := c.tokens[key]
+	c.mu.Unlock()
+	// Valid already treats a token expiring within seconds as expired.
+	if ok && token.Valid() {
+		return token, nil
+	}
+
+	token, err := c.fetch.Token(ctx, client, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.tokens[key] = token
+	c.mu.Unlock()
+	return token, nil
+}
+
+// AuthorizedHttp runs Http with the Authorization header set from the auth
+// block of the request. A token that cannot be fetched fails the check the way
+// an unreachable endpoint does, instead of sending the request without it.
+func AuthorizedHttp(ctx context.Context, client *http.Client, inputData request.HttpCheckerRequest, tokens OAuth2Tokens) (Response, error) {
+	if inputData.Auth == nil || inputData.Auth.OAuth2 == nil {
+		return Http(ctx, client, inputData)
+	}
+
+	start := time.Now()
+	token, err := tokens.Token(ctx, client, *inputData.Auth.OAuth2)
+	if err != nil {
+		return Response{
+			Latency:   time.Since(start).Milliseconds(),
+			Timestamp: start.UTC().UnixMilli(),
+			Error:     fmt.Sprintf("Unable to fetch OAuth2 token: %s", err),
+			ErrorCode: ErrorAuthFailed,
+		}, nil
+	}
+
+	// The token goes last so it wins over a stale static Authorization header.
+	inputData.Headers = append(slices.Clip(inputData.Headers), struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}{Key: "Authorization", Value: token.Type() + " " + token.AccessToken})
+
+	return Http(ctx, client, inputData)
+}
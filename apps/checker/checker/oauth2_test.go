@@ -0,0 +1,106 @@
+package checker_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/request"
+)
+
+// identityProvider issues numbered tokens for client "probe" and counts how
+// many it handed out.
+func identityProvider(t *testing.T, expiresIn int) (string, *atomic.Int32) {
+	t.Helper()
+	var issued atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		clientID, _, ok := r.BasicAuth()
+		if !ok || clientID != "probe" || r.PostForm.Get("grant_type") != "client_credentials" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error": "invalid_client"}`)
+			return
+		}
+		assert.Equal(t, "read:health", r.PostForm.Get("scope"))
+		assert.Equal(t, "https://api.example.com", r.PostForm.Get("audience"))
+
+		n := issued.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "bearer", "expires_in": %d}`, n, expiresIn)
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL, &issued
+}
+
+func TestAuthorizedHttp(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Header.Get("Authorization")))
+	}))
+	t.Cleanup(api.Close)
+
+	check := func(tokenURL, clientID string) request.HttpCheckerRequest {
+		return request.HttpCheckerRequest{
+			Method: http.MethodGet,
+			URL:    api.URL,
+			Auth: &request.HTTPAuth{OAuth2: &request.OAuth2ClientCredentials{
+				TokenURL:     tokenURL,
+				ClientID:     clientID,
+				ClientSecret: "s3cret",
+				Scopes:       []string{"read:health"},
+				Audience:     "https://api.example.com",
+			}},
+		}
+	}
+	client := &http.Client{Timeout: time.Second}
+
+	t.Run("the fetched token is sent as a bearer token", func(t *testing.T) {
+		tokenURL, _ := identityProvider(t, 3600)
+
+		res, err := checker.AuthorizedHttp(t.Context(), client, check(tokenURL, "probe"), checker.OAuth2Fetcher{})
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer token-1", res.Body)
+	})
+
+	t.Run("the cache reuses a token until it expires", func(t *testing.T) {
+		tokenURL, issued := identityProvider(t, 3600)
+		cache := checker.NewOAuth2TokenCache()
+
+		for range 3 {
+			res, err := checker.AuthorizedHttp(t.Context(), client, check(tokenURL, "probe"), cache)
+			require.NoError(t, err)
+			assert.Equal(t, "Bearer token-1", res.Body)
+		}
+		assert.Equal(t, int32(1), issued.Load())
+	})
+
+	t.Run("an expired token is fetched again", func(t *testing.T) {
+		// Tokens this short lived are already inside the expiry margin.
+		tokenURL, issued := identityProvider(t, 1)
+		cache := checker.NewOAuth2TokenCache()
+
+		for range 2 {
+			_, err := checker.AuthorizedHttp(t.Context(), client, check(tokenURL, "probe"), cache)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(2), issued.Load())
+	})
+
+	t.Run("a rejected client fails the check", func(t *testing.T) {
+		tokenURL, _ := identityProvider(t, 3600)
+
+		res, err := checker.AuthorizedHttp(t.Context(), client, check(tokenURL, "intruder"), checker.NewOAuth2TokenCache())
+		require.NoError(t, err)
+		assert.Equal(t, checker.ErrorAuthFailed, res.ErrorCode)
+		assert.Contains(t, res.Error, "Unable to fetch OAuth2 token")
+		assert.Contains(t, res.Error, "invalid_client")
+		assert.Zero(t, res.Status)
+	})
+}
@@ -0,0 +1,258 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultRDAPBootstrapURL is the IANA registry mapping TLDs to RDAP servers.
+const DefaultRDAPBootstrapURL = "https://data.iana.org/rdap/dns.json"
+
+// The bootstrap registry changes rarely; IANA asks clients to cache it.
+const rdapBootstrapTTL = 24 * time.Hour
+
+type RDAPOptions struct {
+	// BaseURL queries this RDAP server directly and skips the bootstrap.
+	BaseURL string
+	// BootstrapURL overrides the IANA bootstrap registry.
+	BootstrapURL string
+}
+
+type DomainResult struct {
+	Timing    TCPResponseTiming
+	Domain    string
+	Registrar string
+	// Expiration is zero when the registry publishes no expiration event.
+	Expiration time.Time
+	// Statuses are in their EPP form (clientHold rather than RDAP's
+	// "client hold"), which is how registrars and users spell them.
+	Statuses []string
+}
+
+// eppStatus maps an RDAP status value back to its EPP name (RFC 8056).
+func eppStatus(status string) string {
+	words := strings.Fields(strings.ToLower(status))
+	for i := 1; i < len(words); i++ {
+		words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+	}
+	return strings.Join(words, "")
+}
+
+// DaysUntilExpiry returns the whole days left at now, negative once the
+// registration has lapsed.
+func (r DomainResult) DaysUntilExpiry(now time.Time) int64 {
+	return int64(r.Expiration.Sub(now).Hours()) / 24
+}
+
+type rdapEntity struct {
+	Roles []string          `json:"roles"`
+	VCard []json.RawMessage `json:"vcardArray"`
+}
+
+// name reads the formatted name from the jCard (RFC 7095) of the entity.
+func (e rdapEntity) name() string {
+	if len(e.VCard) != 2 {
+		return ""
+	}
+	var properties [][]json.RawMessage
+	if err := json.Unmarshal(e.VCard[1], &properties); err != nil {
+		return ""
+	}
+	for _, p := range properties {
+		var key, value string
+		if len(p) < 4 || json.Unmarshal(p[0], &key) != nil || key != "fn" {
+			continue
+		}
+		if json.Unmarshal(p[3], &value) == nil {
+			return value
+		}
+	}
+	return ""
+}
+
+type rdapDomain struct {
+	Status []string `json:"status"`
+	Events []struct {
+		Action string `json:"eventAction"`
+		Date   string `json:"eventDate"`
+	} `json:"events"`
+	Entities []rdapEntity `json:"entities"`
+}
+
+func findRegistrar(entities []rdapEntity) string {
+	for _, e := range entities {
+		if slices.Contains(e.Roles, "registrar") {
+			return e.name()
+		}
+	}
+	return ""
+}
+
+type rdapBootstrapEntry struct {
+	services  map[string]string
+	fetchedAt time.Time
+}
+
+var rdapBootstrapCache = struct {
+	sync.Mutex
+	entries map[string]rdapBootstrapEntry
+}{entries: map[string]rdapBootstrapEntry{}}
+
+// rdapServer resolves the RDAP base URL for domain from the bootstrap
+// registry (RFC 9224), matching the longest registered suffix.
+func rdapServer(ctx context.Context, client *http.Client, bootstrapURL, domain string) (string, error) {
+	rdapBootstrapCache.Lock()
+	entry, ok := rdapBootstrapCache.entries[bootstrapURL]
+	rdapBootstrapCache.Unlock()
+
+	if !ok || time.Since(entry.fetchedAt) > rdapBootstrapTTL {
+		var registry struct {
+			Services [][][]string `json:"services"`
+		}
+		if err := getRDAP(ctx, client, bootstrapURL, &registry); err != nil {
+			return "", fmt.Errorf("unable to load RDAP bootstrap: %w", err)
+		}
+		entry = rdapBootstrapEntry{services: map[string]string{}, fetchedAt: time.Now()}
+		for _, service := range registry.Services {
+			if len(service) != 2 || len(service[1]) == 0 {
+				continue
+			}
+			// Prefer https when a registry lists several servers.
+			server := service[1][0]
+			for _, u := range service[1] {
+				if strings.HasPrefix(u, "https://") {
+					server = u
+					break
+				}
+			}
+			for _, suffix := range service[0] {
+				entry.services[strings.ToLower(suffix)] = server
+			}
+		}
+		rdapBootstrapCache.Lock()
+		rdapBootstrapCache.entries[bootstrapURL] = entry
+		rdapBootstrapCache.Unlock()
+	}
+
+	labels := strings.Split(domain, ".")
+	for i := range labels {
+		if server, ok := entry.services[strings.Join(labels[i:], ".")]; ok {
+			return server, nil
+		}
+	}
+	return "", fmt.Errorf("no RDAP server is registered for %s", domain)
+}
+
+var errRDAPNotFound = errors.New("not found")
+
+func getRDAP(ctx context.Context, client *http.Client, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("unable to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/rdap+json, application/json")
+	req.Header.Set("User-Agent", "OpenStatus/1.0")
+
+	res, err := client.Do(req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return &CheckError{Msg: fmt.Sprintf("timeout querying %s", url), Err: err}
+		}
+		return fmt.Errorf("unable to query %s: %w", url, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return errRDAPNotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", res.StatusCode, url)
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("unable to read response from %s: %w", url, err)
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("invalid RDAP response from %s: %w", url, err)
+	}
+	return nil
+}
+
+// LookupDomain queries RDAP for the registration of domain and reports its
+// expiration date, registrar and status values. Without a base URL the
+// server comes from the bootstrap registry; the phases are the bootstrap and
+// the lookup.
+func LookupDomain(ctx context.Context, client *http.Client, domain string, opts RDAPOptions, timeout time.Duration) (DomainResult, error) {
+	domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+	if !strings.Contains(domain, ".") {
+		return DomainResult{}, fmt.Errorf("invalid domain %q", domain)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	var phases []PhaseTiming
+
+	base := opts.BaseURL
+	if base == "" {
+		bootstrapURL := opts.BootstrapURL
+		if bootstrapURL == "" {
+			bootstrapURL = DefaultRDAPBootstrapURL
+		}
+		server, err := rdapServer(ctx, client, bootstrapURL, domain)
+		if err != nil {
+			return DomainResult{}, err
+		}
+		base = server
+		phases = append(phases, PhaseTiming{Name: "bootstrap", Start: start.UnixMilli(), Done: time.Now().UnixMilli()})
+	}
+
+	lookupStart := time.Now()
+	var res rdapDomain
+	err := getRDAP(ctx, client, strings.TrimSuffix(base, "/")+"/domain/"+domain, &res)
+	if errors.Is(err, errRDAPNotFound) {
+		return DomainResult{}, fmt.Errorf("domain %s is not registered", domain)
+	}
+	if err != nil {
+		return DomainResult{}, err
+	}
+	done := time.Now()
+	phases = append(phases, PhaseTiming{Name: "lookup", Start: lookupStart.UnixMilli(), Done: done.UnixMilli()})
+
+	result := DomainResult{
+		Domain:    domain,
+		Registrar: findRegistrar(res.Entities),
+		Statuses:  make([]string, 0, len(res.Status)),
+		Timing: TCPResponseTiming{
+			TCPStart: start.UnixMilli(),
+			TCPDone:  done.UnixMilli(),
+			Phases:   phases,
+		},
+	}
+	for _, status := range res.Status {
+		result.Statuses = append(result.Statuses, eppStatus(status))
+	}
+	for _, e := range res.Events {
+		if e.Action != "expiration" {
+			continue
+		}
+		expiration, err := time.Parse(time.RFC3339, e.Date)
+		if err != nil {
+			return DomainResult{}, fmt.Errorf("invalid expiration date %q: %w", e.Date, err)
+		}
+		result.Expiration = expiration
+	}
+	return result, nil
+}
@@ -0,0 +1,84 @@
+package checker_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+)
+
+const rdapDomainBody = `{
+	"objectClassName": "domain",
+	"ldhName": "OPENSTATUS.DEV",
+	"status": ["client transfer prohibited", "active"],
+	"events": [
+		{"eventAction": "registration", "eventDate": "2023-01-02T00:00:00Z"},
+		{"eventAction": "expiration", "eventDate": "2027-01-02T10:00:00Z"}
+	],
+	"entities": [
+		{"roles": ["technical"], "vcardArray": ["vcard", [["fn", {}, "text", "Someone Else"]]]},
+		{"roles": ["registrar"], "vcardArray": ["vcard", [["version", {}, "text", "4.0"], ["fn", {}, "text", "Example Registrar, Inc."]]]}
+	]
+}`
+
+// rdapRegistry serves an IANA style bootstrap file pointing .dev at itself
+// and answers domain lookups for openstatus.dev only.
+func rdapRegistry(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	mux.HandleFunc("/dns.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"version": "1.0", "services": [[["com", "net"], ["https://rdap.example.com/"]], [["dev"], ["%s/rdap/"]]]}`, srv.URL)
+	})
+	mux.HandleFunc("/rdap/domain/{name}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("name") != "openstatus.dev" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/rdap+json")
+		fmt.Fprint(w, rdapDomainBody)
+	})
+	return srv
+}
+
+func TestLookupDomain(t *testing.T) {
+	ctx := t.Context()
+	srv := rdapRegistry(t)
+
+	t.Run("bootstrap then lookup", func(t *testing.T) {
+		res, err := checker.LookupDomain(ctx, srv.Client(), "OpenStatus.dev.", checker.RDAPOptions{BootstrapURL: srv.URL + "/dns.json"}, 5*time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, "openstatus.dev", res.Domain)
+		assert.Equal(t, "Example Registrar, Inc.", res.Registrar)
+		assert.Equal(t, []string{"clientTransferProhibited", "active"}, res.Statuses)
+		assert.Equal(t, time.Date(2027, 1, 2, 10, 0, 0, 0, time.UTC), res.Expiration.UTC())
+		assert.Equal(t, []string{"bootstrap", "lookup"}, phaseNames(res.Timing))
+		assert.Equal(t, int64(30), res.DaysUntilExpiry(time.Date(2026, 12, 2, 12, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("base url skips the bootstrap", func(t *testing.T) {
+		res, err := checker.LookupDomain(ctx, srv.Client(), "openstatus.dev", checker.RDAPOptions{BaseURL: srv.URL + "/rdap"}, 5*time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"lookup"}, phaseNames(res.Timing))
+	})
+
+	t.Run("unregistered domain", func(t *testing.T) {
+		_, err := checker.LookupDomain(ctx, srv.Client(), "missing.dev", checker.RDAPOptions{BaseURL: srv.URL + "/rdap/"}, 5*time.Second)
+		require.Error(t, err)
+		assert.Equal(t, "domain missing.dev is not registered", err.Error())
+	})
+
+	t.Run("tld without rdap server", func(t *testing.T) {
+		_, err := checker.LookupDomain(ctx, srv.Client(), "example.org", checker.RDAPOptions{BootstrapURL: srv.URL + "/dns.json"}, 5*time.Second)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no RDAP server is registered")
+	})
+}
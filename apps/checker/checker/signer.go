@@ -0,0 +1,204 @@
+package checker
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openstatushq/openstatus/apps/checker/request"
+)
+
+// RequestSigner signs a fully built request; body is the payload that is sent.
+type RequestSigner interface {
+	Sign(req *http.Request, body []byte, now time.Time) error
+}
+
+// NewRequestSigner returns the signer configured in auth, or nil when the
+// request is not signed.
+func NewRequestSigner(auth *request.HTTPAuth) RequestSigner {
+	switch {
+	case auth == nil:
+		return nil
+	case auth.HMAC != nil:
+		return hmacSigner{cfg: *auth.HMAC}
+	case auth.SigV4 != nil:
+		return sigV4Signer{cfg: *auth.SigV4}
+	default:
+		return nil
+	}
+}
+
+// hmacSigner signs "timestamp\nMETHOD\n/path?query\nbody" and sends the hex
+// digest along with the timestamp, so the receiver can reject replays.
+type hmacSigner struct {
+	cfg request.HMACSigning
+}
+
+func (s hmacSigner) Sign(req *http.Request, body []byte, now time.Time) error {
+	var newHash func() hash.Hash
+	switch s.cfg.Algorithm {
+	case "", "sha256":
+		newHash = sha256.New
+	case "sha512":
+		newHash = sha512.New
+	default:
+		return fmt.Errorf("unsupported HMAC algorithm %q", s.cfg.Algorithm)
+	}
+
+	signatureHeader := s.cfg.SignatureHeader
+	if signatureHeader == "" {
+		signatureHeader = "X-Signature"
+	}
+	timestampHeader := s.cfg.TimestampHeader
+	if timestampHeader == "" {
+		timestampHeader = "X-Timestamp"
+	}
+
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	mac := hmac.New(newHash, []byte(s.cfg.Secret))
+	fmt.Fprintf(mac, "%s\n%s\n%s\n", timestamp, req.Method, req.URL.RequestURI())
+	mac.Write(body)
+
+	req.Header.Set(timestampHeader, timestamp)
+	req.Header.Set(signatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	return nil
+}
+
+// sigV4Signer implements AWS Signature Version 4 with the signature in the
+// Authorization header.
+type sigV4Signer struct {
+	cfg request.SigV4Signing
+}
+
+const sigV4Algorithm = "AWS4-HMAC-SHA256"
+
+func (s sigV4Signer) Sign(req *http.Request, body []byte, now time.Time) error {
+	if s.cfg.AccessKeyID == "" || s.cfg.SecretAccessKey == "" || s.cfg.Region == "" {
+		return fmt.Errorf("SigV4 signing needs an access key, a secret key and a region")
+	}
+	service := s.cfg.Service
+	if service == "" {
+		service = "execute-api"
+	}
+
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	if s.cfg.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.cfg.SessionToken)
+	}
+
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	for _, name := range []string{"X-Amz-Date", "X-Amz-Security-Token"} {
+		if value := req.Header.Get(name); value != "" {
+			headers[strings.ToLower(name)] = value
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	payloadHash := sha256.Sum256(body)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		sigV4Path(req.URL),
+		sigV4Query(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+
+	scope := strings.Join([]string{date, s.cfg.Region, service, "aws4_request"}, "/")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{sigV4Algorithm, amzDate, scope, hex.EncodeToString(requestHash[:])}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), date)
+	key = hmacSHA256(key, s.cfg.Region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		sigV4Algorithm, s.cfg.AccessKeyID, scope, signedHeaders, signature))
+	return nil
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// sigV4Path encodes the already escaped path once more, as every service
+// but S3 expects.
+func sigV4Path(u *url.URL) string {
+	path := u.EscapedPath()
+	if path == "" {
+		return "/"
+	}
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = sigV4Escape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// sigV4Query sorts the encoded parameters by name, then value.
+func sigV4Query(query url.Values) string {
+	type pair struct{ key, value string }
+	var pairs []pair
+	for key, values := range query {
+		for _, value := range values {
+			pairs = append(pairs, pair{sigV4Escape(key), sigV4Escape(value)})
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].key != pairs[j].key {
+			return pairs[i].key < pairs[j].key
+		}
+		return pairs[i].value < pairs[j].value
+	})
+
+	encoded := make([]string, len(pairs))
+	for i, p := range pairs {
+		encoded[i] = p.key + "=" + p.value
+	}
+	return strings.Join(encoded, "&")
+}
+
+// sigV4Escape percent-encodes everything but the RFC 3986 unreserved
+// characters.
+func sigV4Escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
@@ -0,0 +1,91 @@
+package checker_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/request"
+)
+
+func TestSigV4Signer(t *testing.T) {
+	// The get-vanilla case of the AWS SigV4 test suite.
+	signer := checker.NewRequestSigner(&request.HTTPAuth{SigV4: &request.SigV4Signing{
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+		Region:          "us-east-1",
+		Service:         "service",
+	}})
+	req, err := http.NewRequest(http.MethodGet, "https://example.amazonaws.com/", nil)
+	require.NoError(t, err)
+
+	require.NoError(t, signer.Sign(req, nil, time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)))
+	assert.Equal(t, "20150830T123600Z", req.Header.Get("X-Amz-Date"))
+	assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, "+
+		"SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31",
+		req.Header.Get("Authorization"))
+
+	t.Run("missing credentials are rejected", func(t *testing.T) {
+		signer := checker.NewRequestSigner(&request.HTTPAuth{SigV4: &request.SigV4Signing{Region: "us-east-1"}})
+		assert.Error(t, signer.Sign(req, nil, time.Now()))
+	})
+}
+
+func TestHttp_HMACSignature(t *testing.T) {
+	const secret = "webhook-secret"
+
+	// The receiver recomputes the signature the way a webhook would.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp := r.Header.Get("X-Hook-Timestamp")
+
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(timestamp + "\n" + r.Method + "\n" + r.URL.RequestURI() + "\n" + string(body)))
+		if !hmac.Equal([]byte(hex.EncodeToString(mac.Sum(nil))), []byte(r.Header.Get("X-Hook-Signature"))) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	check := func(secret string) request.HttpCheckerRequest {
+		return request.HttpCheckerRequest{
+			Method: http.MethodPost,
+			URL:    srv.URL + "/hooks/health?source=openstatus",
+			Body:   `{"ping":true}`,
+			Auth: &request.HTTPAuth{HMAC: &request.HMACSigning{
+				Secret:          secret,
+				SignatureHeader: "X-Hook-Signature",
+				TimestampHeader: "X-Hook-Timestamp",
+			}},
+		}
+	}
+	client := &http.Client{Timeout: time.Second}
+
+	res, err := checker.Http(t.Context(), client, check(secret))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, res.Status)
+
+	res, err = checker.Http(t.Context(), client, check("wrong-secret"))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, res.Status)
+
+	t.Run("an unknown algorithm fails before sending", func(t *testing.T) {
+		req := check(secret)
+		req.Auth.HMAC.Algorithm = "md5"
+
+		_, err := checker.Http(t.Context(), client, req)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported HMAC algorithm")
+	})
+}
@@ -0,0 +1,180 @@
+package checker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+type SSHOptions struct {
+	// HostKeyFingerprint pins the server key (SHA256:...); empty accepts any.
+	HostKeyFingerprint string
+	Username           string
+	PrivateKey         string
+	Passphrase         string
+}
+
+type SSHResult struct {
+	Timing             TCPResponseTiming
+	Banner             string
+	HostKeyType        string
+	HostKeyFingerprint string
+	Authenticated      bool
+}
+
+// ErrHostKeyMismatch is returned when the server presents a key other than
+// the pinned one.
+var ErrHostKeyMismatch = errors.New("host key mismatch")
+
+// bannerConn records the server identification line, which the client only
+// exposes once authentication succeeded.
+type bannerConn struct {
+	net.Conn
+	mu       sync.Mutex
+	buf      bytes.Buffer
+	bannerAt time.Time
+}
+
+func (c *bannerConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	c.mu.Lock()
+	if c.bannerAt.IsZero() && n > 0 {
+		c.buf.Write(p[:n])
+		if bytes.Contains(c.buf.Bytes(), []byte("\n")) {
+			c.bannerAt = time.Now()
+		}
+	}
+	c.mu.Unlock()
+	return n, err
+}
+
+func (c *bannerConn) banner() (string, time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Servers may send other lines before the version (RFC 4253 4.2).
+	for _, line := range strings.Split(c.buf.String(), "\n") {
+		if strings.HasPrefix(line, "SSH-") {
+			return strings.TrimRight(line, "\r"), c.bannerAt
+		}
+	}
+	return "", c.bannerAt
+}
+
+// normalizeFingerprint accepts the pin with or without its SHA256: prefix.
+func normalizeFingerprint(fp string) string {
+	fp = strings.TrimSpace(fp)
+	if !strings.HasPrefix(fp, "SHA256:") {
+		fp = "SHA256:" + fp
+	}
+	return strings.TrimRight(fp, "=")
+}
+
+// PingSSH runs the SSH handshake against addr (host:port), checks the host key
+// against the pin and, when a username is given, authenticates with the
+// private key.
+func PingSSH(ctx context.Context, addr string, opts SSHOptions, timeout time.Duration) (SSHResult, error) {
+	var auth []ssh.AuthMethod
+	if opts.Username != "" {
+		if opts.PrivateKey == "" {
+			return SSHResult{}, errors.New("a private key is required to authenticate")
+		}
+		var signer ssh.Signer
+		var err error
+		if opts.Passphrase != "" {
+			signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(opts.PrivateKey), []byte(opts.Passphrase))
+		} else {
+			signer, err = ssh.ParsePrivateKey([]byte(opts.PrivateKey))
+		}
+		if err != nil {
+			return SSHResult{}, fmt.Errorf("invalid private key: %w", err)
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	raw, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return SSHResult{}, dialError(err, timeout)
+	}
+	defer raw.Close()
+	connected := time.Now()
+	if deadline, ok := ctx.Deadline(); ok {
+		raw.SetDeadline(deadline)
+	}
+	conn := &bannerConn{Conn: raw}
+
+	var result SSHResult
+	var kexDone time.Time
+	var hostKeyErr error
+	user := opts.Username
+	if user == "" {
+		user = "openstatus"
+	}
+	config := &ssh.ClientConfig{
+		User: user,
+		Auth: auth,
+		HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			kexDone = time.Now()
+			result.HostKeyType = key.Type()
+			result.HostKeyFingerprint = ssh.FingerprintSHA256(key)
+			if opts.HostKeyFingerprint != "" && normalizeFingerprint(opts.HostKeyFingerprint) != normalizeFingerprint(result.HostKeyFingerprint) {
+				hostKeyErr = fmt.Errorf("%w: expected %s, got %s", ErrHostKeyMismatch, opts.HostKeyFingerprint, result.HostKeyFingerprint)
+				return hostKeyErr
+			}
+			return nil
+		},
+		Timeout: timeout,
+	}
+
+	client, chans, reqs, err := ssh.NewClientConn(conn, addr, config)
+	done := time.Now()
+	result.Banner, _ = conn.banner()
+	switch {
+	case hostKeyErr != nil:
+		return SSHResult{}, hostKeyErr
+	case err == nil:
+		result.Authenticated = opts.Username != ""
+		ssh.NewClient(client, chans, reqs).Close()
+	case opts.Username == "" && !kexDone.IsZero():
+		// Without credentials the server is expected to turn us away once
+		// the key exchange is complete.
+	default:
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return SSHResult{}, timeoutError(err, timeout)
+		}
+		if !kexDone.IsZero() {
+			return SSHResult{}, fmt.Errorf("%w: %w", ErrAuthFailed, err)
+		}
+		return SSHResult{}, fmt.Errorf("handshake failed: %w", err)
+	}
+
+	_, bannerAt := conn.banner()
+	if bannerAt.IsZero() {
+		bannerAt = connected
+	}
+	phases := []PhaseTiming{
+		{Name: "connect", Start: start.UnixMilli(), Done: connected.UnixMilli()},
+		{Name: "banner", Start: connected.UnixMilli(), Done: bannerAt.UnixMilli()},
+		{Name: "handshake", Start: bannerAt.UnixMilli(), Done: kexDone.UnixMilli()},
+	}
+	if result.Authenticated {
+		phases = append(phases, PhaseTiming{Name: "auth", Start: kexDone.UnixMilli(), Done: done.UnixMilli()})
+	}
+	result.Timing = TCPResponseTiming{
+		TCPStart: start.UnixMilli(),
+		TCPDone:  done.UnixMilli(),
+		Phases:   phases,
+	}
+	return result, nil
+}
@@ -0,0 +1,121 @@
+package checker_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+)
+
+// newClientKey returns a PEM encoded private key and its public half.
+func newClientKey(t *testing.T) (string, ssh.PublicKey) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(priv, "")
+	require.NoError(t, err)
+	sshPub, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(block)), sshPub
+}
+
+// fakeSSH accepts public key logins for authorized and closes the session
+// right after the handshake.
+func fakeSSH(t *testing.T, authorized ssh.PublicKey) (string, ssh.PublicKey) {
+	t.Helper()
+	_, hostPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostKey, err := ssh.NewSignerFromKey(hostPriv)
+	require.NoError(t, err)
+
+	config := &ssh.ServerConfig{
+		ServerVersion: "SSH-2.0-OpenSSH_9.6 fake",
+		PublicKeyCallback: func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if authorized != nil && bytes.Equal(key.Marshal(), authorized.Marshal()) {
+				return &ssh.Permissions{}, nil
+			}
+			return nil, errors.New("unknown key")
+		},
+	}
+	config.AddHostKey(hostKey)
+
+	addr := listen(t, func(conn net.Conn) {
+		sc, chans, reqs, err := ssh.NewServerConn(conn, config)
+		if err != nil {
+			return
+		}
+		defer sc.Close()
+		go ssh.DiscardRequests(reqs)
+		for ch := range chans {
+			ch.Reject(ssh.Prohibited, "no sessions")
+		}
+	})
+	return addr, hostKey.PublicKey()
+}
+
+func TestPingSSH(t *testing.T) {
+	ctx := t.Context()
+	privateKey, publicKey := newClientKey(t)
+	addr, hostKey := fakeSSH(t, publicKey)
+	fingerprint := ssh.FingerprintSHA256(hostKey)
+
+	t.Run("handshake without credentials", func(t *testing.T) {
+		res, err := checker.PingSSH(ctx, addr, checker.SSHOptions{HostKeyFingerprint: fingerprint}, 5*time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, "SSH-2.0-OpenSSH_9.6 fake", res.Banner)
+		assert.Equal(t, ssh.KeyAlgoED25519, res.HostKeyType)
+		assert.Equal(t, fingerprint, res.HostKeyFingerprint)
+		assert.False(t, res.Authenticated)
+		assert.Equal(t, []string{"connect", "banner", "handshake"}, phaseNames(res.Timing))
+	})
+
+	t.Run("pin without prefix", func(t *testing.T) {
+		_, err := checker.PingSSH(ctx, addr, checker.SSHOptions{HostKeyFingerprint: strings.TrimPrefix(fingerprint, "SHA256:")}, 5*time.Second)
+		require.NoError(t, err)
+	})
+
+	t.Run("changed host key", func(t *testing.T) {
+		_, err := checker.PingSSH(ctx, addr, checker.SSHOptions{HostKeyFingerprint: "SHA256:AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"}, 5*time.Second)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, checker.ErrHostKeyMismatch)
+		assert.Contains(t, err.Error(), fingerprint)
+	})
+
+	t.Run("public key authentication", func(t *testing.T) {
+		res, err := checker.PingSSH(ctx, addr, checker.SSHOptions{
+			HostKeyFingerprint: fingerprint,
+			Username:           "probe",
+			PrivateKey:         privateKey,
+		}, 5*time.Second)
+		require.NoError(t, err)
+		assert.True(t, res.Authenticated)
+		assert.Equal(t, []string{"connect", "banner", "handshake", "auth"}, phaseNames(res.Timing))
+	})
+
+	t.Run("rejected key", func(t *testing.T) {
+		otherKey, _ := newClientKey(t)
+		_, err := checker.PingSSH(ctx, addr, checker.SSHOptions{Username: "probe", PrivateKey: otherKey}, 5*time.Second)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "authentication failed")
+	})
+
+	t.Run("not an ssh server", func(t *testing.T) {
+		addr := listen(t, func(conn net.Conn) {
+			conn.Write([]byte("220 mail.test ESMTP\r\n"))
+		})
+		_, err := checker.PingSSH(ctx, addr, checker.SSHOptions{}, time.Second)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "handshake failed")
+	})
+}
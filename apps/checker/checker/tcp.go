@@ -3,7 +3,6 @@ package checker
 import (
 	"fmt"
 	"net"
-	"strings"
 	"time"
 )
 
@@ -16,6 +15,8 @@ type TCPData struct {
 type TCPResponseTiming struct {
 	TCPStart int64 `json:"tcpStart"`
 	TCPDone  int64 `json:"tcpDone"`
+	// Phases breaks protocol-level checks down further; plain TCP leaves it empty.
+	Phases []PhaseTiming `json:"phases,omitempty"`
 }
 
 type TCPResponse struct {
@@ -29,6 +30,7 @@ type TCPResponse struct {
 	Latency      int64             `json:"latency"`
 	Timing       TCPResponseTiming `json:"timing"`
 	Error        uint8             `json:"error,omitempty"`
+	ErrorCode    ErrorCode         `json:"errorCode,omitempty"`
 }
 
 func PingTCP(timeout int, url string) (TCPResponseTiming, error) {
@@ -37,11 +39,11 @@ func PingTCP(timeout int, url string) (TCPResponseTiming, error) {
 	stop := time.Now().UTC().UnixMilli()
 
 	if err != nil {
-		if e := err.(*net.OpError).Timeout(); e {
-			return TCPResponseTiming{}, fmt.Errorf("timeout after %d ms", timeout*1000)
-		}
-		if strings.Contains(err.Error(), "connection refused") {
-			return TCPResponseTiming{}, fmt.Errorf("connection refused")
+		switch Classify(err) {
+		case ErrorTimeoutConnect:
+			return TCPResponseTiming{}, &CheckError{Msg: fmt.Sprintf("timeout after %d ms", timeout*1000), Err: err}
+		case ErrorConnectionRefused:
+			return TCPResponseTiming{}, &CheckError{Msg: "connection refused", Err: err}
 		}
 		return TCPResponseTiming{}, fmt.Errorf("dial error: %w", err)
 	}
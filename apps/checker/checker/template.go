@@ -0,0 +1,181 @@
+package checker
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/openstatushq/openstatus/apps/checker/request"
+)
+
+// TemplateEnv resolves {{env "NAME"}} expressions. Only the private location
+// agent provides one, so secrets can stay on the user's own hosts; without it
+// env lookups are rejected.
+type TemplateEnv func(name string) (string, bool)
+
+var templateExpr = regexp.MustCompile(`\{\{\s*(.*?)\s*\}\}`)
+
+// RenderRequest renders the template expressions in the URL, header values
+// and body of a request:
+//
+//	{{now.unix}} {{now.unix_ms}} {{now.iso}} {{uuid}} {{random.int 1 100}} {{env "API_KEY"}}
+//
+// Unknown expressions are left as they are, so bodies that happen to contain
+// braces keep working. Callers render once per attempt, so each attempt gets
+// fresh timestamps and nonces, and use the rendered request for the check,
+// its diagnosis and its logs.
+func RenderRequest(req request.HttpCheckerRequest, env TemplateEnv) (request.HttpCheckerRequest, error) {
+	return renderRequest(req, time.Now(), env)
+}
+
+// RenderGraphQLRequest renders the HTTP request of a GraphQL check and its
+// variables.
+func RenderGraphQLRequest(req request.GraphQLCheckerRequest, env TemplateEnv) (request.GraphQLCheckerRequest, error) {
+	now := time.Now()
+	var err error
+
+	if req.HttpCheckerRequest, err = renderRequest(req.HttpCheckerRequest, now, env); err != nil {
+		return req, err
+	}
+	if len(req.Variables) > 0 {
+		variables, err := renderTemplate(string(req.Variables), now, env)
+		if err != nil {
+			return req, err
+		}
+		req.Variables = json.RawMessage(variables)
+	}
+
+	return req, nil
+}
+
+func renderRequest(req request.HttpCheckerRequest, now time.Time, env TemplateEnv) (request.HttpCheckerRequest, error) {
+	var err error
+
+	if req.URL, err = renderTemplate(req.URL, now, env); err != nil {
+		return req, err
+	}
+	if req.Body, err = renderTemplate(req.Body, now, env); err != nil {
+		return req, err
+	}
+	if len(req.Headers) > 0 {
+		headers := make([]struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		}, len(req.Headers))
+		copy(headers, req.Headers)
+		for i := range headers {
+			if headers[i].Value, err = renderTemplate(headers[i].Value, now, env); err != nil {
+				return req, err
+			}
+		}
+		req.Headers = headers
+	}
+
+	return req, nil
+}
+
+func renderTemplate(s string, now time.Time, env TemplateEnv) (string, error) {
+	if !strings.Contains(s, "{{") {
+		return s, nil
+	}
+
+	var renderErr error
+	rendered := templateExpr.ReplaceAllStringFunc(s, func(match string) string {
+		expr := templateExpr.FindStringSubmatch(match)[1]
+		value, ok, err := evalTemplateExpr(expr, now, env)
+		if err != nil {
+			if renderErr == nil {
+				renderErr = fmt.Errorf("template %q: %w", match, err)
+			}
+			return match
+		}
+		if !ok {
+			return match
+		}
+		return value
+	})
+
+	return rendered, renderErr
+}
+
+// evalTemplateExpr reports false for an expression it does not know.
+func evalTemplateExpr(expr string, now time.Time, env TemplateEnv) (string, bool, error) {
+	args, err := templateArgs(expr)
+	if err != nil || len(args) == 0 {
+		return "", false, nil
+	}
+
+	switch args[0] {
+	case "now.unix":
+		return strconv.FormatInt(now.Unix(), 10), true, nil
+	case "now.unix_ms":
+		return strconv.FormatInt(now.UnixMilli(), 10), true, nil
+	case "now.iso":
+		return now.UTC().Format(time.RFC3339), true, nil
+	case "uuid":
+		return uuid.NewString(), true, nil
+	case "random.int":
+		if len(args) != 3 {
+			return "", true, fmt.Errorf("random.int takes a minimum and a maximum")
+		}
+		lo, err := strconv.Atoi(args[1])
+		if err != nil {
+			return "", true, fmt.Errorf("invalid minimum: %w", err)
+		}
+		hi, err := strconv.Atoi(args[2])
+		if err != nil {
+			return "", true, fmt.Errorf("invalid maximum: %w", err)
+		}
+		if hi < lo {
+			return "", true, fmt.Errorf("maximum %d is below minimum %d", hi, lo)
+		}
+		return strconv.Itoa(lo + rand.IntN(hi-lo+1)), true, nil
+	case "env":
+		if len(args) != 2 {
+			return "", true, fmt.Errorf("env takes a variable name")
+		}
+		if env == nil {
+			return "", true, fmt.Errorf("env is only available on private locations")
+		}
+		value, ok := env(args[1])
+		if !ok {
+			return "", true, fmt.Errorf("environment variable %s is not set", args[1])
+		}
+		return value, true, nil
+	default:
+		return "", false, nil
+	}
+}
+
+// templateArgs splits an expression on spaces; arguments may be quoted.
+func templateArgs(expr string) ([]string, error) {
+	var args []string
+	for rest := strings.TrimSpace(expr); rest != ""; rest = strings.TrimSpace(rest) {
+		if rest[0] == '"' {
+			quoted, err := strconv.QuotedPrefix(rest)
+			if err != nil {
+				return nil, err
+			}
+			arg, err := strconv.Unquote(quoted)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			rest = rest[len(quoted):]
+			continue
+		}
+		end := strings.IndexByte(rest, ' ')
+		if end < 0 {
+			end = len(rest)
+		}
+		args = append(args, rest[:end])
+		rest = rest[end:]
+	}
+	return args, nil
+}
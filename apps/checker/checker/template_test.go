@@ -0,0 +1,92 @@
+package checker_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/request"
+)
+
+func TestRenderRequest(t *testing.T) {
+	check := func(body string) request.HttpCheckerRequest {
+		req := request.HttpCheckerRequest{
+			Method: http.MethodPost,
+			URL:    "https://openstat.us/?ts={{now.unix}}",
+			Body:   body,
+		}
+		req.Headers = append(req.Headers, struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		}{Key: "X-Nonce", Value: "{{ uuid }}"})
+		return req
+	}
+
+	t.Run("every render gets fresh values", func(t *testing.T) {
+		req := check(`{"n": {{random.int 1 6}}, "at": "{{now.iso}}", "raw": "{{unknown}}"}`)
+
+		first, err := checker.RenderRequest(req, nil)
+		require.NoError(t, err)
+		second, err := checker.RenderRequest(req, nil)
+		require.NoError(t, err)
+
+		ts, err := strconv.ParseInt(first.URL[len("https://openstat.us/?ts="):], 10, 64)
+		require.NoError(t, err)
+		assert.InDelta(t, time.Now().Unix(), ts, 5)
+		assert.Len(t, first.Headers[0].Value, 36)
+		assert.NotEqual(t, first.Headers[0].Value, second.Headers[0].Value)
+		assert.Regexp(t, `^\{"n": [1-6], "at": "\d{4}-\d{2}-\d{2}T[^"]+", "raw": "\{\{unknown\}\}"\}$`, first.Body)
+
+		// The request itself is not modified.
+		assert.Equal(t, "{{ uuid }}", req.Headers[0].Value)
+	})
+
+	t.Run("env is refused without an environment", func(t *testing.T) {
+		_, err := checker.RenderRequest(check(`{"key": "{{env "API_KEY"}}"}`), nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "only available on private locations")
+	})
+
+	t.Run("env reads from the given environment", func(t *testing.T) {
+		env := func(name string) (string, bool) {
+			if name == "API_KEY" {
+				return "s3cret", true
+			}
+			return "", false
+		}
+
+		rendered, err := checker.RenderRequest(check(`{"key": "{{env "API_KEY"}}"}`), env)
+		require.NoError(t, err)
+		assert.Equal(t, `{"key": "s3cret"}`, rendered.Body)
+
+		_, err = checker.RenderRequest(check(`{{env "MISSING"}}`), env)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "MISSING is not set")
+	})
+
+	t.Run("invalid arguments are rejected", func(t *testing.T) {
+		_, err := checker.RenderRequest(check(`{{random.int 10 1}}`), nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "below minimum")
+	})
+}
+
+func TestRenderGraphQLRequest(t *testing.T) {
+	req := request.GraphQLCheckerRequest{
+		HttpCheckerRequest: request.HttpCheckerRequest{URL: "https://openstat.us/graphql?ts={{now.unix}}"},
+		Query:              "query Me($at: String!) { me(at: $at) { id } }",
+		Variables:          json.RawMessage(`{"at": "{{now.unix}}"}`),
+	}
+
+	rendered, err := checker.RenderGraphQLRequest(req, nil)
+	require.NoError(t, err)
+	assert.NotContains(t, rendered.URL, "{{")
+	assert.NotContains(t, string(rendered.Variables), "{{")
+	assert.Equal(t, req.Query, rendered.Query)
+}
@@ -5,10 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
+	"sync"
 
-	"github.com/rs/zerolog/log"
 	"google.golang.org/api/option"
 
 	"cloud.google.com/go/auth"
@@ -26,27 +27,101 @@ type UpdateData struct {
 	Latency       int64  `json:"latency,omitempty"`
 }
 
-func UpdateStatus(ctx context.Context, updateData UpdateData) error {
+// StatusNotifier tells the workflows service that a monitor changed status.
+type StatusNotifier interface {
+	UpdateStatus(ctx context.Context, updateData UpdateData) error
+}
+
+func workflowsURL() string {
+	if url := os.Getenv("WORKFLOWS_URL"); url != "" {
+		return strings.TrimSuffix(url, "/") + "/updateStatus"
+	}
+	return "https://openstatus-workflows.fly.dev/updateStatus"
+}
 
-	url := "https://openstatus-workflows.fly.dev/updateStatus"
-	basic := "Basic " + os.Getenv("CRON_SECRET")
-	payloadBuf := new(bytes.Buffer)
-	c := os.Getenv("GCP_PRIVATE_KEY")
-	c = strings.ReplaceAll(c, "\\n", "\n")
-	opts := &auth.Options2LO{
-		Email:        os.Getenv("GCP_CLIENT_EMAIL"),
-		PrivateKey:   []byte(c),
+// NewStatusNotifierFromEnv picks the notifier named by STATUS_NOTIFIER:
+// "cloudtasks", "webhook" or "none". Unset, it is cloudtasks when
+// GCP_PROJECT_ID is set and webhook otherwise. Both post to WORKFLOWS_URL.
+func NewStatusNotifierFromEnv(ctx context.Context, httpClient *http.Client) (StatusNotifier, error) {
+	notifier := os.Getenv("STATUS_NOTIFIER")
+	if notifier == "" {
+		notifier = "webhook"
+		if os.Getenv("GCP_PROJECT_ID") != "" {
+			notifier = "cloudtasks"
+		}
+	}
+
+	switch notifier {
+	case "cloudtasks":
+		return NewCloudTasksNotifier(ctx, CloudTasksConfigFromEnv())
+	case "webhook":
+		return NewWebhookNotifier(httpClient, workflowsURL(), os.Getenv("CRON_SECRET")), nil
+	case "none":
+		return noopNotifier{}, nil
+	default:
+		return nil, fmt.Errorf("unknown status notifier %q", notifier)
+	}
+}
+
+// CloudTasksConfig locates the queue the status updates are pushed to and
+// the service account pushing them.
+type CloudTasksConfig struct {
+	ProjectID string
+	Location  string
+	Queue     string
+	// URL the tasks post the update to.
+	URL    string
+	Secret string
+
+	ClientEmail  string
+	PrivateKey   string
+	PrivateKeyID string
+}
+
+// CloudTasksConfigFromEnv reads the GCP_* variables. The queue defaults to
+// "alerting" in europe-west1.
+func CloudTasksConfigFromEnv() CloudTasksConfig {
+	config := CloudTasksConfig{
+		ProjectID:    os.Getenv("GCP_PROJECT_ID"),
+		Location:     os.Getenv("GCP_TASKS_LOCATION"),
+		Queue:        os.Getenv("GCP_TASKS_QUEUE"),
+		URL:          workflowsURL(),
+		Secret:       os.Getenv("CRON_SECRET"),
+		ClientEmail:  os.Getenv("GCP_CLIENT_EMAIL"),
+		PrivateKey:   strings.ReplaceAll(os.Getenv("GCP_PRIVATE_KEY"), "\\n", "\n"),
 		PrivateKeyID: os.Getenv("GCP_PRIVATE_KEY_ID"),
+	}
+	if config.Location == "" {
+		config.Location = "europe-west1"
+	}
+	if config.Queue == "" {
+		config.Queue = "alerting"
+	}
+	return config
+}
+
+// CloudTasksNotifier queues every update as a Cloud Tasks HTTP task, which
+// retries the delivery to the workflows service.
+type CloudTasksNotifier struct {
+	client    *cloudtasks.Client
+	queuePath string
+	url       string
+	secret    string
+}
+
+// NewCloudTasksNotifier creates the Cloud Tasks client shared by all updates.
+func NewCloudTasksNotifier(ctx context.Context, config CloudTasksConfig) (*CloudTasksNotifier, error) {
+	tp, err := auth.New2LOTokenProvider(&auth.Options2LO{
+		Email:        config.ClientEmail,
+		PrivateKey:   []byte(config.PrivateKey),
+		PrivateKeyID: config.PrivateKeyID,
 		Scopes: []string{
 			"https://www.googleapis.com/auth/cloud-platform",
 		},
 		TokenURL: "https://oauth2.googleapis.com/token",
-	}
-
-	tp, err := auth.New2LOTokenProvider(opts)
+	})
 	if err != nil {
-		log.Ctx(ctx).Error().Err(err).Msg("error while creating token provider")
-		return err
+		return nil, fmt.Errorf("unable to create token provider: %w", err)
 	}
 
 	creds := auth.NewCredentials(&auth.CredentialsOptions{
@@ -55,39 +130,121 @@ func UpdateStatus(ctx context.Context, updateData UpdateData) error {
 
 	client, err := cloudtasks.NewClient(ctx, option.WithAuthCredentials(creds))
 	if err != nil {
-		log.Ctx(ctx).Error().Err(err).Msg("error while creating cloud tasks client")
-
+		return nil, fmt.Errorf("unable to create cloud tasks client: %w", err)
 	}
-	defer client.Close()
 
-	if err := json.NewEncoder(payloadBuf).Encode(updateData); err != nil {
-		log.Ctx(ctx).Error().Err(err).Msg("error while updating status")
-		return err
+	return &CloudTasksNotifier{
+		client:    client,
+		queuePath: fmt.Sprintf("projects/%s/locations/%s/queues/%s", config.ProjectID, config.Location, config.Queue),
+		url:       config.URL,
+		secret:    config.Secret,
+	}, nil
+}
+
+func (n *CloudTasksNotifier) UpdateStatus(ctx context.Context, updateData UpdateData) error {
+	payload, err := json.Marshal(updateData)
+	if err != nil {
+		return fmt.Errorf("unable to encode payload: %w", err)
 	}
-	projectID := os.Getenv("GCP_PROJECT_ID")
-	queuePath := fmt.Sprintf("projects/%s/locations/europe-west1/queues/alerting", projectID)
+
 	req := &taskspb.CreateTaskRequest{
-		Parent: queuePath,
+		Parent: n.queuePath,
 		Task: &taskspb.Task{
 			// https://godoc.org/google.golang.org/genproto/googleapis/cloud/tasks/v2#HttpRequest
 			MessageType: &taskspb.Task_HttpRequest{
 				HttpRequest: &taskspb.HttpRequest{
 					HttpMethod: taskspb.HttpMethod_POST,
-					Url:        url,
-					Headers:    map[string]string{"Authorization": basic, "Content-Type": "application/json"},
+					Url:        n.url,
+					Headers:    map[string]string{"Authorization": "Basic " + n.secret, "Content-Type": "application/json"},
+					Body:       payload,
 				},
 			},
 		},
 	}
 
-	// Add a payload message if one is present.
-	req.Task.GetHttpRequest().Body = payloadBuf.Bytes()
+	if _, err := n.client.CreateTask(ctx, req); err != nil {
+		return fmt.Errorf("cloudtasks.CreateTask: %w", err)
+	}
+
+	return nil
+}
+
+func (n *CloudTasksNotifier) Close() error {
+	return n.client.Close()
+}
 
-	_, err = client.CreateTask(ctx, req)
+// WebhookNotifier posts every update straight to the workflows service.
+type WebhookNotifier struct {
+	httpClient *http.Client
+	url        string
+	secret     string
+}
+
+func NewWebhookNotifier(httpClient *http.Client, url, secret string) *WebhookNotifier {
+	return &WebhookNotifier{
+		httpClient: httpClient,
+		url:        url,
+		secret:     secret,
+	}
+}
+
+func (n *WebhookNotifier) UpdateStatus(ctx context.Context, updateData UpdateData) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(updateData); err != nil {
+		return fmt.Errorf("unable to encode payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, &body)
 	if err != nil {
-		log.Ctx(ctx).Error().Err(err).Msg("error while creating the cloud task")
-		return fmt.Errorf("cloudtasks.CreateTask: %w", err)
+		return fmt.Errorf("unable to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Basic "+n.secret)
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to send request: %w", err)
 	}
+	defer resp.Body.Close()
 
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+type noopNotifier struct{}
+
+func (noopNotifier) UpdateStatus(context.Context, UpdateData) error { return nil }
+
+// MemoryNotifier keeps the updates in memory, for tests to assert on them.
+type MemoryNotifier struct {
+	// Err is returned by every UpdateStatus call, after recording the update.
+	Err error
+
+	mu      sync.Mutex
+	updates []UpdateData
+}
+
+func (n *MemoryNotifier) UpdateStatus(_ context.Context, updateData UpdateData) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.updates = append(n.updates, updateData)
+	return n.Err
+}
+
+// Updates returns the updates received so far.
+func (n *MemoryNotifier) Updates() []UpdateData {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]UpdateData(nil), n.updates...)
+}
+
+// CloseNotifier releases the resources of notifiers that hold a client.
+func CloseNotifier(notifier StatusNotifier) error {
+	if c, ok := notifier.(interface{ Close() error }); ok {
+		return c.Close()
+	}
 	return nil
 }
@@ -0,0 +1,65 @@
+package checker_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+)
+
+func TestWebhookNotifier(t *testing.T) {
+	var got checker.UpdateData
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer srv.Close()
+
+	notifier := checker.NewWebhookNotifier(srv.Client(), srv.URL+"/updateStatus", "secret")
+	update := checker.UpdateData{MonitorId: "1", Status: "error", Region: "ams", CronTimestamp: 1700000000000}
+
+	require.NoError(t, notifier.UpdateStatus(context.Background(), update))
+	assert.Equal(t, "Basic secret", auth)
+	assert.Equal(t, update, got)
+}
+
+func TestWebhookNotifier_UnexpectedStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	notifier := checker.NewWebhookNotifier(srv.Client(), srv.URL, "wrong")
+	err := notifier.UpdateStatus(context.Background(), checker.UpdateData{MonitorId: "1"})
+	assert.ErrorContains(t, err, "unexpected status code: 401")
+}
+
+func TestNewStatusNotifierFromEnv(t *testing.T) {
+	t.Run("defaults to the webhook without a GCP project", func(t *testing.T) {
+		t.Setenv("STATUS_NOTIFIER", "")
+		t.Setenv("GCP_PROJECT_ID", "")
+		notifier, err := checker.NewStatusNotifierFromEnv(context.Background(), http.DefaultClient)
+		require.NoError(t, err)
+		assert.IsType(t, &checker.WebhookNotifier{}, notifier)
+	})
+
+	t.Run("cloud tasks needs a private key", func(t *testing.T) {
+		t.Setenv("STATUS_NOTIFIER", "cloudtasks")
+		t.Setenv("GCP_PRIVATE_KEY", "")
+		_, err := checker.NewStatusNotifierFromEnv(context.Background(), http.DefaultClient)
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown notifier", func(t *testing.T) {
+		t.Setenv("STATUS_NOTIFIER", "pager")
+		_, err := checker.NewStatusNotifierFromEnv(context.Background(), http.DefaultClient)
+		assert.ErrorContains(t, err, `unknown status notifier "pager"`)
+	})
+}
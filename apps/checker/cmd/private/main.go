@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -14,6 +15,10 @@ import (
 	"github.com/madflojo/tasks"
 	"github.com/openstatushq/openstatus/apps/checker/pkg/job"
 	"github.com/openstatushq/openstatus/apps/checker/pkg/scheduler"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/zerolog/log"
 
 	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
 )
@@ -21,6 +26,9 @@ import (
 const (
 	configRefreshInterval = 10 * time.Minute
 	platformTimeout       = 30 * time.Second
+	// unhealthyAfter lets a few monitor fetches fail before /healthz asks
+	// for a restart.
+	unhealthyAfter = 3 * configRefreshInterval
 )
 
 func main() {
@@ -41,14 +49,51 @@ func main() {
 		cancel()
 	}()
 	fmt.Println("Launching openstatus private location checker")
+	// Deferred first so the scheduler stops before the last metrics flush.
+	// Monitors may read secrets from this host with {{env "NAME"}}; the
+	// probe's own key is never handed out.
+	jobRunner := job.NewJobRunnerWithEnv(func(name string) (string, bool) {
+		if name == "OPENSTATUS_KEY" {
+			return "", false
+		}
+		return os.LookupEnv(name)
+	})
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := jobRunner.Shutdown(shutdownCtx); err != nil {
+			log.Error().Err(err).Msg("failed to flush otel metrics")
+		}
+	}()
+
 	s := tasks.New()
 	defer s.Stop()
 
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+
 	monitorManager := scheduler.MonitorManager{
 		Client:    getClient(apiKey),
-		JobRunner: job.NewJobRunner(),
+		JobRunner: jobRunner,
 		Scheduler: s,
+		Metrics:   scheduler.NewMetrics(registry),
+	}
+
+	server := &http.Server{
+		Addr:              getEnv("OPENSTATUS_METRICS_ADDR", ":9090"),
+		Handler:           observabilityHandler(&monitorManager, registry),
+		ReadHeaderTimeout: 10 * time.Second,
 	}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error().Err(err).Msg("metrics server stopped")
+		}
+	}()
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
 	configTicker := time.NewTicker(configRefreshInterval)
 	defer configTicker.Stop()
 
@@ -64,6 +109,28 @@ func main() {
 	}
 }
 
+// observabilityHandler serves the probe metrics on /metrics, and /healthz
+// and /readyz from the outcome of the monitor fetches.
+func observabilityHandler(mm *scheduler.MonitorManager, registry *prometheus.Registry) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		probeResponse(w, mm.Healthy(unhealthyAfter))
+	})
+	mux.HandleFunc("GET /readyz", func(w http.ResponseWriter, r *http.Request) {
+		probeResponse(w, mm.Ready())
+	})
+	return mux
+}
+
+func probeResponse(w http.ResponseWriter, err error) {
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	fmt.Fprintln(w, "ok")
+}
+
 func getEnv(key, fallback string) string {
 	if value, ok := os.LookupEnv(key); ok {
 		return value
@@ -14,9 +14,13 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/openstatushq/openstatus/apps/checker/checker"
 	"github.com/openstatushq/openstatus/apps/checker/handlers"
 
 	"github.com/openstatushq/openstatus/apps/checker/pkg/logger"
+	otelOS "github.com/openstatushq/openstatus/apps/checker/pkg/otel"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/sink"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/statuspolicy"
 	"github.com/openstatushq/openstatus/apps/checker/pkg/tinybird"
 	"github.com/rs/zerolog/log"
 	"go.opentelemetry.io/contrib/bridges/otelslog"
@@ -227,13 +231,47 @@ func main() {
 
 	defer httpClient.CloseIdleConnections()
 
-	tinybirdClient := tinybird.NewClient(httpClient, tinyBirdToken)
+	resultSink, err := sink.FromEnv(ctx, httpClient, tinyBirdToken, tinybird.BatchOptions{})
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create result sink")
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := sink.Close(shutdownCtx, resultSink); err != nil {
+			log.Error().Err(err).Msg("failed to flush results")
+		}
+	}()
+
+	notifier, err := checker.NewStatusNotifierFromEnv(ctx, httpClient)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create status notifier")
+	}
+	defer checker.CloseNotifier(notifier)
+
+	meters := otelOS.NewMeterProviders(otelOS.DefaultExportInterval)
+	loggers := otelOS.NewLoggerProviders()
+	defer func() {
+		// ctx is already cancelled here, the last export needs its own.
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := meters.Shutdown(shutdownCtx); err != nil {
+			log.Error().Err(err).Msg("failed to flush otel metrics")
+		}
+		if err := loggers.Shutdown(shutdownCtx); err != nil {
+			log.Error().Err(err).Msg("failed to flush otel logs")
+		}
+	}()
 
 	h := &handlers.Handler{
 		Secret:        cronSecret,
 		CloudProvider: cloudProvider,
 		Region:        region,
-		TbClient:      tinybirdClient,
+		Sink:          resultSink,
+		Meters:        meters,
+		Loggers:       loggers,
+		Notifier:      notifier,
+		Statuses:      statuspolicy.NewTracker(),
 	}
 
 	router := gin.New()
@@ -243,6 +281,8 @@ func main() {
 	router.POST("/checker/http", h.HTTPCheckerHandler)
 	router.POST("/checker/tcp", h.TCPHandler)
 	router.POST("/checker/dns", h.DNSHandler)
+	router.POST("/checker/graphql", h.GraphQLCheckerHandler)
+	router.POST("/checker/mqtt", h.MQTTHandler)
 	router.POST("/ping/:region", h.PingRegionHandler)
 	router.POST("/tcp/:region", h.TCPHandlerRegion)
 	router.POST("/dns/:region", h.DNSHandlerRegion)
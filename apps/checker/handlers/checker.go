@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -14,6 +15,7 @@ import (
 	"github.com/openstatushq/openstatus/apps/checker/checker"
 	"github.com/openstatushq/openstatus/apps/checker/pkg/assertions"
 	otelOS "github.com/openstatushq/openstatus/apps/checker/pkg/otel"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/statuspolicy"
 	"github.com/openstatushq/openstatus/apps/checker/request"
 )
 
@@ -42,12 +44,13 @@ type PingData struct {
 	Timestamp     int64  `json:"timestamp"`
 	StatusCode    int    `json:"statusCode,omitempty"`
 	Error         uint8  `json:"error"`
+	Diagnosis     string `json:"diagnosis,omitempty"`
+	ErrorCode     string `json:"errorCode,omitempty"`
+	TraceID       string `json:"traceId,omitempty"`
 }
 
 func (h Handler) HTTPCheckerHandler(c *gin.Context) {
 	ctx := c.Request.Context()
-	const defaultRetry = 3
-	dataSourceName := "ping_response__v8"
 
 	if c.GetHeader("Authorization") != fmt.Sprintf("Basic %s", h.Secret) {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
@@ -73,6 +76,65 @@ func (h Handler) HTTPCheckerHandler(c *gin.Context) {
 
 		return
 	}
+
+	h.runHTTPCheck(c, req, &httpProbe{req: req})
+}
+
+// httpProber runs a single attempt of an HTTP-based check and judges it, so
+// specialised checks (GraphQL) share the retry and status update flow below.
+type httpProber interface {
+	jobType() string
+	// render renders the request of the next attempt and returns its URL.
+	render() (string, error)
+	probe(ctx context.Context, client *http.Client) (checker.Response, error)
+	evaluate(data *PingData, res checker.Response) (bool, error)
+}
+
+type httpProbe struct {
+	req      request.HttpCheckerRequest
+	rendered request.HttpCheckerRequest
+}
+
+func (p *httpProbe) jobType() string { return "http" }
+
+// render has no environment to read from: env templates are only available
+// on private locations.
+func (p *httpProbe) render() (string, error) {
+	rendered, err := checker.RenderRequest(p.req, nil)
+	p.rendered = rendered
+	return rendered.URL, err
+}
+
+func (p *httpProbe) probe(ctx context.Context, client *http.Client) (checker.Response, error) {
+	return checker.AuthorizedHttp(ctx, client, p.rendered, checker.OAuth2Fetcher{})
+}
+
+func (p *httpProbe) evaluate(data *PingData, res checker.Response) (bool, error) {
+	return EvaluateHTTPAssertions(p.req.RawAssertions, *data, res)
+}
+
+func statusPolicy(p request.StatusPolicy) statuspolicy.Policy {
+	return statuspolicy.Policy{
+		FailureThreshold:  p.FailureThreshold,
+		RecoveryThreshold: p.RecoveryThreshold,
+		FlapThreshold:     p.FlapThreshold,
+		FlapWindow:        time.Duration(p.FlapWindow) * time.Millisecond,
+	}
+}
+
+// requestStatus is how a monitor status is stored with the check result.
+func requestStatus(status string) string {
+	if status == statuspolicy.StatusActive {
+		return "success"
+	}
+	return status
+}
+
+func (h Handler) runHTTPCheck(c *gin.Context, req request.HttpCheckerRequest, prober httpProber) {
+	ctx := c.Request.Context()
+	const defaultRetry = 3
+	dataSourceName := "ping_response__v8"
+
 	//  We need a new client for each request to avoid connection reuse.
 	requestClient := &http.Client{
 		Timeout: time.Duration(req.Timeout) * time.Millisecond,
@@ -117,9 +179,20 @@ func (h Handler) HTTPCheckerHandler(c *gin.Context) {
 		retry = int(req.Retry)
 	}
 
+	var attempts []otelOS.HTTPAttempt
+	if req.OtelConfig.Traces {
+		ctx = otelOS.WithCheckTrace(ctx)
+	}
+
 	op := func() error {
 		called++
-		res, err := checker.Http(ctx, requestClient, req)
+		url, err := prober.render()
+		if err != nil {
+			// Retrying would render the same request again.
+			return backoff.Permanent(fmt.Errorf("invalid request template: %w", err))
+		}
+
+		res, err := prober.probe(ctx, requestClient)
 
 		if err != nil {
 			return fmt.Errorf("unable to ping: %w", err)
@@ -141,16 +214,6 @@ func (h Handler) HTTPCheckerHandler(c *gin.Context) {
 			return fmt.Errorf("error while generating uuid %w", err)
 		}
 
-		var requestStatus = ""
-		switch req.Status {
-		case "active":
-			requestStatus = "success"
-		case "error":
-			requestStatus = "error"
-		case "degraded":
-			requestStatus = "degraded"
-		}
-
 		data := PingData{
 			ID:            id.String(),
 			Latency:       res.Latency,
@@ -166,24 +229,38 @@ func (h Handler) HTTPCheckerHandler(c *gin.Context) {
 			Headers:       string(headersAsString),
 			Body:          string(res.Body),
 			Trigger:       trigger,
-			RequestStatus: requestStatus,
+			RequestStatus: requestStatus(req.Status),
 			Message:       res.Error,
+			TraceID:       res.TraceID,
 		}
 
 		var isSuccessfull bool = true
-		isSuccessfull, err = EvaluateHTTPAssertions(req.RawAssertions, data, res)
+		isSuccessfull, err = prober.evaluate(&data, res)
 		if err != nil {
 			return err
 		}
+		attempts = append(attempts, otelOS.HTTPAttempt{Response: res, AssertionsFailed: !isSuccessfull && res.Error == ""})
 
 		// let's retry at least once if the status code is not successful.
 		if !isSuccessfull && called < retry {
 			return fmt.Errorf("unable to ping: %v with status %v", res, res.Status)
 		}
 
+		// The final failed attempt is diagnosed when no response came back at
+		// all; a response with a bad status already shows the server is reachable,
+		// and a failed token fetch never reached it.
+		if !isSuccessfull && res.Error != "" && res.ErrorCode != checker.ErrorAuthFailed {
+			diagnosis := checker.Diagnose(ctx, url, checker.DiagnosisTimeout)
+			res.Diagnosis = &diagnosis
+			data.Message = fmt.Sprintf("%s (%s)", res.Error, diagnosis.Summary)
+			if diagnosisAsString, err := json.Marshal(diagnosis); err == nil {
+				data.Diagnosis = string(diagnosisAsString)
+			}
+		}
+
 		result = res
 		result.Region = h.Region
-		result.JobType = "http"
+		result.JobType = prober.jobType()
 
 		// it's in error if not successful
 		if isSuccessfull {
@@ -199,74 +276,50 @@ func (h Handler) HTTPCheckerHandler(c *gin.Context) {
 		} else {
 			data.Error = 1
 			result.Error = "Error"
+			result.ErrorCode = checker.HTTPErrorCode(res)
+			data.ErrorCode = string(result.ErrorCode)
 		}
 
 		data.Assertions = assertionAsString
 
-		if !isSuccessfull && req.Status != "error" {
-			// Q: Why here we do not check if the status was previously active?
-			checker.UpdateStatus(ctx, checker.UpdateData{
-				MonitorId:     req.MonitorID,
-				Status:        "error",
-				StatusCode:    res.Status,
-				Region:        h.Region,
-				Message:       res.Error,
-				CronTimestamp: req.CronTimestamp,
-				Latency:       res.Latency,
-			})
-			data.RequestStatus = "error"
-		}
-		// it's degraded
-		if isSuccessfull && req.DegradedAfter > 0 && res.Latency > req.DegradedAfter && req.Status != "degraded" {
-			checker.UpdateStatus(ctx, checker.UpdateData{
-				MonitorId:     req.MonitorID,
-				Status:        "degraded",
-				Region:        h.Region,
-				StatusCode:    res.Status,
-				CronTimestamp: req.CronTimestamp,
-				Latency:       res.Latency,
-			})
-			data.RequestStatus = "degraded"
+		observed := statuspolicy.StatusActive
+		switch {
+		case !isSuccessfull:
+			observed = statuspolicy.StatusError
+		case req.DegradedAfter > 0 && res.Latency > req.DegradedAfter:
+			observed = statuspolicy.StatusDegraded
 		}
-		// it's active
-		if isSuccessfull && req.DegradedAfter == 0 && req.Status != "active" {
-			checker.UpdateStatus(ctx, checker.UpdateData{
+
+		status, report := h.Statuses.Observe(req.MonitorID, statusPolicy(req.StatusPolicy), req.Status, observed, time.Now())
+		if report {
+			update := checker.UpdateData{
 				MonitorId:     req.MonitorID,
-				Status:        "active",
-				Region:        h.Region,
+				Status:        status,
 				StatusCode:    res.Status,
-				CronTimestamp: req.CronTimestamp,
-				Latency:       res.Latency,
-			})
-			data.RequestStatus = "success"
-		}
-		// it's active
-		if isSuccessfull && res.Latency < req.DegradedAfter && req.DegradedAfter != 0 && req.Status != "active" {
-			checker.UpdateStatus(ctx, checker.UpdateData{
-				MonitorId:     req.MonitorID,
-				Status:        "active",
 				Region:        h.Region,
-				StatusCode:    res.Status,
 				CronTimestamp: req.CronTimestamp,
 				Latency:       res.Latency,
-			})
-			data.RequestStatus = "success"
+			}
+			if status == statuspolicy.StatusError {
+				update.Message = data.Message
+			}
+			h.updateStatus(ctx, update)
 		}
+		data.RequestStatus = requestStatus(status)
 
-		if err := h.TbClient.SendEvent(ctx, data, dataSourceName); err != nil {
-			log.Ctx(ctx).Error().Err(err).Msg("failed to send event to tinybird")
+		if err := h.Sink.SendEvent(ctx, data, dataSourceName); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("failed to store result")
 		}
 
-
 		e, f := c.Get("event")
 		if f {
 			t := e.(map[string]any)
 			t["checker"] = map[string]string{
-				"uri": req.URL,
+				"uri": url,
 				"workspace_id": req.WorkspaceID,
 				"monitor_id":req.MonitorID,
 				"trigger": trigger,
-				"type": "http",
+				"type": prober.jobType(),
 			}
 			c.Set("event", t)
 		}
@@ -281,6 +334,8 @@ func (h Handler) HTTPCheckerHandler(c *gin.Context) {
 			return
 		}
 
+		status, report := h.Statuses.Observe(req.MonitorID, statusPolicy(req.StatusPolicy), req.Status, statuspolicy.StatusError, time.Now())
+
 		data := PingData{
 			ID:            id.String(),
 			URL:           req.URL,
@@ -295,17 +350,18 @@ func (h Handler) HTTPCheckerHandler(c *gin.Context) {
 			Assertions:    assertionAsString,
 			Body:          "",
 			Trigger:       trigger,
-			RequestStatus: "error",
+			RequestStatus: requestStatus(status),
+			ErrorCode:     string(checker.Classify(err)),
 		}
 
-		if err := h.TbClient.SendEvent(ctx, data, dataSourceName); err != nil {
-			log.Ctx(ctx).Error().Err(err).Msg("failed to send event to tinybird")
+		if err := h.Sink.SendEvent(ctx, data, dataSourceName); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("failed to store result")
 		}
 
-		if req.Status != "error" {
-			checker.UpdateStatus(ctx, checker.UpdateData{
+		if report {
+			h.updateStatus(ctx, checker.UpdateData{
 				MonitorId:     req.MonitorID,
-				Status:        "error",
+				Status:        status,
 				Message:       err.Error(),
 				Region:        h.Region,
 				CronTimestamp: req.CronTimestamp,
@@ -314,7 +370,17 @@ func (h Handler) HTTPCheckerHandler(c *gin.Context) {
 	}
 
 	if req.OtelConfig.Endpoint != "" {
-		otelOS.RecordHTTPMetrics(ctx, req, result, h.Region)
+		h.Meters.RecordHTTPMetrics(ctx, req, result, h.Region)
+		lastAttempt := otelOS.HTTPAttempt{Response: result}
+		if len(attempts) > 0 {
+			lastAttempt = attempts[len(attempts)-1]
+		}
+		h.Loggers.RecordHTTPResult(ctx, req, lastAttempt, h.Region)
+		if req.OtelConfig.Traces {
+			otelOS.RecordHTTPTrace(ctx, req, attempts, h.Region)
+		} else {
+			otelOS.RecordHTTPSpan(ctx, req, result, h.Region)
+		}
 	}
 
 	returnData := c.Query("data")
@@ -13,6 +13,7 @@ import (
 	"github.com/openstatushq/openstatus/apps/checker/checker"
 	"github.com/openstatushq/openstatus/apps/checker/handlers"
 
+	"github.com/openstatushq/openstatus/apps/checker/pkg/statuspolicy"
 	"github.com/openstatushq/openstatus/apps/checker/pkg/tinybird"
 	"github.com/openstatushq/openstatus/apps/checker/request"
 	"github.com/stretchr/testify/assert"
@@ -31,7 +32,7 @@ func TestHandler_HTTPCheckerHandler(t *testing.T) {
 
 		region := "local"
 		h := handlers.Handler{
-			TbClient:      client,
+			Sink:          client,
 			Secret:        "",
 			CloudProvider: "fly",
 			Region:        region,
@@ -55,7 +56,7 @@ func TestHandler_HTTPCheckerHandler(t *testing.T) {
 		region := "local"
 
 		h := handlers.Handler{
-			TbClient:      client,
+			Sink:          client,
 			Secret:        "test",
 			CloudProvider: "fly",
 			Region:        region,
@@ -84,7 +85,7 @@ func TestHandler_HTTPCheckerHandler(t *testing.T) {
 		httptest.NewRecorder()
 
 		h := handlers.Handler{
-			TbClient:      client,
+			Sink:          client,
 			Secret:        "test",
 			CloudProvider: "fly",
 			Region:        region,
@@ -107,6 +108,113 @@ func TestHandler_HTTPCheckerHandler(t *testing.T) {
 		assert.Equal(t, 200, w.Code)
 		fmt.Println(w.Body.String())
 	})
+	t.Run("it should diagnose a check that got no response", func(t *testing.T) {
+		srv := httptest.NewServer(http.NotFoundHandler())
+		url := srv.URL
+		srv.Close()
+
+		h := handlers.Handler{Sink: client, Secret: "test", Region: "local"}
+		router := gin.New()
+		router.POST("/checker", h.HTTPCheckerHandler)
+
+		w := httptest.NewRecorder()
+
+		data := request.HttpCheckerRequest{
+			URL:     url,
+			Method:  "GET",
+			Status:  "error", // avoids the network UpdateStatus call
+			Timeout: 1000,
+			Retry:   1,
+		}
+		dataJson, _ := json.Marshal(data)
+		req, _ := http.NewRequest(http.MethodPost, "/checker?data=true", strings.NewReader(string(dataJson)))
+		req.Header.Set("Authorization", "Basic test")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, 200, w.Code)
+		var res checker.Response
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		if assert.NotNil(t, res.Diagnosis) {
+			assert.Equal(t, "DNS skipped, 1/1 IPs refuse connections", res.Diagnosis.Summary)
+		}
+	})
+}
+
+func TestHandler_HTTPCheckerHandlerNotifiesStatusChange(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	notifier := &checker.MemoryNotifier{}
+	h := handlers.Handler{Sink: testTinybird(t), Secret: "test", Region: "local", Notifier: notifier}
+	router := gin.New()
+	router.POST("/checker", h.HTTPCheckerHandler)
+
+	for _, status := range []string{"active", "error"} {
+		data := request.HttpCheckerRequest{
+			MonitorID:     "42",
+			URL:           srv.URL,
+			Method:        "GET",
+			Status:        status,
+			Timeout:       1000,
+			CronTimestamp: 1700000000000,
+		}
+		dataJson, _ := json.Marshal(data)
+		req, _ := http.NewRequest(http.MethodPost, "/checker", strings.NewReader(string(dataJson)))
+		req.Header.Set("Authorization", "Basic test")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	// Only the active monitor changed status.
+	updates := notifier.Updates()
+	if assert.Len(t, updates, 1) {
+		assert.Equal(t, "42", updates[0].MonitorId)
+		assert.Equal(t, "error", updates[0].Status)
+		assert.Equal(t, http.StatusInternalServerError, updates[0].StatusCode)
+	}
+}
+
+func TestHandler_HTTPCheckerHandlerFailureThreshold(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	notifier := &checker.MemoryNotifier{}
+	h := handlers.Handler{
+		Sink:     testTinybird(t),
+		Secret:   "test",
+		Region:   "local",
+		Notifier: notifier,
+		Statuses: statuspolicy.NewTracker(),
+	}
+	router := gin.New()
+	router.POST("/checker", h.HTTPCheckerHandler)
+
+	check := func() {
+		data := request.HttpCheckerRequest{
+			MonitorID:    "42",
+			URL:          srv.URL,
+			Method:       "GET",
+			Status:       "active",
+			Timeout:      1000,
+			StatusPolicy: request.StatusPolicy{FailureThreshold: 2},
+		}
+		dataJson, _ := json.Marshal(data)
+		req, _ := http.NewRequest(http.MethodPost, "/checker", strings.NewReader(string(dataJson)))
+		req.Header.Set("Authorization", "Basic test")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	check()
+	assert.Empty(t, notifier.Updates(), "a single failure must not change the status")
+
+	check()
+	updates := notifier.Updates()
+	if assert.Len(t, updates, 1) {
+		assert.Equal(t, "error", updates[0].Status)
+	}
 }
 
 func TestEvaluateAssertions_raw(t *testing.T) {
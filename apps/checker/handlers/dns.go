@@ -11,7 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/openstatushq/openstatus/apps/checker/checker"
 	"github.com/openstatushq/openstatus/apps/checker/pkg/assertions"
-	otelOS "github.com/openstatushq/openstatus/apps/checker/pkg/otel"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/statuspolicy"
 	"github.com/openstatushq/openstatus/apps/checker/request"
 	"github.com/rs/zerolog/log"
 
@@ -27,6 +27,7 @@ type DNSResponse struct {
 	RequestStatus string `json:"requestStatus,omitempty"`
 	Assertions    string `json:"assertions"`
 	Timing        string `json:"timing"`
+	ErrorCode     string `json:"errorCode,omitempty"`
 
 	Records map[string][]string `json:"records"`
 
@@ -114,13 +115,6 @@ func (h Handler) DNSHandler(c *gin.Context) {
 		return
 	}
 
-	statusMap := map[string]string{
-		"active":   "success",
-		"error":    "error",
-		"degraded": "degraded",
-	}
-	requestStatus := statusMap[req.Status]
-
 	data := DNSResponse{
 		ID:            id.String(),
 		Region:        h.Region,
@@ -129,7 +123,6 @@ func (h Handler) DNSHandler(c *gin.Context) {
 		WorkspaceID:   workspaceId,
 		MonitorID:     monitorId,
 		CronTimestamp: req.CronTimestamp,
-		RequestStatus: requestStatus,
 		Timestamp:     time.Now().UTC().UnixMilli(),
 	}
 
@@ -181,51 +174,46 @@ func (h Handler) DNSHandler(c *gin.Context) {
 		}
 	}
 
+	errorCode := dnsErrorCode(err, isSuccessful)
+
 	// Status update logic
+	observed := statuspolicy.StatusActive
 	switch {
-	case !isSuccessful:
-		log.Ctx(ctx).Debug().Msg("DNS check failed assertions")
-		data.RequestStatus = "error"
+	case errorCode != "":
+		log.Ctx(ctx).Debug().Msg("DNS check failed")
 		data.Error = 1
 		data.ErrorMessage = err.Error()
-		if req.Status != "error" {
-			checker.UpdateStatus(ctx, checker.UpdateData{
-				MonitorId:     req.MonitorID,
-				Status:        "error",
-				Region:        h.Region,
-				Message:       err.Error(),
-				CronTimestamp: req.CronTimestamp,
-				Latency:       latency,
-			})
-		}
-	case isSuccessful && req.DegradedAfter > 0 && latency > req.DegradedAfter && req.Status != "degraded":
-		checker.UpdateStatus(ctx, checker.UpdateData{
-			MonitorId:     req.MonitorID,
-			Status:        "degraded",
-			Region:        h.Region,
-			CronTimestamp: req.CronTimestamp,
-			Latency:       latency,
-		})
-		data.RequestStatus = "degraded"
-	case isSuccessful && ((req.DegradedAfter == 0 && req.Status != "active") || (latency < req.DegradedAfter && req.DegradedAfter != 0 && req.Status != "active")):
-		checker.UpdateStatus(ctx, checker.UpdateData{
+		data.ErrorCode = string(errorCode)
+		observed = statuspolicy.StatusError
+	case req.DegradedAfter > 0 && latency > req.DegradedAfter:
+		observed = statuspolicy.StatusDegraded
+	}
+
+	status, report := h.Statuses.Observe(req.MonitorID, statusPolicy(req.StatusPolicy), req.Status, observed, time.Now())
+	if report {
+		update := checker.UpdateData{
 			MonitorId:     req.MonitorID,
-			Status:        "active",
+			Status:        status,
 			Region:        h.Region,
 			CronTimestamp: req.CronTimestamp,
 			Latency:       latency,
-		})
-		data.RequestStatus = "success"
+		}
+		if status == statuspolicy.StatusError {
+			update.Message = data.ErrorMessage
+		}
+		h.updateStatus(ctx, update)
 	}
+	data.RequestStatus = requestStatus(status)
 
 	if tbEvent, err := data.tinybirdEvent(); err != nil {
 		log.Ctx(ctx).Error().Err(err).Msg("failed to marshal dns records")
-	} else if err := h.TbClient.SendEvent(ctx, tbEvent, dataSourceName); err != nil {
-		log.Ctx(ctx).Error().Err(err).Msg("failed to send event to tinybird")
+	} else if err := h.Sink.SendEvent(ctx, tbEvent, dataSourceName); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("failed to store result")
 	}
 
 	if req.OtelConfig.Endpoint != "" {
-		otelOS.RecordDNSMetrics(ctx, req, latency, err != nil || !isSuccessful, h.Region)
+		h.Meters.RecordDNSMetrics(ctx, req, latency, errorCode, h.Region)
+		h.Loggers.RecordDNSResult(ctx, req, data.Timestamp, latency, data.Records, errorCode, data.ErrorMessage, h.Region)
 	}
 
 	event, f := c.Get("event")
@@ -349,7 +337,18 @@ func (h Handler) DNSHandlerRegion(c *gin.Context) {
 	}
 
 	if req.OtelConfig.Endpoint != "" {
-		otelOS.RecordDNSMetrics(ctx, req, latency, err != nil || !isSuccessful, h.Region)
+		errorCode := dnsErrorCode(err, isSuccessful)
+		h.Meters.RecordDNSMetrics(ctx, req, latency, errorCode, h.Region)
+
+		var records map[string][]string
+		if result != nil {
+			records = checker.FormatDNSRecords(result)
+		}
+		var message string
+		if err != nil {
+			message = err.Error()
+		}
+		h.Loggers.RecordDNSResult(ctx, req, data.Timestamp, latency, records, errorCode, message, h.Region)
 	}
 
 	if err != nil {
@@ -361,8 +360,8 @@ func (h Handler) DNSHandlerRegion(c *gin.Context) {
 	if req.RequestId != 0 {
 		if tbEvent, err := data.tinybirdEvent(); err != nil {
 			log.Ctx(ctx).Error().Err(err).Msg("failed to marshal dns records")
-		} else if err := h.TbClient.SendEvent(ctx, tbEvent, dataSourceName); err != nil {
-			log.Ctx(ctx).Error().Err(err).Msg("failed to send event to tinybird")
+		} else if err := h.Sink.SendEvent(ctx, tbEvent, dataSourceName); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("failed to store result")
 		}
 	}
 
@@ -370,6 +369,19 @@ func (h Handler) DNSHandlerRegion(c *gin.Context) {
 
 }
 
+// dnsErrorCode classifies the outcome of the DNS retry loop; a lookup that
+// kept failing is an error even though no assertion ran.
+func dnsErrorCode(err error, isSuccessful bool) checker.ErrorCode {
+	switch {
+	case !isSuccessful:
+		return checker.ErrorAssertionFailed
+	case err != nil:
+		return checker.Classify(err)
+	default:
+		return ""
+	}
+}
+
 func EvaluateDNSAssertions(rawAssertions []json.RawMessage, response *checker.DnsResponse) (bool, error) {
 	for _, a := range rawAssertions {
 		var assert assertions.RecordTarget
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/assertions"
+	"github.com/openstatushq/openstatus/apps/checker/request"
+)
+
+func (h Handler) GraphQLCheckerHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	if c.GetHeader("Authorization") != fmt.Sprintf("Basic %s", h.Secret) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+
+		return
+	}
+
+	if h.CloudProvider == "fly" {
+		// if the request has been routed to a wrong region, we forward it to the correct one.
+		region := c.GetHeader("fly-prefer-region")
+		if region != "" && region != h.Region {
+			c.Header("fly-replay", fmt.Sprintf("region=%s", region))
+			c.String(http.StatusAccepted, "Forwarding request to %s", region)
+
+			return
+		}
+	}
+
+	var req request.GraphQLCheckerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("failed to decode checker request")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+
+		return
+	}
+
+	// The ingested row records the method that was actually sent.
+	req.Method = http.MethodPost
+
+	h.runHTTPCheck(c, req.HttpCheckerRequest, &graphQLProbe{req: req})
+}
+
+type graphQLProbe struct {
+	req      request.GraphQLCheckerRequest
+	rendered request.GraphQLCheckerRequest
+	last     checker.GraphQLResponse
+}
+
+func (p *graphQLProbe) jobType() string { return "graphql" }
+
+func (p *graphQLProbe) render() (string, error) {
+	rendered, err := checker.RenderGraphQLRequest(p.req, nil)
+	p.rendered = rendered
+	return rendered.URL, err
+}
+
+func (p *graphQLProbe) probe(ctx context.Context, client *http.Client) (checker.Response, error) {
+	res, err := checker.GraphQL(ctx, client, p.rendered, checker.OAuth2Fetcher{})
+	p.last = res
+
+	return res.Response, err
+}
+
+func (p *graphQLProbe) evaluate(data *PingData, res checker.Response) (bool, error) {
+	if msg := p.last.ErrorMessage(); msg != "" && data.Message == "" {
+		data.Message = msg
+	}
+
+	return EvaluateGraphQLAssertions(p.req.RawAssertions, *data, p.last)
+}
+
+// EvaluateGraphQLAssertions fails on transport errors and on a non-empty
+// errors array regardless of the configured assertions: a GraphQL server
+// reports resolver failures with a 200. jsonBody assertions are resolved
+// against `data`, every other assertion type behaves as for HTTP.
+func EvaluateGraphQLAssertions(raw []json.RawMessage, data PingData, res checker.GraphQLResponse) (bool, error) {
+	if res.Error != "" || len(res.Errors) > 0 {
+		return false, nil
+	}
+
+	httpAssertions := make([]json.RawMessage, 0, len(raw))
+	isSuccessful := true
+	for _, a := range raw {
+		var assert request.Assertion
+		if err := json.Unmarshal(a, &assert); err != nil {
+			return false, fmt.Errorf("unable to unmarshal assertion: %w", err)
+		}
+		if assert.AssertionType != request.AssertionJsonBody {
+			httpAssertions = append(httpAssertions, a)
+			continue
+		}
+
+		var target assertions.JsonBodyTarget
+		if err := json.Unmarshal(a, &target); err != nil {
+			return false, fmt.Errorf("unable to unmarshal JsonBodyTarget: %w", err)
+		}
+		isSuccessful = isSuccessful && target.JsonBodyEvaluate(res.Data)
+	}
+
+	// Without status assertions of its own, the HTTP evaluation falls back to
+	// requiring a 2xx, which is also the right default for GraphQL.
+	statusOK, err := EvaluateHTTPAssertions(httpAssertions, data, res.Response)
+	if err != nil {
+		return false, err
+	}
+
+	return isSuccessful && statusOK, nil
+}
@@ -0,0 +1,130 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/handlers"
+	"github.com/openstatushq/openstatus/apps/checker/request"
+)
+
+func TestEvaluateGraphQLAssertions(t *testing.T) {
+	marshal := func(a any) json.RawMessage {
+		b, _ := json.Marshal(a)
+		return b
+	}
+	dataAssertion := func(path string, compare request.StringComparator, target string) json.RawMessage {
+		return marshal(map[string]any{"type": "jsonBody", "path": path, "compare": compare, "target": target})
+	}
+	ok := checker.GraphQLResponse{
+		Response: checker.Response{Status: 200},
+		Data:     json.RawMessage(`{"user":{"name":"openstatus"}}`),
+	}
+
+	tests := []struct {
+		name string
+		raw  []json.RawMessage
+		res  checker.GraphQLResponse
+		want bool
+	}{
+		{name: "no assertions and no errors", res: ok, want: true},
+		{
+			name: "errors array fails a 200",
+			res:  checker.GraphQLResponse{Response: checker.Response{Status: 200}, Errors: []checker.GraphQLError{{Message: "boom"}}},
+			want: false,
+		},
+		{name: "non-2xx fails by default", res: checker.GraphQLResponse{Response: checker.Response{Status: 500}}, want: false},
+		{name: "transport error fails", res: checker.GraphQLResponse{Response: checker.Response{Error: "Timeout after 10 ms"}}, want: false},
+		{
+			name: "data assertion is resolved under data",
+			raw:  []json.RawMessage{dataAssertion("$.user.name", request.StringEquals, "openstatus")},
+			res:  ok,
+			want: true,
+		},
+		{
+			name: "data assertion failure",
+			raw:  []json.RawMessage{dataAssertion("$.user.name", request.StringEquals, "someone")},
+			res:  ok,
+			want: false,
+		},
+		{
+			name: "data assertion still requires a 2xx",
+			raw:  []json.RawMessage{dataAssertion("$.user.name", request.StringEquals, "openstatus")},
+			res:  checker.GraphQLResponse{Response: checker.Response{Status: 503}, Data: ok.Data},
+			want: false,
+		},
+		{
+			name: "status assertion overrides the 2xx default",
+			raw: []json.RawMessage{
+				marshal(map[string]any{"type": "status", "compare": "eq", "target": 207}),
+				dataAssertion("$.user.name", request.StringNotEmpty, ""),
+			},
+			res:  checker.GraphQLResponse{Response: checker.Response{Status: 207}, Data: ok.Data},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := handlers.EvaluateGraphQLAssertions(tt.raw, handlers.PingData{}, tt.res)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHandler_GraphQLCheckerHandler(t *testing.T) {
+	t.Run("it should return 401 if there's no auth", func(t *testing.T) {
+		h := handlers.Handler{Sink: testTinybird(t), Secret: "test", Region: "local"}
+		router := gin.New()
+		router.POST("/checker/graphql", h.GraphQLCheckerHandler)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/checker/graphql", strings.NewReader(`{}`))
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("it should report a GraphQL error as a failed check", func(t *testing.T) {
+		upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data":null,"errors":[{"message":"not authorised"}]}`))
+		}))
+		t.Cleanup(upstream.Close)
+
+		h := handlers.Handler{Sink: testTinybird(t), Secret: "test", Region: "local"}
+		router := gin.New()
+		router.POST("/checker/graphql", h.GraphQLCheckerHandler)
+
+		body, _ := json.Marshal(request.GraphQLCheckerRequest{
+			HttpCheckerRequest: request.HttpCheckerRequest{
+				URL:         upstream.URL,
+				MonitorID:   "1",
+				WorkspaceID: "1",
+				Status:      "error", // avoids the network UpdateStatus call
+				Timeout:     5000,
+				Retry:       1,
+			},
+			Query: "{ user { name } }",
+		})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/checker/graphql?data=true", strings.NewReader(string(body)))
+		req.Header.Set("Authorization", "Basic test")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var res checker.Response
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		assert.Equal(t, 200, res.Status)
+		assert.Equal(t, "graphql", res.JobType)
+		assert.Equal(t, "Error", res.Error)
+	})
+}
@@ -1,16 +1,43 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
 
-	"github.com/openstatushq/openstatus/apps/checker/pkg/tinybird"
+	"github.com/rs/zerolog/log"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	otelOS "github.com/openstatushq/openstatus/apps/checker/pkg/otel"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/sink"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/statuspolicy"
 )
 
 type Handler struct {
-	TbClient      tinybird.Client
+	// Sink stores the check results.
+	Sink          sink.Sink
 	Secret        string
 	CloudProvider string
 	Region        string
+	// Meters holds the OTLP meter providers of the monitors' otel endpoints.
+	Meters *otelOS.MeterProviders
+	// Loggers holds the OTLP logger providers the check results are exported to.
+	Loggers *otelOS.LoggerProviders
+	// Notifier is told about status changes. When nil they are not reported.
+	Notifier checker.StatusNotifier
+	// Statuses counts the consecutive results of the monitors for their
+	// status policy. When nil a single check changes the status.
+	Statuses *statuspolicy.Tracker
+}
+
+// updateStatus reports a status change. A failed report is only logged: the
+// next check reports it again.
+func (h Handler) updateStatus(ctx context.Context, updateData checker.UpdateData) {
+	if h.Notifier == nil {
+		return
+	}
+	if err := h.Notifier.UpdateStatus(ctx, updateData); err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("monitor_id", updateData.MonitorId).Msg("failed to update status")
+	}
 }
 
 // Authorization could be handle by middleware
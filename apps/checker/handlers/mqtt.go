@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/request"
+)
+
+const defaultMQTTTimeout = 10 * time.Second
+
+func (h Handler) MQTTHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	if c.GetHeader("Authorization") != fmt.Sprintf("Basic %s", h.Secret) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+
+		return
+	}
+
+	if h.CloudProvider == "fly" {
+		// if the request has been routed to a wrong region, we forward it to the correct one.
+		region := c.GetHeader("fly-prefer-region")
+		if region != "" && region != h.Region {
+			c.Header("fly-replay", fmt.Sprintf("region=%s", region))
+			c.String(http.StatusAccepted, "Forwarding request to %s", region)
+
+			return
+		}
+	}
+
+	var req request.MQTTCheckerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("failed to decode checker request")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+
+		return
+	}
+
+	h.runTCPCheck(c, req.TCPCheckerRequest, mqttProbe{req: req})
+}
+
+type mqttProbe struct {
+	req request.MQTTCheckerRequest
+}
+
+func (p mqttProbe) jobType() string { return "mqtt" }
+
+// probe reads the timeout in milliseconds, like the HTTP checks.
+func (p mqttProbe) probe(ctx context.Context) (checker.TCPResponseTiming, error) {
+	timeout := time.Duration(p.req.Timeout) * time.Millisecond
+	if timeout <= 0 {
+		timeout = defaultMQTTTimeout
+	}
+
+	return checker.PingMQTT(ctx, p.req.URI, checker.MQTTOptions{
+		Topic:    p.req.Topic,
+		Username: p.req.Username,
+		Password: p.req.Password,
+		TLS:      p.req.TLS,
+	}, timeout)
+}
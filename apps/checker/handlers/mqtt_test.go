@@ -0,0 +1,114 @@
+package handlers_test
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/handlers"
+	"github.com/openstatushq/openstatus/apps/checker/request"
+)
+
+// echoBroker accepts any client and delivers every publish back to it. Only
+// single-byte remaining lengths are supported, which the probe never exceeds.
+func echoBroker(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				for {
+					header := make([]byte, 2)
+					if _, err := io.ReadFull(r, header); err != nil {
+						return
+					}
+					body := make([]byte, header[1])
+					if _, err := io.ReadFull(r, body); err != nil {
+						return
+					}
+					switch header[0] >> 4 {
+					case 1: // CONNECT
+						conn.Write([]byte{0x20, 0x02, 0x00, 0x00})
+					case 8: // SUBSCRIBE
+						conn.Write([]byte{0x90, 0x03, body[0], body[1], 0x01})
+					case 3: // PUBLISH with QoS 1
+						n := binary.BigEndian.Uint16(body)
+						topic, id, payload := body[:2+n], body[2+n:4+n], body[4+n:]
+						conn.Write([]byte{0x40, 0x02, id[0], id[1]})
+						packet := append(append([]byte{}, topic...), payload...)
+						conn.Write(append([]byte{0x30, byte(len(packet))}, packet...))
+					case 14: // DISCONNECT
+						return
+					}
+				}
+			}()
+		}
+	}()
+
+	return ln.Addr().String()
+}
+
+func TestHandler_MQTTHandler(t *testing.T) {
+	t.Run("it should return 401 if there's no auth", func(t *testing.T) {
+		h := handlers.Handler{Sink: testTinybird(t), Secret: "test", Region: "local"}
+		router := gin.New()
+		router.POST("/checker/mqtt", h.MQTTHandler)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/checker/mqtt", strings.NewReader(`{}`))
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("it should report the round trip phases", func(t *testing.T) {
+		h := handlers.Handler{Sink: testTinybird(t), Secret: "test", Region: "local"}
+		router := gin.New()
+		router.POST("/checker/mqtt", h.MQTTHandler)
+
+		body, _ := json.Marshal(request.MQTTCheckerRequest{
+			TCPCheckerRequest: request.TCPCheckerRequest{
+				URI:         echoBroker(t),
+				MonitorID:   "1",
+				WorkspaceID: "1",
+				Status:      "active", // avoids the network UpdateStatus call
+				Timeout:     5000,
+				Retry:       1,
+			},
+			Topic: "fleet/health",
+		})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/checker/mqtt?data=true", strings.NewReader(string(body)))
+		req.Header.Set("Authorization", "Basic test")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var res checker.TCPResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		assert.Equal(t, "mqtt", res.JobType)
+		assert.Zero(t, res.Error)
+		require.Len(t, res.Timing.Phases, 3)
+		assert.Equal(t, "roundtrip", res.Timing.Phases[2].Name)
+	})
+}
@@ -1,6 +1,7 @@
 package handlers_test
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/openstatushq/openstatus/apps/checker/handlers"
+	otelOS "github.com/openstatushq/openstatus/apps/checker/pkg/otel"
 	"github.com/openstatushq/openstatus/apps/checker/pkg/tinybird"
 	"github.com/openstatushq/openstatus/apps/checker/request"
 	"github.com/stretchr/testify/assert"
@@ -51,9 +53,11 @@ func TestTCPHandler_ExportsOTLPOnSuccess(t *testing.T) {
 	otlp, count := countingOTLPServer(t)
 
 	h := handlers.Handler{
-		TbClient: testTinybird(t),
-		Secret:   "test",
-		Region:   "local",
+		Sink:    testTinybird(t),
+		Secret:  "test",
+		Region:  "local",
+		Meters:  otelOS.NewMeterProviders(0),
+		Loggers: otelOS.NewLoggerProviders(),
 	}
 	router := gin.New()
 	router.POST("/checker/tcp", h.TCPHandler)
@@ -73,6 +77,8 @@ func TestTCPHandler_ExportsOTLPOnSuccess(t *testing.T) {
 	r, _ := http.NewRequest(http.MethodPost, "/checker/tcp", strings.NewReader(string(body)))
 	r.Header.Set("Authorization", "Basic test")
 	router.ServeHTTP(w, r)
+	require.NoError(t, h.Meters.Shutdown(context.Background()))
+	require.NoError(t, h.Loggers.Shutdown(context.Background()))
 
 	assert.Equal(t, http.StatusOK, w.Code)
 	assert.Eventually(t, func() bool { return atomic.LoadInt64(count) > 0 }, 5*time.Second, 50*time.Millisecond,
@@ -83,9 +89,11 @@ func TestTCPHandlerRegion_ExportsOTLPOnFailure(t *testing.T) {
 	otlp, count := countingOTLPServer(t)
 
 	h := handlers.Handler{
-		TbClient: testTinybird(t),
-		Secret:   "test",
-		Region:   "local",
+		Sink:    testTinybird(t),
+		Secret:  "test",
+		Region:  "local",
+		Meters:  otelOS.NewMeterProviders(0),
+		Loggers: otelOS.NewLoggerProviders(),
 	}
 	router := gin.New()
 	router.POST("/tcp/:region", h.TCPHandlerRegion)
@@ -102,6 +110,8 @@ func TestTCPHandlerRegion_ExportsOTLPOnFailure(t *testing.T) {
 	r, _ := http.NewRequest(http.MethodPost, "/tcp/local", strings.NewReader(string(body)))
 	r.Header.Set("Authorization", "Basic test")
 	router.ServeHTTP(w, r)
+	require.NoError(t, h.Meters.Shutdown(context.Background()))
+	require.NoError(t, h.Loggers.Shutdown(context.Background()))
 
 	assert.Equal(t, http.StatusOK, w.Code)
 	assert.Eventually(t, func() bool { return atomic.LoadInt64(count) > 0 }, 10*time.Second, 50*time.Millisecond,
@@ -112,9 +122,11 @@ func TestDNSHandler_ExportsOTLPOnFailure(t *testing.T) {
 	otlp, count := countingOTLPServer(t)
 
 	h := handlers.Handler{
-		TbClient: testTinybird(t),
-		Secret:   "test",
-		Region:   "local",
+		Sink:    testTinybird(t),
+		Secret:  "test",
+		Region:  "local",
+		Meters:  otelOS.NewMeterProviders(0),
+		Loggers: otelOS.NewLoggerProviders(),
 	}
 	router := gin.New()
 	router.POST("/checker/dns", h.DNSHandler)
@@ -133,6 +145,8 @@ func TestDNSHandler_ExportsOTLPOnFailure(t *testing.T) {
 	r, _ := http.NewRequest(http.MethodPost, "/checker/dns", strings.NewReader(string(body)))
 	r.Header.Set("Authorization", "Basic test")
 	router.ServeHTTP(w, r)
+	require.NoError(t, h.Meters.Shutdown(context.Background()))
+	require.NoError(t, h.Loggers.Shutdown(context.Background()))
 
 	assert.Equal(t, http.StatusOK, w.Code)
 	assert.Eventually(t, func() bool { return atomic.LoadInt64(count) > 0 }, 10*time.Second, 50*time.Millisecond,
@@ -143,9 +157,11 @@ func TestDNSHandlerRegion_ExportsOTLPOnFailure(t *testing.T) {
 	otlp, count := countingOTLPServer(t)
 
 	h := handlers.Handler{
-		TbClient: testTinybird(t),
-		Secret:   "test",
-		Region:   "local",
+		Sink:    testTinybird(t),
+		Secret:  "test",
+		Region:  "local",
+		Meters:  otelOS.NewMeterProviders(0),
+		Loggers: otelOS.NewLoggerProviders(),
 	}
 	router := gin.New()
 	router.POST("/dns/:region", h.DNSHandlerRegion)
@@ -164,6 +180,8 @@ func TestDNSHandlerRegion_ExportsOTLPOnFailure(t *testing.T) {
 	r, _ := http.NewRequest(http.MethodPost, "/dns/local", strings.NewReader(string(body)))
 	r.Header.Set("Authorization", "Basic test")
 	router.ServeHTTP(w, r)
+	require.NoError(t, h.Meters.Shutdown(context.Background()))
+	require.NoError(t, h.Loggers.Shutdown(context.Background()))
 
 	assert.Equal(t, http.StatusOK, w.Code)
 	assert.Eventually(t, func() bool { return atomic.LoadInt64(count) > 0 }, 10*time.Second, 50*time.Millisecond,
@@ -138,8 +138,8 @@ func (h Handler) PingRegionHandler(c *gin.Context) {
 		res.Region = h.Region
 
 		if tbData.RequestId != 0 {
-			if err := h.TbClient.SendEvent(ctx, tbData, dataSourceName); err != nil {
-				log.Ctx(ctx).Error().Err(err).Msg("failed to send event to tinybird")
+			if err := h.Sink.SendEvent(ctx, tbData, dataSourceName); err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("failed to store result")
 			}
 		}
 
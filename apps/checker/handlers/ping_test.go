@@ -37,7 +37,7 @@ func TestHandler_PingRegion(t *testing.T) {
 
 		region := "local"
 		h := handlers.Handler{
-			TbClient:      client,
+			Sink:          client,
 			Secret:        "",
 			CloudProvider: "fly",
 			Region:        region,
@@ -61,7 +61,7 @@ func TestHandler_PingRegion(t *testing.T) {
 		region := "local"
 
 		h := handlers.Handler{
-			TbClient:      client,
+			Sink:          client,
 			Secret:        "test",
 			CloudProvider: "fly",
 			Region:        region,
@@ -90,7 +90,7 @@ func TestHandler_PingRegion(t *testing.T) {
 		httptest.NewRecorder()
 
 		h := handlers.Handler{
-			TbClient:      client,
+			Sink:          client,
 			Secret:        "test",
 			CloudProvider: "fly",
 			Region:        region,
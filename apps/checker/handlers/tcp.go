@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -11,7 +12,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/openstatushq/openstatus/apps/checker/checker"
-	otelOS "github.com/openstatushq/openstatus/apps/checker/pkg/otel"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/statuspolicy"
 	"github.com/openstatushq/openstatus/apps/checker/request"
 	"github.com/rs/zerolog/log"
 )
@@ -25,6 +26,7 @@ type TCPData struct {
 	Trigger       string `json:"trigger"`
 	URI           string `json:"uri"`
 	RequestStatus string `json:"requestStatus,omitempty"`
+	ErrorCode     string `json:"errorCode,omitempty"`
 
 	RequestId     int64 `json:"requestId,omitempty"`
 	WorkspaceID   int64 `json:"workspaceId"`
@@ -38,7 +40,6 @@ type TCPData struct {
 
 func (h Handler) TCPHandler(c *gin.Context) {
 	ctx := c.Request.Context()
-	dataSourceName := "tcp_response__v0"
 
 	if c.GetHeader("Authorization") != fmt.Sprintf("Basic %s", h.Secret) {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
@@ -65,6 +66,30 @@ func (h Handler) TCPHandler(c *gin.Context) {
 		return
 	}
 
+	h.runTCPCheck(c, req, tcpProbe{req: req})
+}
+
+// tcpProber runs a single attempt of a TCP-based check, so protocol checks
+// (MQTT) share the retry and status update flow below.
+type tcpProber interface {
+	jobType() string
+	probe(ctx context.Context) (checker.TCPResponseTiming, error)
+}
+
+type tcpProbe struct {
+	req request.TCPCheckerRequest
+}
+
+func (p tcpProbe) jobType() string { return "tcp" }
+
+func (p tcpProbe) probe(ctx context.Context) (checker.TCPResponseTiming, error) {
+	return checker.PingTCP(int(p.req.Timeout), p.req.URI)
+}
+
+func (h Handler) runTCPCheck(c *gin.Context, req request.TCPCheckerRequest, prober tcpProber) {
+	ctx := c.Request.Context()
+	dataSourceName := "tcp_response__v0"
+
 	workspaceId, err := strconv.ParseInt(req.WorkspaceID, 10, 64)
 
 	if err != nil {
@@ -94,7 +119,7 @@ func (h Handler) TCPHandler(c *gin.Context) {
 			"workspace_id": req.WorkspaceID,
 			"monitor_id":req.MonitorID,
 			"trigger": trigger,
-			"type": "tcp",
+			"type": prober.jobType(),
 		}
 		c.Set("event", t)
 	}
@@ -109,10 +134,10 @@ func (h Handler) TCPHandler(c *gin.Context) {
 	}
 
 	op := func() error {
-		res, err := checker.PingTCP(int(req.Timeout), req.URI)
+		res, err := prober.probe(ctx)
 
 		if err != nil {
-			return fmt.Errorf("unable to check tcp %s", err)
+			return fmt.Errorf("unable to check %s %w", prober.jobType(), err)
 		}
 
 		timingAsString, err := json.Marshal(res)
@@ -122,17 +147,6 @@ func (h Handler) TCPHandler(c *gin.Context) {
 
 		latency := res.TCPDone - res.TCPStart
 
-		var requestStatus = ""
-		switch req.Status {
-		case "active":
-			requestStatus = "success"
-		case "error":
-			requestStatus = "error"
-
-		case "degraded":
-			requestStatus = "degraded"
-		}
-
 		id, err := uuid.NewV7()
 		if err != nil {
 			return fmt.Errorf("error while generating uuid %w", err)
@@ -151,57 +165,35 @@ func (h Handler) TCPHandler(c *gin.Context) {
 			CronTimestamp: req.CronTimestamp,
 			Trigger:       trigger,
 			URI:           req.URI,
-			RequestStatus: requestStatus,
 		}
 
 		response = checker.TCPResponse{
 			Timestamp: res.TCPStart,
-			Timing: checker.TCPResponseTiming{
-				TCPStart: res.TCPStart,
-				TCPDone:  res.TCPDone,
-			},
-			Latency: latency,
-			Region:  h.Region,
-			JobType: "tcp",
+			Timing:    res,
+			Latency:   latency,
+			Region:    h.Region,
+			JobType:   prober.jobType(),
 		}
 
-		if req.DegradedAfter == 0 && req.Status != "active" {
-			checker.UpdateStatus(ctx, checker.UpdateData{
-				MonitorId:     req.MonitorID,
-				Status:        "active",
-				Region:        h.Region,
-				CronTimestamp: req.CronTimestamp,
-				Latency:       latency,
-			})
-			data.RequestStatus = "success"
+		observed := statuspolicy.StatusActive
+		if req.DegradedAfter > 0 && latency > req.DegradedAfter {
+			observed = statuspolicy.StatusDegraded
 		}
 
-		if (req.DegradedAfter > 0 && latency < req.DegradedAfter) && req.Status != "active" {
-			checker.UpdateStatus(ctx, checker.UpdateData{
+		status, report := h.Statuses.Observe(req.MonitorID, statusPolicy(req.StatusPolicy), req.Status, observed, time.Now())
+		if report {
+			h.updateStatus(ctx, checker.UpdateData{
 				MonitorId:     req.MonitorID,
-				Status:        "active",
+				Status:        status,
 				Region:        h.Region,
 				CronTimestamp: req.CronTimestamp,
 				Latency:       latency,
 			})
-			data.RequestStatus = "success"
-
 		}
+		data.RequestStatus = requestStatus(status)
 
-		if req.DegradedAfter > 0 && latency > req.DegradedAfter && req.Status != "degraded" {
-			checker.UpdateStatus(ctx, checker.UpdateData{
-				MonitorId:     req.MonitorID,
-				Status:        "degraded",
-				Region:        h.Region,
-				CronTimestamp: req.CronTimestamp,
-				Latency:       latency,
-			})
-			data.RequestStatus = "degraded"
-
-		}
-
-		if err := h.TbClient.SendEvent(ctx, data, dataSourceName); err != nil {
-			log.Ctx(ctx).Error().Err(err).Msg("failed to send event to tinybird")
+		if err := h.Sink.SendEvent(ctx, data, dataSourceName); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("failed to store result")
 		}
 
 		return nil
@@ -214,6 +206,9 @@ func (h Handler) TCPHandler(c *gin.Context) {
 			log.Ctx(ctx).Error().Err(e).Msg("failed to send event to tinybird")
 			return
 		}
+
+		status, report := h.Statuses.Observe(req.MonitorID, statusPolicy(req.StatusPolicy), req.Status, statuspolicy.StatusError, time.Now())
+
 		data := TCPData{
 			ID:            id.String(),
 			WorkspaceID:   workspaceId,
@@ -224,24 +219,30 @@ func (h Handler) TCPHandler(c *gin.Context) {
 			Error:         1,
 			Trigger:       trigger,
 			URI:           req.URI,
-			RequestStatus: "error",
+			RequestStatus: requestStatus(status),
+			ErrorCode:     string(checker.Classify(err)),
 		}
-		if err := h.TbClient.SendEvent(ctx, data, dataSourceName); err != nil {
-			log.Ctx(ctx).Error().Err(err).Msg("failed to send event to tinybird")
+		if err := h.Sink.SendEvent(ctx, data, dataSourceName); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("failed to store result")
+		}
+		if report {
+			h.updateStatus(ctx, checker.UpdateData{
+				MonitorId:     req.MonitorID,
+				Status:        status,
+				Message:       err.Error(),
+				Region:        h.Region,
+				CronTimestamp: req.CronTimestamp,
+			})
 		}
-		checker.UpdateStatus(ctx, checker.UpdateData{
-			MonitorId:     req.MonitorID,
-			Status:        "error",
-			Message:       err.Error(),
-			Region:        h.Region,
-			CronTimestamp: req.CronTimestamp,
-		})
 
 		response.Error = 1
+		response.ErrorCode = checker.Classify(err)
+		response.ErrorMessage = err.Error()
 	}
 
 	if req.OtelConfig.Endpoint != "" {
-		otelOS.RecordTCPMetrics(ctx, req, response, h.Region)
+		h.Meters.RecordTCPMetrics(ctx, req, response, h.Region)
+		h.Loggers.RecordTCPResult(ctx, req, response, h.Region)
 	}
 
 	returnData := c.Query("data")
@@ -301,7 +302,7 @@ func (h Handler) TCPHandlerRegion(c *gin.Context) {
 		res, err := checker.PingTCP(int(req.Timeout), req.URI)
 
 		if err != nil {
-			return fmt.Errorf("unable to check tcp %s", err)
+			return fmt.Errorf("unable to check tcp %w", err)
 		}
 
 		response = checker.TCPResponse{
@@ -336,8 +337,8 @@ func (h Handler) TCPHandlerRegion(c *gin.Context) {
 		}
 
 		if req.RequestId != 0 {
-			if err := h.TbClient.SendEvent(ctx, data, dataSourceName); err != nil {
-				log.Ctx(ctx).Error().Err(err).Msg("failed to send event to tinybird")
+			if err := h.Sink.SendEvent(ctx, data, dataSourceName); err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("failed to store result")
 			}
 		}
 
@@ -347,10 +348,13 @@ func (h Handler) TCPHandlerRegion(c *gin.Context) {
 	err := backoff.Retry(op, backoff.WithMaxRetries(backoff.NewExponentialBackOff(), 3))
 	if err != nil {
 		response.Error = 1
+		response.ErrorCode = checker.Classify(err)
+		response.ErrorMessage = err.Error()
 	}
 
 	if req.OtelConfig.Endpoint != "" {
-		otelOS.RecordTCPMetrics(ctx, req, response, region)
+		h.Meters.RecordTCPMetrics(ctx, req, response, region)
+		h.Loggers.RecordTCPResult(ctx, req, response, region)
 	}
 
 	if err != nil {
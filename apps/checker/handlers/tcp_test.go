@@ -0,0 +1,55 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/handlers"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/statuspolicy"
+	"github.com/openstatushq/openstatus/apps/checker/request"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler_TCPHandlerFailureThreshold(t *testing.T) {
+	notifier := &checker.MemoryNotifier{}
+	h := handlers.Handler{
+		Sink:     testTinybird(t),
+		Secret:   "test",
+		Region:   "local",
+		Notifier: notifier,
+		Statuses: statuspolicy.NewTracker(),
+	}
+	router := gin.New()
+	router.POST("/checker/tcp", h.TCPHandler)
+
+	check := func() {
+		data := request.TCPCheckerRequest{
+			URI:          "127.0.0.1:1", // connection refused
+			WorkspaceID:  "1",
+			MonitorID:    "42",
+			Status:       "active",
+			Timeout:      5,
+			Retry:        1,
+			StatusPolicy: request.StatusPolicy{FailureThreshold: 2},
+		}
+		dataJson, _ := json.Marshal(data)
+		req, _ := http.NewRequest(http.MethodPost, "/checker/tcp", strings.NewReader(string(dataJson)))
+		req.Header.Set("Authorization", "Basic test")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	check()
+	assert.Empty(t, notifier.Updates(), "a single failure must not change the status")
+
+	check()
+	updates := notifier.Updates()
+	if assert.Len(t, updates, 1) {
+		assert.Equal(t, "42", updates[0].MonitorId)
+		assert.Equal(t, "error", updates[0].Status)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/openstatushq/openstatus/apps/checker/request"
@@ -33,6 +34,13 @@ type StringTargetType struct {
 	Target     string                   `json:"target"`
 }
 
+type JsonBodyTarget struct {
+	AssertionType request.AssertionType    `json:"type"`
+	Comparator    request.StringComparator `json:"compare"`
+	Path          string                   `json:"path"`
+	Target        string                   `json:"target"`
+}
+
 type RecordTarget struct {
 	Comparator request.RecordComparator `json:"compare"`
 	Target     string                   `json:"target"`
@@ -149,3 +157,101 @@ func (target RecordTarget) RecordEvaluate(s []string) bool {
 
 	return true
 }
+
+// JsonBodyEvaluate resolves Path in the JSON document and compares the value
+// it points to. Only the dotted/bracketed subset of JSONPath is supported
+// ($.a.b[0]['c']). A path that does not resolve fails the assertion.
+func (target JsonBodyTarget) JsonBodyEvaluate(document []byte) bool {
+	var root any
+	if err := json.Unmarshal(document, &root); err != nil {
+		return false
+	}
+
+	value, found := lookupJSONPath(root, target.Path)
+	if !found {
+		return false
+	}
+
+	t := StringTargetType{Comparator: target.Comparator, Target: target.Target}
+
+	return t.StringEvaluate(jsonValueString(value))
+}
+
+func lookupJSONPath(root any, path string) (any, bool) {
+	path = strings.TrimPrefix(strings.TrimSpace(path), "$")
+	current := root
+
+	for path != "" {
+		var key string
+		var index = -1
+
+		switch {
+		case strings.HasPrefix(path, "."):
+			path = path[1:]
+			end := strings.IndexAny(path, ".[")
+			if end == -1 {
+				end = len(path)
+			}
+			key, path = path[:end], path[end:]
+		case strings.HasPrefix(path, "['"):
+			end := strings.Index(path, "']")
+			if end == -1 {
+				return nil, false
+			}
+			key, path = path[2:end], path[end+2:]
+		case strings.HasPrefix(path, "["):
+			end := strings.Index(path, "]")
+			if end == -1 {
+				return nil, false
+			}
+			i, err := strconv.Atoi(path[1:end])
+			if err != nil {
+				return nil, false
+			}
+			index, path = i, path[end+1:]
+		default:
+			// A bare first segment ("data.user") reads like ".data.user".
+			path = "." + path
+			continue
+		}
+
+		if index >= 0 {
+			list, ok := current.([]any)
+			if !ok || index >= len(list) {
+				return nil, false
+			}
+			current = list[index]
+			continue
+		}
+
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current, ok = object[key]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+func jsonValueString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return string(b)
+	}
+}
@@ -200,3 +200,39 @@ func TestRecordTarget_RecordEvaluate(t *testing.T) {
 		})
 	}
 }
+
+func TestJsonBodyTarget_JsonBodyEvaluate(t *testing.T) {
+	document := []byte(`{"user":{"name":"openstatus","plan":"team","seats":12,"active":true,"tags":["a","b"],"manager":null}}`)
+
+	tests := []struct {
+		name   string
+		target JsonBodyTarget
+		want   bool
+	}{
+		{name: "nested field equals", target: JsonBodyTarget{Path: "$.user.name", Comparator: request.StringEquals, Target: "openstatus"}, want: true},
+		{name: "nested field not equals", target: JsonBodyTarget{Path: "$.user.plan", Comparator: request.StringEquals, Target: "free"}, want: false},
+		{name: "bare path", target: JsonBodyTarget{Path: "user.plan", Comparator: request.StringEquals, Target: "team"}, want: true},
+		{name: "bracket key", target: JsonBodyTarget{Path: "$['user']['name']", Comparator: request.StringContains, Target: "status"}, want: true},
+		{name: "array index", target: JsonBodyTarget{Path: "$.user.tags[1]", Comparator: request.StringEquals, Target: "b"}, want: true},
+		{name: "array index out of range", target: JsonBodyTarget{Path: "$.user.tags[5]", Comparator: request.StringEmpty}, want: false},
+		{name: "number", target: JsonBodyTarget{Path: "$.user.seats", Comparator: request.StringEquals, Target: "12"}, want: true},
+		{name: "bool", target: JsonBodyTarget{Path: "$.user.active", Comparator: request.StringEquals, Target: "true"}, want: true},
+		{name: "null is empty", target: JsonBodyTarget{Path: "$.user.manager", Comparator: request.StringEmpty}, want: true},
+		{name: "object is compared as json", target: JsonBodyTarget{Path: "$.user.tags", Comparator: request.StringEquals, Target: `["a","b"]`}, want: true},
+		{name: "missing field fails", target: JsonBodyTarget{Path: "$.user.email", Comparator: request.StringNotEquals, Target: "x"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.target.JsonBodyEvaluate(document); got != tt.want {
+				t.Errorf("JsonBodyTarget.JsonBodyEvaluate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("invalid json fails", func(t *testing.T) {
+		target := JsonBodyTarget{Path: "$.user", Comparator: request.StringNotEmpty}
+		if target.JsonBodyEvaluate([]byte("not json")) {
+			t.Error("expected an invalid document to fail the assertion")
+		}
+	})
+}
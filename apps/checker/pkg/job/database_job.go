@@ -0,0 +1,95 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/assertions"
+	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
+)
+
+func databaseEngine(engine v1.DatabaseEngine) (string, error) {
+	switch engine {
+	case v1.DatabaseEngine_DATABASE_ENGINE_POSTGRES:
+		return checker.DatabasePostgres, nil
+	case v1.DatabaseEngine_DATABASE_ENGINE_MYSQL:
+		return checker.DatabaseMySQL, nil
+	case v1.DatabaseEngine_DATABASE_ENGINE_REDIS:
+		return checker.DatabaseRedis, nil
+	}
+	return "", fmt.Errorf("unknown database engine: %v", engine)
+}
+
+// evaluateDatabaseAssertions checks the row count and the first value of the
+// result; without assertions a successful query is enough.
+func evaluateDatabaseAssertions(monitor *v1.DatabaseMonitor, res checker.DatabaseResult) (bool, error) {
+	isSuccessful := true
+	for _, assertion := range monitor.RowCountAssertions {
+		a, err := ProtoNumberAssertionToComparator(assertion.Comparator)
+		if err != nil {
+			return false, fmt.Errorf("error while parsing row count assertion comparator: %w", err)
+		}
+		assert := assertions.StatusTarget{
+			Comparator: a,
+			Target:     assertion.Target,
+		}
+		isSuccessful = isSuccessful && assert.StatusEvaluate(res.RowCount)
+	}
+	for _, assertion := range monitor.ValueAssertions {
+		a, err := ProtoStringAssertionToComparator(assertion.Comparator)
+		if err != nil {
+			return false, fmt.Errorf("error while parsing value assertion comparator: %w", err)
+		}
+		assert := assertions.StringTargetType{
+			Comparator: a,
+			Target:     assertion.Target,
+		}
+		isSuccessful = isSuccessful && assert.StringEvaluate(res.Value)
+	}
+	return isSuccessful, nil
+}
+
+// redactedDatabaseMonitor reports the monitor under its redacted URI.
+type redactedDatabaseMonitor struct {
+	*v1.DatabaseMonitor
+	uri string
+}
+
+func (m redactedDatabaseMonitor) GetUri() string {
+	return m.uri
+}
+
+func (jr jobRunner) DatabaseJob(ctx context.Context, monitor *v1.DatabaseMonitor, region string) (*TCPPrivateRegionData, error) {
+	engine, err := databaseEngine(monitor.Engine)
+	if err != nil {
+		return nil, err
+	}
+
+	// Credentials live in the URI; never let them reach the ingested row.
+	uri := checker.RedactURI(monitor.Uri)
+
+	return jr.protocolJob(ctx, redactedDatabaseMonitor{monitor, uri}, region, engine, func() (protocolOutcome, error) {
+		res, err := checker.PingDatabase(ctx, engine, monitor.Uri, monitor.Query, time.Duration(monitor.Timeout)*time.Millisecond)
+		if err != nil {
+			return protocolOutcome{}, err
+		}
+		ok, err := evaluateDatabaseAssertions(monitor, res)
+		if err != nil {
+			return protocolOutcome{}, backoff.Permanent(err)
+		}
+		if !ok {
+			return protocolOutcome{
+				Timing:  res.Timing,
+				Message: fmt.Sprintf("Assertions failed: got value %q and %d rows", res.Value, res.RowCount),
+				Failed:  true,
+			}, nil
+		}
+		return protocolOutcome{
+			Timing:  res.Timing,
+			Message: fmt.Sprintf("Successfully queried %s", uri),
+		}, nil
+	})
+}
@@ -0,0 +1,111 @@
+package job_test
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/checker/pkg/job"
+	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
+)
+
+// redisStandIn answers every command with +PONG, except HELLO which is
+// refused so the client falls back to plain AUTH.
+func redisStandIn(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if !strings.HasPrefix(line, "*") {
+						continue
+					}
+					// Skip to the command name: "$<len>\r\n<name>\r\n".
+					if _, err := r.ReadString('\n'); err != nil {
+						return
+					}
+					name, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					reply := "+PONG\r\n"
+					switch strings.ToUpper(strings.TrimSpace(name)) {
+					case "HELLO":
+						reply = "-ERR unknown command 'HELLO'\r\n"
+					case "AUTH", "SELECT":
+						reply = "+OK\r\n"
+					}
+					if _, err := conn.Write([]byte(reply)); err != nil {
+						return
+					}
+				}
+			}()
+		}
+	}()
+
+	return ln.Addr().String()
+}
+
+func TestDatabaseJob(t *testing.T) {
+	addr := redisStandIn(t)
+
+	t.Run("a passing value assertion succeeds", func(t *testing.T) {
+		monitor := &v1.DatabaseMonitor{
+			Engine:  v1.DatabaseEngine_DATABASE_ENGINE_REDIS,
+			Uri:     "redis://:secret@" + addr + "/0",
+			Timeout: 1000,
+			Retry:   1,
+			ValueAssertions: []*v1.ValueAssertion{
+				{Comparator: v1.StringComparator_STRING_COMPARATOR_EQUAL, Target: "PONG"},
+			},
+		}
+
+		data, err := job.NewJobRunner().DatabaseJob(context.Background(), monitor, "test-region")
+		require.NoError(t, err)
+		assert.Equal(t, "success", data.RequestStatus)
+		assert.NotContains(t, data.URI, "secret")
+		assert.Contains(t, data.Timing, `"phases"`)
+	})
+
+	t.Run("a failing row count assertion is reported", func(t *testing.T) {
+		monitor := &v1.DatabaseMonitor{
+			Engine:  v1.DatabaseEngine_DATABASE_ENGINE_REDIS,
+			Uri:     "redis://" + addr,
+			Timeout: 1000,
+			Retry:   1,
+			RowCountAssertions: []*v1.RowCountAssertion{
+				{Comparator: v1.NumberComparator_NUMBER_COMPARATOR_GREATER_THAN, Target: 5},
+			},
+		}
+
+		data, err := job.NewJobRunner().DatabaseJob(context.Background(), monitor, "test-region")
+		require.NoError(t, err)
+		assert.Equal(t, "error", data.RequestStatus)
+		assert.Equal(t, 1, data.Error)
+		assert.Contains(t, data.Message, "Assertions failed")
+	})
+
+	t.Run("an unknown engine is rejected", func(t *testing.T) {
+		_, err := job.NewJobRunner().DatabaseJob(context.Background(), &v1.DatabaseMonitor{Uri: "redis://" + addr}, "test-region")
+		assert.Error(t, err)
+	})
+}
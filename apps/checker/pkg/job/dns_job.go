@@ -24,6 +24,7 @@ type DNSPrivateRegionData struct {
 	CronTimestamp int64               `json:"cronTimestamp"`
 	Timestamp     int64               `json:"timestamp"`
 	Error         uint8               `json:"error"`
+	ErrorCode     string              `json:"errorCode,omitempty"`
 }
 
 func ProtoRecordAssertionToComparator(assertion v1.RecordComparator) (request.RecordComparator, error) {
@@ -124,6 +125,7 @@ func (jobRunner) DNSJob(ctx context.Context, monitor *v1.DNSMonitor) (*DNSPrivat
 			data.RequestStatus = "error"
 			data.Error = 1
 			data.Message = lookupErr.Error()
+			data.ErrorCode = string(checker.Classify(lookupErr))
 			lastFailure = data
 
 			if called < int(retry) {
@@ -142,6 +144,7 @@ func (jobRunner) DNSJob(ctx context.Context, monitor *v1.DNSMonitor) (*DNSPrivat
 			data.RequestStatus = "error"
 			data.Error = 1
 			data.Message = fmt.Sprintf("invalid DNS assertion for %s: %s", monitor.Uri, assertErr)
+			data.ErrorCode = string(checker.ErrorAssertionFailed)
 			return data, nil
 		}
 
@@ -150,6 +153,7 @@ func (jobRunner) DNSJob(ctx context.Context, monitor *v1.DNSMonitor) (*DNSPrivat
 			data.RequestStatus = "error"
 			data.Error = 1
 			data.Message = fmt.Sprintf("DNS assertions failed for %s", monitor.Uri)
+			data.ErrorCode = string(checker.ErrorAssertionFailed)
 			lastFailure = data
 
 			if called < int(retry) {
@@ -0,0 +1,82 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/assertions"
+	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
+)
+
+// evaluateDomainAssertions checks the days left until expiry and the EPP
+// status values. A registry that publishes no expiration date fails every
+// expiry assertion rather than passing it silently.
+func evaluateDomainAssertions(monitor *v1.DomainMonitor, res checker.DomainResult, now time.Time) (bool, error) {
+	isSuccessful := true
+	for _, assertion := range monitor.ExpiryAssertions {
+		a, err := ProtoNumberAssertionToComparator(assertion.Comparator)
+		if err != nil {
+			return false, fmt.Errorf("error while parsing expiry assertion comparator: %w", err)
+		}
+		if res.Expiration.IsZero() {
+			isSuccessful = false
+			continue
+		}
+		assert := assertions.StatusTarget{
+			Comparator: a,
+			Target:     assertion.Target,
+		}
+		isSuccessful = isSuccessful && assert.StatusEvaluate(res.DaysUntilExpiry(now))
+	}
+	statuses := strings.Join(res.Statuses, " ")
+	for _, assertion := range monitor.StatusAssertions {
+		a, err := ProtoStringAssertionToComparator(assertion.Comparator)
+		if err != nil {
+			return false, fmt.Errorf("error while parsing status assertion comparator: %w", err)
+		}
+		assert := assertions.StringTargetType{
+			Comparator: a,
+			Target:     assertion.Target,
+		}
+		isSuccessful = isSuccessful && assert.StringEvaluate(statuses)
+	}
+	return isSuccessful, nil
+}
+
+func domainSummary(res checker.DomainResult, now time.Time) string {
+	expiry := "no expiration date"
+	if !res.Expiration.IsZero() {
+		expiry = fmt.Sprintf("expires %s (%d days)", res.Expiration.UTC().Format(time.DateOnly), res.DaysUntilExpiry(now))
+	}
+	registrar := res.Registrar
+	if registrar == "" {
+		registrar = "unknown registrar"
+	}
+	return fmt.Sprintf("%s %s, %s, status %q", res.Domain, expiry, registrar, strings.Join(res.Statuses, " "))
+}
+
+// DomainJob looks up the registration of the monitored domain over RDAP.
+func (jr jobRunner) DomainJob(ctx context.Context, monitor *v1.DomainMonitor, region string) (*TCPPrivateRegionData, error) {
+	opts := checker.RDAPOptions{BaseURL: monitor.RdapBaseUrl}
+
+	return jr.protocolJob(ctx, monitor, region, "domain", func() (protocolOutcome, error) {
+		res, err := checker.LookupDomain(ctx, http.DefaultClient, monitor.Uri, opts, time.Duration(monitor.Timeout)*time.Millisecond)
+		if err != nil {
+			return protocolOutcome{}, err
+		}
+		now := time.Now()
+		ok, err := evaluateDomainAssertions(monitor, res, now)
+		if err != nil {
+			return protocolOutcome{}, backoff.Permanent(err)
+		}
+		if !ok {
+			return protocolOutcome{Timing: res.Timing, Message: "Assertions failed: " + domainSummary(res, now), Failed: true}, nil
+		}
+		return protocolOutcome{Timing: res.Timing, Message: domainSummary(res, now)}, nil
+	})
+}
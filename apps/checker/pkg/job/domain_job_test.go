@@ -0,0 +1,73 @@
+package job_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/checker/pkg/job"
+	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
+)
+
+// rdapStandIn answers every domain lookup with the given expiry and status.
+func rdapStandIn(t *testing.T, expires time.Time, status string) string {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rdap+json")
+		fmt.Fprintf(w, `{"objectClassName": "domain", "status": [%q], "events": [{"eventAction": "expiration", "eventDate": %q}]}`,
+			status, expires.UTC().Format(time.RFC3339))
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+func TestDomainJob(t *testing.T) {
+	monitor := func(baseURL string) *v1.DomainMonitor {
+		return &v1.DomainMonitor{
+			Uri:         "openstatus.dev",
+			RdapBaseUrl: baseURL,
+			Timeout:     1000,
+			Retry:       1,
+			ExpiryAssertions: []*v1.ExpiryAssertion{
+				{Comparator: v1.NumberComparator_NUMBER_COMPARATOR_GREATER_THAN, Target: 30},
+			},
+			StatusAssertions: []*v1.ValueAssertion{
+				{Comparator: v1.StringComparator_STRING_COMPARATOR_NOT_CONTAINS, Target: "clientHold"},
+			},
+		}
+	}
+
+	t.Run("a healthy registration succeeds", func(t *testing.T) {
+		baseURL := rdapStandIn(t, time.Now().AddDate(1, 0, 0), "active")
+
+		data, err := job.NewJobRunner().DomainJob(context.Background(), monitor(baseURL), "test-region")
+		require.NoError(t, err)
+		assert.Equal(t, "success", data.RequestStatus)
+		assert.Contains(t, data.Message, "openstatus.dev expires")
+	})
+
+	t.Run("an expiry within the window fails", func(t *testing.T) {
+		baseURL := rdapStandIn(t, time.Now().AddDate(0, 0, 10), "active")
+
+		data, err := job.NewJobRunner().DomainJob(context.Background(), monitor(baseURL), "test-region")
+		require.NoError(t, err)
+		assert.Equal(t, "error", data.RequestStatus)
+		assert.Contains(t, data.Message, "Assertions failed")
+		assert.Contains(t, data.Message, "(9 days)")
+	})
+
+	t.Run("a domain on hold fails", func(t *testing.T) {
+		baseURL := rdapStandIn(t, time.Now().AddDate(1, 0, 0), "client hold")
+
+		data, err := job.NewJobRunner().DomainJob(context.Background(), monitor(baseURL), "test-region")
+		require.NoError(t, err)
+		assert.Equal(t, "error", data.RequestStatus)
+		assert.Contains(t, data.Message, `status "clientHold"`)
+	})
+}
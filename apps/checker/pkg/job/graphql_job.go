@@ -0,0 +1,186 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/google/uuid"
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/assertions"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/otel"
+	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
+	"github.com/openstatushq/openstatus/apps/checker/request"
+)
+
+// graphQLFailureMessage prefers the server's own GraphQL error over the
+// generic HTTP explanation, since resolver failures come back with a 200.
+func graphQLFailureMessage(res checker.GraphQLResponse, statusOK bool) string {
+	if res.Error == "" {
+		if msg := res.ErrorMessage(); msg != "" {
+			return msg
+		}
+	}
+
+	return httpFailureMessage(res.Response, statusOK)
+}
+
+func (jr jobRunner) GraphQLJob(ctx context.Context, monitor *v1.GraphQLMonitor, region string) (*HttpPrivateRegionData, error) {
+	retry := monitor.Retry
+	if retry == 0 {
+		retry = 3
+	}
+
+	requestClient := newRequestClient(monitor.Timeout, monitor.FollowRedirects)
+	defer requestClient.CloseIdleConnections()
+
+	var degradedAfter int64
+	if monitor.DegradedAt != nil {
+		degradedAfter = *monitor.DegradedAt
+	}
+
+	var variables json.RawMessage
+	if monitor.Variables != "" {
+		if !json.Valid([]byte(monitor.Variables)) {
+			return nil, fmt.Errorf("invalid graphql variables for monitor %s", monitor.Id)
+		}
+		variables = json.RawMessage(monitor.Variables)
+	}
+
+	req := request.GraphQLCheckerRequest{
+		HttpCheckerRequest: request.HttpCheckerRequest{
+			URL:             monitor.Url,
+			MonitorID:       monitor.Id,
+			Method:          http.MethodPost,
+			Retry:           monitor.Retry,
+			Timeout:         monitor.Timeout,
+			DegradedAfter:   degradedAfter,
+			FollowRedirects: monitor.FollowRedirects,
+			Headers:         requestHeaders(monitor.Headers),
+			Auth:            httpAuth(monitor.GetAuth()),
+		},
+		Query:         monitor.Query,
+		Variables:     variables,
+		OperationName: monitor.OperationName,
+	}
+	if otelCfg := monitor.GetOtelConfig(); otelCfg.GetEndpoint() != "" {
+		req.OtelConfig.Endpoint = otelCfg.GetEndpoint()
+		req.OtelConfig.Headers = headersToMap(otelCfg.GetHeaders())
+		req.OtelConfig.Traces = otelCfg.GetTraces()
+	}
+
+	var called int
+	var lastRes checker.Response
+	var attempts []otel.HTTPAttempt
+	if req.OtelConfig.Traces {
+		ctx = otel.WithCheckTrace(ctx)
+	}
+
+	op := func() (*HttpPrivateRegionData, error) {
+		called++
+		rendered, err := checker.RenderGraphQLRequest(req, jr.templateEnv)
+		if err != nil {
+			return nil, backoff.Permanent(fmt.Errorf("%w: %w", errInvalidTemplate, err))
+		}
+		res, err := checker.GraphQL(ctx, requestClient, rendered, jr.tokens)
+		if err != nil {
+			return nil, fmt.Errorf("unable to ping: %w", err)
+		}
+		lastRes = res.Response
+
+		timingBytes, err := json.Marshal(res.Timing)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing timing data %s: %w", req.URL, err)
+		}
+		headersBytes, err := json.Marshal(res.Headers)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing headers %s: %w", req.URL, err)
+		}
+		id, err := uuid.NewV7()
+		if err != nil {
+			return nil, fmt.Errorf("error while generating uuid: %w", err)
+		}
+
+		isSuccessful, err := evaluateStatusAndHeaderAssertions(res.Response, monitor.StatusCodeAssertions, monitor.HeaderAssertions)
+		if err != nil {
+			return nil, fmt.Errorf("error while evaluating assertions %s: %w", req.URL, err)
+		}
+		isSuccessful = isSuccessful && res.Error == "" && len(res.Errors) == 0
+
+		for _, assertion := range monitor.DataAssertions {
+			a, err := ProtoStringAssertionToComparator(assertion.Comparator)
+			if err != nil {
+				return nil, fmt.Errorf("error while parsing data assertion comparator: %w", err)
+			}
+			assert := assertions.JsonBodyTarget{
+				Comparator: a,
+				Path:       assertion.Path,
+				Target:     assertion.Target,
+			}
+			isSuccessful = isSuccessful && assert.JsonBodyEvaluate(res.Data)
+		}
+
+		attempts = append(attempts, otel.HTTPAttempt{Response: res.Response, AssertionsFailed: !isSuccessful && res.Error == ""})
+
+		requestStatus := "success"
+		if !isSuccessful {
+			requestStatus = "error"
+		} else if req.DegradedAfter > 0 && res.Latency > req.DegradedAfter {
+			requestStatus = "degraded"
+		}
+
+		data := HttpPrivateRegionData{
+			ID:            id.String(),
+			Latency:       res.Latency,
+			StatusCode:    res.Status,
+			Timestamp:     res.Timestamp,
+			CronTimestamp: res.Timestamp,
+			URL:           req.URL,
+			Timing:        string(timingBytes),
+			Headers:       string(headersBytes),
+			RequestStatus: requestStatus,
+			TraceID:       res.TraceID,
+		}
+
+		if isSuccessful {
+			if req.DegradedAfter != 0 && res.Latency > req.DegradedAfter {
+				data.Body = res.Body
+			}
+		} else {
+			data.Error = 1
+			data.Message = graphQLFailureMessage(res, statusCode(res.Status).IsSuccessful())
+			lastRes.Error = "Error"
+			if called < int(retry) {
+				return nil, fmt.Errorf("unable to ping: %v with status %v", res.Response, res.Status)
+			}
+		}
+
+		return &data, nil
+	}
+
+	resp, err := backoff.Retry(ctx, op, backoff.WithMaxTries(uint(retry)), backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if req.OtelConfig.Endpoint != "" {
+		if err != nil && lastRes.Error == "" {
+			lastRes.Error = err.Error()
+		}
+		jr.meters.RecordHTTPMetrics(ctx, req.HttpCheckerRequest, lastRes, region)
+		jr.loggers.RecordHTTPResult(ctx, req.HttpCheckerRequest, finalAttempt(attempts, lastRes), region)
+		if req.OtelConfig.Traces {
+			otel.RecordHTTPTrace(ctx, req.HttpCheckerRequest, attempts, region)
+		} else {
+			otel.RecordHTTPSpan(ctx, req.HttpCheckerRequest, lastRes, region)
+		}
+	}
+
+	if errors.Is(err, errInvalidTemplate) {
+		return templateFailure(req.URL, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,66 @@
+package job_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/openstatushq/openstatus/apps/checker/pkg/job"
+	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func graphQLServer(t *testing.T, response string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "{ user { name } }", payload["query"])
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGraphQLJob(t *testing.T) {
+	t.Run("data assertions are evaluated against data", func(t *testing.T) {
+		server := graphQLServer(t, `{"data":{"user":{"name":"openstatus"}}}`)
+		monitor := &v1.GraphQLMonitor{
+			Url:     server.URL,
+			Query:   "{ user { name } }",
+			Timeout: 1000,
+			Retry:   1,
+			DataAssertions: []*v1.JsonBodyAssertion{
+				{Path: "$.user.name", Comparator: v1.StringComparator_STRING_COMPARATOR_EQUAL, Target: "openstatus"},
+			},
+		}
+
+		data, err := job.NewJobRunner().GraphQLJob(context.Background(), monitor, "test-region")
+		require.NoError(t, err)
+		assert.Equal(t, "success", data.RequestStatus)
+		assert.Equal(t, uint8(0), data.Error)
+	})
+
+	t.Run("an errors array fails the check with the server message", func(t *testing.T) {
+		server := graphQLServer(t, `{"data":null,"errors":[{"message":"not authorised"}]}`)
+		monitor := &v1.GraphQLMonitor{Url: server.URL, Query: "{ user { name } }", Timeout: 1000, Retry: 1}
+
+		data, err := job.NewJobRunner().GraphQLJob(context.Background(), monitor, "test-region")
+		require.NoError(t, err)
+		assert.Equal(t, "error", data.RequestStatus)
+		assert.Equal(t, 200, data.StatusCode)
+		assert.Equal(t, "GraphQL error: not authorised", data.Message)
+	})
+
+	t.Run("invalid variables are rejected", func(t *testing.T) {
+		monitor := &v1.GraphQLMonitor{Url: "http://localhost", Query: "{ user { name } }", Variables: "{", Retry: 1}
+
+		_, err := job.NewJobRunner().GraphQLJob(context.Background(), monitor, "test-region")
+		assert.Error(t, err)
+	})
+}
@@ -3,6 +3,7 @@ package job
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -67,6 +68,16 @@ func httpFailureMessage(res checker.Response, statusOK bool) string {
 	}
 }
 
+// finalAttempt is the attempt the check ended with, as exported in its OTel
+// log record. A check that ended before getting a response falls back to
+// lastRes, which carries the error.
+func finalAttempt(attempts []otel.HTTPAttempt, lastRes checker.Response) otel.HTTPAttempt {
+	if n := len(attempts); n > 0 && attempts[n-1].Response.Timestamp == lastRes.Timestamp {
+		return attempts[n-1]
+	}
+	return otel.HTTPAttempt{Response: lastRes}
+}
+
 func (jr jobRunner) HTTPJob(ctx context.Context, monitor *v1.HTTPMonitor, region string) (*HttpPrivateRegionData, error) {
 
 	retry := monitor.Retry
@@ -74,44 +85,15 @@ func (jr jobRunner) HTTPJob(ctx context.Context, monitor *v1.HTTPMonitor, region
 		retry = 3
 	}
 
-	requestClient := &http.Client{
-		Timeout: time.Duration(monitor.Timeout) * time.Millisecond,
-	}
+	requestClient := newRequestClient(monitor.Timeout, monitor.FollowRedirects)
 	defer requestClient.CloseIdleConnections()
 
-	if !monitor.FollowRedirects {
-		requestClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
-			return http.ErrUseLastResponse
-		}
-	} else {
-		requestClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
-			if len(via) >= 10 {
-				return http.ErrUseLastResponse
-			}
-			return nil
-		}
-	}
-
 	var degradedAfter int64
 	if monitor.DegradedAt != nil {
 		degradedAfter = *monitor.DegradedAt
 	}
 
-	headers := make([]struct {
-		Key   string `json:"key"`
-		Value string `json:"value"`
-	}, 0)
-	if monitor.Headers != nil {
-		for _, header := range monitor.Headers {
-			headers = append(headers, struct {
-				Key   string `json:"key"`
-				Value string `json:"value"`
-			}{
-				Key:   header.Key,
-				Value: header.Value,
-			})
-		}
-	}
+	headers := requestHeaders(monitor.Headers)
 
 	req := request.HttpCheckerRequest{
 		URL:             monitor.Url,
@@ -123,18 +105,28 @@ func (jr jobRunner) HTTPJob(ctx context.Context, monitor *v1.HTTPMonitor, region
 		DegradedAfter:   degradedAfter,
 		FollowRedirects: monitor.FollowRedirects,
 		Headers:         headers,
+		Auth:            httpAuth(monitor.GetAuth()),
 	}
 	if otelCfg := monitor.GetOtelConfig(); otelCfg.GetEndpoint() != "" {
 		req.OtelConfig.Endpoint = otelCfg.GetEndpoint()
 		req.OtelConfig.Headers = headersToMap(otelCfg.GetHeaders())
+		req.OtelConfig.Traces = otelCfg.GetTraces()
 	}
 
 	var called int
 	var lastRes checker.Response
+	var attempts []otel.HTTPAttempt
+	if req.OtelConfig.Traces {
+		ctx = otel.WithCheckTrace(ctx)
+	}
 
 	op := func() (*HttpPrivateRegionData, error) {
 		called++
-		res, err := checker.Http(ctx, requestClient, req)
+		rendered, err := checker.RenderRequest(req, jr.templateEnv)
+		if err != nil {
+			return nil, backoff.Permanent(fmt.Errorf("%w: %w", errInvalidTemplate, err))
+		}
+		res, err := checker.AuthorizedHttp(ctx, requestClient, rendered, jr.tokens)
 		if err != nil {
 			return nil, fmt.Errorf("unable to ping: %w", err)
 		}
@@ -154,47 +146,9 @@ func (jr jobRunner) HTTPJob(ctx context.Context, monitor *v1.HTTPMonitor, region
 		}
 
 		status := statusCode(res.Status)
-		// Only use default 2xx check if no status assertions exist
-		// If status assertions are configured, let them determine success
-		hasStatusAssertions := len(monitor.StatusCodeAssertions) > 0
-		isSuccessful := status.IsSuccessful()
-		if hasStatusAssertions {
-			isSuccessful = true // Start with true, assertions will override
-		}
-
-		if len(monitor.HeaderAssertions) > 0 {
-			headersAsString, err := json.Marshal(res.Headers)
-			if err != nil {
-				return nil, fmt.Errorf("error while parsing headers %s: %w", req.URL, err)
-			}
-			for _, assertion := range monitor.HeaderAssertions {
-
-				a, err := ProtoStringAssertionToComparator(assertion.Comparator)
-				if err != nil {
-					return nil, fmt.Errorf("error while parsing header assertion comparator: %w", err)
-				}
-				assert := assertions.HeaderTarget{
-					Comparator: a,
-					Target:     assertion.Target,
-					Key:        assertion.Key,
-				}
-				isSuccessful = isSuccessful && assert.HeaderEvaluate(string(headersAsString))
-			}
-		}
-
-		if len(monitor.StatusCodeAssertions) > 0 {
-			for _, assertion := range monitor.StatusCodeAssertions {
-				a, err := ProtoNumberAssertionToComparator(assertion.Comparator)
-				if err != nil {
-					return nil, fmt.Errorf("error while parsing header assertion comparator: %w", err)
-				}
-
-				assert := assertions.StatusTarget{
-					Comparator: a,
-					Target:     assertion.Target,
-				}
-				isSuccessful = isSuccessful && assert.StatusEvaluate(int64(res.Status))
-			}
+		isSuccessful, err := evaluateStatusAndHeaderAssertions(res, monitor.StatusCodeAssertions, monitor.HeaderAssertions)
+		if err != nil {
+			return nil, fmt.Errorf("error while evaluating assertions %s: %w", req.URL, err)
 		}
 		if len(monitor.BodyAssertions) > 0 {
 			for _, assertion := range monitor.BodyAssertions {
@@ -210,6 +164,8 @@ func (jr jobRunner) HTTPJob(ctx context.Context, monitor *v1.HTTPMonitor, region
 			}
 		}
 
+		attempts = append(attempts, otel.HTTPAttempt{Response: res, AssertionsFailed: !isSuccessful && res.Error == ""})
+
 		requestStatus := "success"
 		if !isSuccessful {
 			requestStatus = "error"
@@ -229,6 +185,7 @@ func (jr jobRunner) HTTPJob(ctx context.Context, monitor *v1.HTTPMonitor, region
 			Headers:       string(headersBytes),
 			Body:          "",
 			RequestStatus: requestStatus,
+			TraceID:       res.TraceID,
 			// Assertions:    assertionAsString,
 			Error: 0,
 		}
@@ -240,12 +197,21 @@ func (jr jobRunner) HTTPJob(ctx context.Context, monitor *v1.HTTPMonitor, region
 		} else {
 			data.Error = 1
 			data.Message = httpFailureMessage(res, status.IsSuccessful())
+			data.ErrorCode = string(checker.HTTPErrorCode(res))
 			// Mark the recorded response as errored so OTel emits the error counter
 			// for non-2xx / failed assertions, matching the public checker.
 			lastRes.Error = "Error"
+			lastRes.ErrorCode = checker.HTTPErrorCode(res)
 			if called < int(retry) {
 				return nil, fmt.Errorf("unable to ping: %v with status %v", res, res.Status)
 			}
+			if res.Error != "" && res.ErrorCode != checker.ErrorAuthFailed {
+				diagnosis := checker.Diagnose(ctx, rendered.URL, checker.DiagnosisTimeout)
+				data.Message = fmt.Sprintf("%s (%s)", data.Message, diagnosis.Summary)
+				if diagnosisBytes, err := json.Marshal(diagnosis); err == nil {
+					data.Diagnosis = string(diagnosisBytes)
+				}
+			}
 		}
 
 		return &data, nil
@@ -256,12 +222,141 @@ func (jr jobRunner) HTTPJob(ctx context.Context, monitor *v1.HTTPMonitor, region
 	if req.OtelConfig.Endpoint != "" {
 		if err != nil && lastRes.Error == "" {
 			lastRes.Error = err.Error()
+			lastRes.ErrorCode = checker.Classify(err)
+		}
+		jr.meters.RecordHTTPMetrics(ctx, req, lastRes, region)
+		jr.loggers.RecordHTTPResult(ctx, req, finalAttempt(attempts, lastRes), region)
+		if req.OtelConfig.Traces {
+			otel.RecordHTTPTrace(ctx, req, attempts, region)
+		} else {
+			otel.RecordHTTPSpan(ctx, req, lastRes, region)
 		}
-		otel.RecordHTTPMetrics(ctx, req, lastRes, region)
 	}
 
+	if errors.Is(err, errInvalidTemplate) {
+		return templateFailure(req.URL, err)
+	}
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
+
+func httpAuth(auth *v1.HTTPAuth) *request.HTTPAuth {
+	if auth == nil {
+		return nil
+	}
+
+	res := &request.HTTPAuth{}
+	if oauth := auth.GetOauth2(); oauth != nil {
+		res.OAuth2 = &request.OAuth2ClientCredentials{
+			TokenURL:     oauth.GetTokenUrl(),
+			ClientID:     oauth.GetClientId(),
+			ClientSecret: oauth.GetClientSecret(),
+			Scopes:       oauth.GetScopes(),
+			Audience:     oauth.GetAudience(),
+		}
+	}
+	if hmac := auth.GetHmac(); hmac != nil {
+		res.HMAC = &request.HMACSigning{
+			Secret:          hmac.GetSecret(),
+			Algorithm:       hmac.GetAlgorithm(),
+			SignatureHeader: hmac.GetSignatureHeader(),
+			TimestampHeader: hmac.GetTimestampHeader(),
+		}
+	}
+	if sigv4 := auth.GetSigv4(); sigv4 != nil {
+		res.SigV4 = &request.SigV4Signing{
+			AccessKeyID:     sigv4.GetAccessKeyId(),
+			SecretAccessKey: sigv4.GetSecretAccessKey(),
+			SessionToken:    sigv4.GetSessionToken(),
+			Region:          sigv4.GetRegion(),
+			Service:         sigv4.GetService(),
+		}
+	}
+	return res
+}
+
+func newRequestClient(timeout int64, followRedirects bool) *http.Client {
+	requestClient := &http.Client{
+		Timeout: time.Duration(timeout) * time.Millisecond,
+	}
+
+	if !followRedirects {
+		requestClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	} else {
+		requestClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return http.ErrUseLastResponse
+			}
+			return nil
+		}
+	}
+
+	return requestClient
+}
+
+func requestHeaders(monitorHeaders []*v1.Headers) []struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+} {
+	headers := make([]struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}, 0, len(monitorHeaders))
+	for _, header := range monitorHeaders {
+		headers = append(headers, struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		}{
+			Key:   header.Key,
+			Value: header.Value,
+		})
+	}
+
+	return headers
+}
+
+// evaluateStatusAndHeaderAssertions only applies the default 2xx check when
+// no status assertions exist; configured status assertions decide on their own.
+func evaluateStatusAndHeaderAssertions(res checker.Response, statusAssertions []*v1.StatusCodeAssertion, headerAssertions []*v1.HeaderAssertion) (bool, error) {
+	isSuccessful := statusCode(res.Status).IsSuccessful()
+	if len(statusAssertions) > 0 {
+		isSuccessful = true // Start with true, assertions will override
+	}
+
+	if len(headerAssertions) > 0 {
+		headersAsString, err := json.Marshal(res.Headers)
+		if err != nil {
+			return false, fmt.Errorf("error while parsing headers: %w", err)
+		}
+		for _, assertion := range headerAssertions {
+			a, err := ProtoStringAssertionToComparator(assertion.Comparator)
+			if err != nil {
+				return false, fmt.Errorf("error while parsing header assertion comparator: %w", err)
+			}
+			assert := assertions.HeaderTarget{
+				Comparator: a,
+				Target:     assertion.Target,
+				Key:        assertion.Key,
+			}
+			isSuccessful = isSuccessful && assert.HeaderEvaluate(string(headersAsString))
+		}
+	}
+
+	for _, assertion := range statusAssertions {
+		a, err := ProtoNumberAssertionToComparator(assertion.Comparator)
+		if err != nil {
+			return false, fmt.Errorf("error while parsing status assertion comparator: %w", err)
+		}
+		assert := assertions.StatusTarget{
+			Comparator: a,
+			Target:     assertion.Target,
+		}
+		isSuccessful = isSuccessful && assert.StatusEvaluate(int64(res.Status))
+	}
+
+	return isSuccessful, nil
+}
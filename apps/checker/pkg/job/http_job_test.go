@@ -258,3 +258,61 @@ func TestHTTPJob_FailureMessage(t *testing.T) {
 		assert.Empty(t, data.Message)
 	})
 }
+
+func TestHTTPJob_InvalidTemplate(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	defer srv.Close()
+
+	monitor := &v1.HTTPMonitor{
+		Url: srv.URL, Method: "GET", Timeout: 10000, Retry: 3,
+		Headers: []*v1.Headers{{Key: "Authorization", Value: `Bearer {{env "MISSING_TOKEN"}}`}},
+	}
+
+	env := func(string) (string, bool) { return "", false }
+	data, err := job.NewJobRunnerWithEnv(env).HTTPJob(context.Background(), monitor, "test-region")
+	if err != nil {
+		t.Fatalf("expected the failure to be reported as a check, got %v", err)
+	}
+	assert.Equal(t, 0, hits, "the request must not be sent")
+	assert.Equal(t, uint8(1), data.Error)
+	assert.Equal(t, "error", data.RequestStatus)
+	assert.Contains(t, data.Message, "invalid request template")
+	assert.Contains(t, data.Message, "environment variable MISSING_TOKEN is not set")
+}
+
+func TestHTTPJob_Diagnosis(t *testing.T) {
+	t.Run("diagnoses a transport failure", func(t *testing.T) {
+		srv := httptest.NewServer(http.NotFoundHandler())
+		url := srv.URL
+		srv.Close()
+
+		monitor := &v1.HTTPMonitor{Url: url, Method: "GET", Timeout: 1000, Retry: 1}
+
+		data, err := job.NewJobRunner().HTTPJob(context.Background(), monitor, "test-region")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		assert.Equal(t, uint8(1), data.Error)
+		assert.Contains(t, data.Message, "(DNS skipped, 1/1 IPs refuse connections)")
+		assert.Contains(t, data.Diagnosis, `"connect":"refused"`)
+	})
+
+	t.Run("leaves a server answering with an error alone", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer srv.Close()
+
+		monitor := &v1.HTTPMonitor{Url: srv.URL, Method: "GET", Timeout: 10000, Retry: 1}
+
+		data, err := job.NewJobRunner().HTTPJob(context.Background(), monitor, "test-region")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		assert.Equal(t, uint8(1), data.Error)
+		assert.Empty(t, data.Diagnosis)
+	})
+}
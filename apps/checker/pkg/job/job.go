@@ -2,7 +2,14 @@ package job
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/google/uuid"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/otel"
 	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
 )
 
@@ -25,18 +32,76 @@ type HttpPrivateRegionData struct {
 	Timestamp     int64  `json:"timestamp"`
 	StatusCode    int    `json:"statusCode,omitempty"`
 	Error         uint8  `json:"error"`
+	Diagnosis     string `json:"diagnosis,omitempty"`
+	ErrorCode     string `json:"errorCode,omitempty"`
+	TraceID       string `json:"traceId,omitempty"`
 }
 
 type JobRunner interface {
 	TCPJob(ctx context.Context, monitor *v1.TCPMonitor, region string) (*TCPPrivateRegionData, error)
 	HTTPJob(ctx context.Context, monitor *v1.HTTPMonitor, region string) (*HttpPrivateRegionData, error)
+	GraphQLJob(ctx context.Context, monitor *v1.GraphQLMonitor, region string) (*HttpPrivateRegionData, error)
 	DNSJob(ctx context.Context, monitor *v1.DNSMonitor) (*DNSPrivateRegionData, error)
+	DatabaseJob(ctx context.Context, monitor *v1.DatabaseMonitor, region string) (*TCPPrivateRegionData, error)
+	NTPJob(ctx context.Context, monitor *v1.NTPMonitor, region string) (*TCPPrivateRegionData, error)
+	DomainJob(ctx context.Context, monitor *v1.DomainMonitor, region string) (*TCPPrivateRegionData, error)
+	Shutdown(ctx context.Context) error
 }
 
-type jobRunner struct{}
+type jobRunner struct {
+	// tokens outlives single checks so OAuth2 tokens are reused until expiry.
+	tokens *checker.OAuth2TokenCache
+	// meters keeps one OTLP meter provider per monitor otel endpoint.
+	meters *otel.MeterProviders
+	// loggers keeps one OTLP logger provider per monitor otel endpoint.
+	loggers *otel.LoggerProviders
+	// templateEnv resolves {{env "NAME"}} in HTTP and GraphQL requests.
+	templateEnv checker.TemplateEnv
+}
 
 func NewJobRunner() JobRunner {
-	return &jobRunner{}
+	return NewJobRunnerWithEnv(nil)
+}
+
+// NewJobRunnerWithEnv returns a runner whose HTTP and GraphQL requests can
+// read env, the environment of the private location host.
+func NewJobRunnerWithEnv(env checker.TemplateEnv) JobRunner {
+	return &jobRunner{
+		tokens:      checker.NewOAuth2TokenCache(),
+		meters:      otel.NewMeterProviders(otel.DefaultExportInterval),
+		loggers:     otel.NewLoggerProviders(),
+		templateEnv: env,
+	}
+}
+
+// Shutdown flushes the metrics and log records not exported yet.
+func (jr jobRunner) Shutdown(ctx context.Context) error {
+	return errors.Join(jr.meters.Shutdown(ctx), jr.loggers.Shutdown(ctx))
+}
+
+// errInvalidTemplate wraps the errors of a request that cannot be rendered.
+// Retrying would not help, so the check fails at once without reaching the
+// endpoint.
+var errInvalidTemplate = errors.New("invalid request template")
+
+// templateFailure reports the check whose request could not be rendered.
+func templateFailure(url string, err error) (*HttpPrivateRegionData, error) {
+	id, uuidErr := uuid.NewV7()
+	if uuidErr != nil {
+		return nil, fmt.Errorf("error while generating uuid: %w", uuidErr)
+	}
+	now := time.Now().UnixMilli()
+
+	return &HttpPrivateRegionData{
+		ID:            id.String(),
+		URL:           url,
+		Timestamp:     now,
+		CronTimestamp: now,
+		RequestStatus: "error",
+		Error:         1,
+		Message:       err.Error(),
+		ErrorCode:     string(checker.ErrorUnknown),
+	}, nil
 }
 
 func headersToMap(headers []*v1.Headers) map[string]string {
@@ -0,0 +1,100 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/assertions"
+	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
+)
+
+func mailProtocol(protocol v1.TCPProtocol) (string, error) {
+	switch protocol {
+	case v1.TCPProtocol_TCP_PROTOCOL_SMTP:
+		return checker.MailSMTP, nil
+	case v1.TCPProtocol_TCP_PROTOCOL_IMAP:
+		return checker.MailIMAP, nil
+	case v1.TCPProtocol_TCP_PROTOCOL_POP3:
+		return checker.MailPOP3, nil
+	}
+	return "", fmt.Errorf("unknown tcp protocol: %v", protocol)
+}
+
+// evaluateMailAssertions checks the SMTP greeting code and the advertised
+// capabilities. Without reply code assertions an SMTP greeting must be 2xx.
+func evaluateMailAssertions(monitor *v1.TCPMonitor, res checker.MailResult) (bool, error) {
+	isSuccessful := true
+	if monitor.Protocol == v1.TCPProtocol_TCP_PROTOCOL_SMTP && len(monitor.ReplyCodeAssertions) == 0 {
+		isSuccessful = res.ReplyCode >= 200 && res.ReplyCode < 300
+	}
+	for _, assertion := range monitor.ReplyCodeAssertions {
+		a, err := ProtoNumberAssertionToComparator(assertion.Comparator)
+		if err != nil {
+			return false, fmt.Errorf("error while parsing reply code assertion comparator: %w", err)
+		}
+		assert := assertions.StatusTarget{
+			Comparator: a,
+			Target:     assertion.Target,
+		}
+		isSuccessful = isSuccessful && assert.StatusEvaluate(int64(res.ReplyCode))
+	}
+	capabilities := strings.Join(res.Capabilities, " ")
+	for _, assertion := range monitor.CapabilityAssertions {
+		a, err := ProtoStringAssertionToComparator(assertion.Comparator)
+		if err != nil {
+			return false, fmt.Errorf("error while parsing capability assertion comparator: %w", err)
+		}
+		assert := assertions.StringTargetType{
+			Comparator: a,
+			Target:     assertion.Target,
+		}
+		isSuccessful = isSuccessful && assert.StringEvaluate(capabilities)
+	}
+	return isSuccessful, nil
+}
+
+func mailFailureMessage(res checker.MailResult) string {
+	greeting := res.Greeting
+	if res.ReplyCode != 0 {
+		greeting = fmt.Sprintf("%d %s", res.ReplyCode, res.Greeting)
+	}
+	return fmt.Sprintf("Assertions failed: got greeting %q and capabilities %q", greeting, strings.Join(res.Capabilities, " "))
+}
+
+// mailJob runs the mail dialogue for SMTP, IMAP and POP3 monitors.
+func (jr jobRunner) mailJob(ctx context.Context, monitor *v1.TCPMonitor, region string) (*TCPPrivateRegionData, error) {
+	protocol, err := mailProtocol(monitor.Protocol)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := checker.MailOptions{
+		StartTLS:    monitor.GetMail().GetStarttls(),
+		ImplicitTLS: monitor.GetMail().GetImplicitTls(),
+		Username:    monitor.GetMail().GetUsername(),
+		Password:    monitor.GetMail().GetPassword(),
+		EhloName:    monitor.GetMail().GetEhloName(),
+	}
+
+	return jr.protocolJob(ctx, monitor, region, protocol, func() (protocolOutcome, error) {
+		res, err := checker.PingMail(ctx, protocol, monitor.Uri, opts, time.Duration(monitor.Timeout)*time.Millisecond)
+		if err != nil {
+			return protocolOutcome{}, err
+		}
+		ok, err := evaluateMailAssertions(monitor, res)
+		if err != nil {
+			return protocolOutcome{}, backoff.Permanent(err)
+		}
+		if !ok {
+			return protocolOutcome{Timing: res.Timing, Message: mailFailureMessage(res), Failed: true}, nil
+		}
+		return protocolOutcome{
+			Timing:  res.Timing,
+			Message: fmt.Sprintf("Successfully checked %s on %s", strings.ToUpper(protocol), monitor.Uri),
+		}, nil
+	})
+}
@@ -0,0 +1,121 @@
+package job_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/checker/pkg/job"
+	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
+)
+
+// smtpStandIn greets with the given line and answers EHLO with PIPELINING.
+func smtpStandIn(t *testing.T, greeting string) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				fmt.Fprintf(conn, "%s\r\n", greeting)
+				r := bufio.NewReader(conn)
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					switch {
+					case strings.HasPrefix(line, "EHLO"):
+						fmt.Fprint(conn, "250-mail.test\r\n250 PIPELINING\r\n")
+					case strings.HasPrefix(line, "QUIT"):
+						fmt.Fprint(conn, "221 Bye\r\n")
+						return
+					default:
+						fmt.Fprint(conn, "502 Command not implemented\r\n")
+					}
+				}
+			}()
+		}
+	}()
+
+	return ln.Addr().String()
+}
+
+func TestTCPJob_SMTP(t *testing.T) {
+	t.Run("a healthy relay succeeds with phases", func(t *testing.T) {
+		monitor := &v1.TCPMonitor{
+			Uri:      smtpStandIn(t, "220 mail.test ESMTP"),
+			Protocol: v1.TCPProtocol_TCP_PROTOCOL_SMTP,
+			Timeout:  1000,
+			Retry:    1,
+			CapabilityAssertions: []*v1.ValueAssertion{
+				{Comparator: v1.StringComparator_STRING_COMPARATOR_CONTAINS, Target: "PIPELINING"},
+			},
+		}
+
+		data, err := job.NewJobRunner().TCPJob(context.Background(), monitor, "test-region")
+		require.NoError(t, err)
+		assert.Equal(t, "success", data.RequestStatus)
+		assert.Contains(t, data.Timing, `"name":"greeting"`)
+	})
+
+	t.Run("a refusing greeting fails without assertions", func(t *testing.T) {
+		monitor := &v1.TCPMonitor{
+			Uri:      smtpStandIn(t, "554 No SMTP service here"),
+			Protocol: v1.TCPProtocol_TCP_PROTOCOL_SMTP,
+			Timeout:  1000,
+			Retry:    1,
+		}
+
+		data, err := job.NewJobRunner().TCPJob(context.Background(), monitor, "test-region")
+		require.NoError(t, err)
+		assert.Equal(t, "error", data.RequestStatus)
+		assert.Contains(t, data.Message, "554")
+	})
+
+	t.Run("reply code assertions override the default", func(t *testing.T) {
+		monitor := &v1.TCPMonitor{
+			Uri:      smtpStandIn(t, "554 No SMTP service here"),
+			Protocol: v1.TCPProtocol_TCP_PROTOCOL_SMTP,
+			Timeout:  1000,
+			Retry:    1,
+			ReplyCodeAssertions: []*v1.StatusCodeAssertion{
+				{Comparator: v1.NumberComparator_NUMBER_COMPARATOR_EQUAL, Target: 554},
+			},
+		}
+
+		data, err := job.NewJobRunner().TCPJob(context.Background(), monitor, "test-region")
+		require.NoError(t, err)
+		assert.Equal(t, "success", data.RequestStatus)
+	})
+
+	t.Run("a missing capability fails", func(t *testing.T) {
+		monitor := &v1.TCPMonitor{
+			Uri:      smtpStandIn(t, "220 mail.test ESMTP"),
+			Protocol: v1.TCPProtocol_TCP_PROTOCOL_SMTP,
+			Timeout:  1000,
+			Retry:    1,
+			CapabilityAssertions: []*v1.ValueAssertion{
+				{Comparator: v1.StringComparator_STRING_COMPARATOR_CONTAINS, Target: "STARTTLS"},
+			},
+		}
+
+		data, err := job.NewJobRunner().TCPJob(context.Background(), monitor, "test-region")
+		require.NoError(t, err)
+		assert.Equal(t, "error", data.RequestStatus)
+		assert.Contains(t, data.Message, "Assertions failed")
+	})
+}
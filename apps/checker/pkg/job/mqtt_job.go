@@ -0,0 +1,32 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
+)
+
+// mqttJob publishes to the test topic and waits for the broker to deliver
+// the message back.
+func (jr jobRunner) mqttJob(ctx context.Context, monitor *v1.TCPMonitor, region string) (*TCPPrivateRegionData, error) {
+	opts := checker.MQTTOptions{
+		Topic:    monitor.GetMqtt().GetTopic(),
+		Username: monitor.GetMqtt().GetUsername(),
+		Password: monitor.GetMqtt().GetPassword(),
+		TLS:      monitor.GetMqtt().GetTls(),
+	}
+
+	return jr.protocolJob(ctx, monitor, region, "mqtt", func() (protocolOutcome, error) {
+		timing, err := checker.PingMQTT(ctx, monitor.Uri, opts, time.Duration(monitor.Timeout)*time.Millisecond)
+		if err != nil {
+			return protocolOutcome{}, err
+		}
+		return protocolOutcome{
+			Timing:  timing,
+			Message: fmt.Sprintf("Successfully exchanged a message with %s", monitor.Uri),
+		}, nil
+	})
+}
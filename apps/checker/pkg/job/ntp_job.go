@@ -0,0 +1,67 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/assertions"
+	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
+)
+
+// evaluateNTPAssertions checks the absolute offset in milliseconds and the
+// stratum; without assertions any synchronized answer is a success.
+func evaluateNTPAssertions(monitor *v1.NTPMonitor, res checker.NTPResult) (bool, error) {
+	offset := res.Offset.Abs().Milliseconds()
+
+	isSuccessful := true
+	for _, assertion := range monitor.OffsetAssertions {
+		a, err := ProtoNumberAssertionToComparator(assertion.Comparator)
+		if err != nil {
+			return false, fmt.Errorf("error while parsing offset assertion comparator: %w", err)
+		}
+		assert := assertions.StatusTarget{
+			Comparator: a,
+			Target:     assertion.Target,
+		}
+		isSuccessful = isSuccessful && assert.StatusEvaluate(offset)
+	}
+	for _, assertion := range monitor.StratumAssertions {
+		a, err := ProtoNumberAssertionToComparator(assertion.Comparator)
+		if err != nil {
+			return false, fmt.Errorf("error while parsing stratum assertion comparator: %w", err)
+		}
+		assert := assertions.StatusTarget{
+			Comparator: a,
+			Target:     assertion.Target,
+		}
+		isSuccessful = isSuccessful && assert.StatusEvaluate(int64(res.Stratum))
+	}
+	return isSuccessful, nil
+}
+
+func ntpSummary(res checker.NTPResult) string {
+	return fmt.Sprintf("stratum %d (%s), offset %s, delay %s", res.Stratum, res.ReferenceID, res.Offset.Round(time.Microsecond), res.Delay.Round(time.Microsecond))
+}
+
+func (jr jobRunner) NTPJob(ctx context.Context, monitor *v1.NTPMonitor, region string) (*TCPPrivateRegionData, error) {
+	return jr.protocolJob(ctx, monitor, region, "ntp", func() (protocolOutcome, error) {
+		res, err := checker.PingNTP(ctx, monitor.Uri, time.Duration(monitor.Timeout)*time.Millisecond)
+		if err != nil {
+			return protocolOutcome{}, err
+		}
+		ok, err := evaluateNTPAssertions(monitor, res)
+		if err != nil {
+			return protocolOutcome{}, backoff.Permanent(err)
+		}
+		if !ok {
+			return protocolOutcome{Timing: res.Timing, Message: "Assertions failed: got " + ntpSummary(res), Failed: true}, nil
+		}
+		return protocolOutcome{
+			Timing:  res.Timing,
+			Message: fmt.Sprintf("Successfully queried %s: %s", monitor.Uri, ntpSummary(res)),
+		}, nil
+	})
+}
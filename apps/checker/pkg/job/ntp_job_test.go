@@ -0,0 +1,78 @@
+package job_test
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/checker/pkg/job"
+	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
+)
+
+// ntpStandIn is a stratum 2 server whose clock runs skew ahead.
+func ntpStandIn(t *testing.T, skew time.Duration) string {
+	t.Helper()
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, 48)
+		for {
+			_, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			now := time.Now().Add(skew)
+			ts := uint64(now.Unix()+2208988800)<<32 | (uint64(now.Nanosecond())<<32)/1e9
+			res := make([]byte, 48)
+			res[0] = 4<<3 | 4
+			res[1] = 2
+			copy(res[24:32], buf[40:48])
+			binary.BigEndian.PutUint64(res[32:40], ts)
+			binary.BigEndian.PutUint64(res[40:48], ts)
+			conn.WriteTo(res, addr)
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+func TestNTPJob(t *testing.T) {
+	assertions := func(monitor *v1.NTPMonitor) *v1.NTPMonitor {
+		monitor.Timeout = 1000
+		monitor.Retry = 1
+		monitor.OffsetAssertions = []*v1.OffsetAssertion{
+			{Comparator: v1.NumberComparator_NUMBER_COMPARATOR_LESS_THAN, Target: 100},
+		}
+		monitor.StratumAssertions = []*v1.StratumAssertion{
+			{Comparator: v1.NumberComparator_NUMBER_COMPARATOR_LESS_THAN_OR_EQUAL, Target: 3},
+		}
+		return monitor
+	}
+
+	t.Run("a server in sync succeeds", func(t *testing.T) {
+		monitor := assertions(&v1.NTPMonitor{Uri: ntpStandIn(t, 0)})
+
+		data, err := job.NewJobRunner().NTPJob(context.Background(), monitor, "test-region")
+		require.NoError(t, err)
+		assert.Equal(t, "success", data.RequestStatus)
+		assert.Contains(t, data.Message, "stratum 2")
+	})
+
+	t.Run("a drifting clock fails the offset assertion", func(t *testing.T) {
+		monitor := assertions(&v1.NTPMonitor{Uri: ntpStandIn(t, -time.Second)})
+
+		data, err := job.NewJobRunner().NTPJob(context.Background(), monitor, "test-region")
+		require.NoError(t, err)
+		assert.Equal(t, "error", data.RequestStatus)
+		assert.Contains(t, data.Message, "Assertions failed")
+		// Measured offsets land on either side of -1s, e.g. -999.99ms.
+		assert.Regexp(t, `offset -(999\.\d+ms|1(\.\d+)?s)`, data.Message)
+	})
+}
@@ -79,9 +79,12 @@ func TestHTTPJob_RecordsOTelMetrics(t *testing.T) {
 		OtelConfig: &v1.OtelConfig{Endpoint: otlp.server.URL},
 	}
 
-	data, err := job.NewJobRunner().HTTPJob(context.Background(), monitor, "test-region")
+	runner := job.NewJobRunner()
+	data, err := runner.HTTPJob(context.Background(), monitor, "test-region")
 	require.NoError(t, err)
 	assert.Equal(t, "success", data.RequestStatus)
+	// Metrics are exported periodically, shutting down flushes them now.
+	require.NoError(t, runner.Shutdown(context.Background()))
 	otlp.requireMetric(t, "openstatus.status")
 }
 
@@ -97,9 +100,11 @@ func TestHTTPJob_RecordsErrorOnNon2xx(t *testing.T) {
 		OtelConfig: &v1.OtelConfig{Endpoint: otlp.server.URL},
 	}
 
-	data, err := job.NewJobRunner().HTTPJob(context.Background(), monitor, "test-region")
+	runner := job.NewJobRunner()
+	data, err := runner.HTTPJob(context.Background(), monitor, "test-region")
 	require.NoError(t, err)
 	assert.Equal(t, uint8(1), data.Error)
+	require.NoError(t, runner.Shutdown(context.Background()))
 	otlp.requireMetric(t, "openstatus.error")
 	assert.False(t, otlp.sawMetric("openstatus.status"), "a non-2xx must not record the status counter")
 }
@@ -115,9 +120,11 @@ func TestHTTPJob_RecordsOTelOnFailure(t *testing.T) {
 		OtelConfig: &v1.OtelConfig{Endpoint: otlp.server.URL},
 	}
 
-	data, err := job.NewJobRunner().HTTPJob(context.Background(), monitor, "test-region")
+	runner := job.NewJobRunner()
+	data, err := runner.HTTPJob(context.Background(), monitor, "test-region")
 	require.NoError(t, err)
 	require.NotEmpty(t, data.Message, "Expected error message to be populated for transport failure")
+	require.NoError(t, runner.Shutdown(context.Background()))
 	otlp.requireMetric(t, "openstatus.error")
 }
 
@@ -147,9 +154,11 @@ func TestTCPJob_RecordsOTelMetrics(t *testing.T) {
 		OtelConfig: &v1.OtelConfig{Endpoint: otlp.server.URL},
 	}
 
-	data, err := job.NewJobRunner().TCPJob(context.Background(), monitor, "test-region")
+	runner := job.NewJobRunner()
+	data, err := runner.TCPJob(context.Background(), monitor, "test-region")
 	require.NoError(t, err)
 	assert.Equal(t, "success", data.RequestStatus)
+	require.NoError(t, runner.Shutdown(context.Background()))
 	otlp.requireMetric(t, "openstatus.status")
 }
 
@@ -163,8 +172,10 @@ func TestTCPJob_RecordsOTelOnFailure(t *testing.T) {
 		OtelConfig: &v1.OtelConfig{Endpoint: otlp.server.URL},
 	}
 
-	data, err := job.NewJobRunner().TCPJob(context.Background(), monitor, "test-region")
+	runner := job.NewJobRunner()
+	data, err := runner.TCPJob(context.Background(), monitor, "test-region")
 	require.NoError(t, err)
 	assert.Equal(t, 1, data.Error)
+	require.NoError(t, runner.Shutdown(context.Background()))
 	otlp.requireMetric(t, "openstatus.error")
 }
@@ -0,0 +1,126 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/google/uuid"
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
+)
+
+// protocolOutcome is a completed protocol dialogue; Failed marks one whose
+// assertions did not hold.
+type protocolOutcome struct {
+	Timing  checker.TCPResponseTiming
+	Message string
+	Failed  bool
+}
+
+// protocolCheck runs one attempt of a protocol-aware TCP check. An error
+// means the dialogue could not complete.
+type protocolCheck func() (protocolOutcome, error)
+
+// tcpPipelineMonitor is the part of a monitor the TCP result pipeline needs;
+// the generated getters of every TCP-ingested monitor satisfy it.
+type tcpPipelineMonitor interface {
+	GetUri() string
+	GetRetry() int64
+	GetDegradedAt() int64
+	GetOtelConfig() *v1.OtelConfig
+}
+
+// protocolJob retries check like the plain TCP job and shapes its outcome
+// into the TCP result, timing phases included. The mail, SSH, MQTT and
+// database checks only provide the dialogue.
+func (jr jobRunner) protocolJob(ctx context.Context, monitor tcpPipelineMonitor, region, name string, check protocolCheck) (*TCPPrivateRegionData, error) {
+	retry := monitor.GetRetry()
+	if retry == 0 {
+		retry = 3
+	}
+
+	degradedAfter := monitor.GetDegradedAt()
+	uri := monitor.GetUri()
+	req := otelTCPRequest(uri, monitor.GetOtelConfig())
+
+	var called int
+	var lastResult checker.TCPResponse
+
+	result := func(timing checker.TCPResponseTiming, requestStatus, message string, code checker.ErrorCode) (*TCPPrivateRegionData, error) {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate UUID: %w", err)
+		}
+		now := time.Now().UnixMilli()
+		data := &TCPPrivateRegionData{
+			ID:            id.String(),
+			Timestamp:     now,
+			CronTimestamp: now,
+			URI:           uri,
+			RequestStatus: requestStatus,
+			Message:       message,
+			ErrorCode:     string(code),
+		}
+		if requestStatus == "error" {
+			data.Error = 1
+		}
+		if len(timing.Phases) > 0 {
+			timingAsString, err := json.Marshal(timing)
+			if err != nil {
+				return nil, fmt.Errorf("error while parsing timing data %s: %w", uri, err)
+			}
+			data.Latency = timing.TCPDone - timing.TCPStart
+			data.Timestamp = timing.TCPStart
+			data.CronTimestamp = timing.TCPStart
+			data.Timing = string(timingAsString)
+		}
+		return data, nil
+	}
+
+	op := func() (*TCPPrivateRegionData, error) {
+		called++
+		outcome, err := check()
+		if err != nil {
+			lastResult = checker.TCPResponse{Error: 1, ErrorCode: checker.Classify(err)}
+			var permanent *backoff.PermanentError
+			if errors.As(err, &permanent) || called < int(retry) {
+				return nil, fmt.Errorf("%s check failed: %w", name, err)
+			}
+			return result(checker.TCPResponseTiming{}, "error", err.Error(), lastResult.ErrorCode)
+		}
+
+		latency := outcome.Timing.TCPDone - outcome.Timing.TCPStart
+		lastResult = checker.TCPResponse{Latency: latency, Timing: outcome.Timing}
+
+		if outcome.Failed {
+			lastResult.Error = 1
+			lastResult.ErrorCode = checker.ErrorAssertionFailed
+			if called < int(retry) {
+				return nil, fmt.Errorf("%s check failed: %s", name, outcome.Message)
+			}
+			return result(outcome.Timing, "error", outcome.Message, checker.ErrorAssertionFailed)
+		}
+
+		requestStatus := "success"
+		if degradedAfter > 0 && latency > degradedAfter {
+			requestStatus = "degraded"
+		}
+		return result(outcome.Timing, requestStatus, outcome.Message, "")
+	}
+
+	resp, err := backoff.Retry(ctx, op,
+		backoff.WithMaxTries(uint(retry)),
+		backoff.WithBackOff(backoff.NewExponentialBackOff()),
+	)
+
+	jr.recordTCPOtel(ctx, req, lastResult, region, err != nil || (resp != nil && resp.Error == 1))
+
+	if err != nil {
+		return nil, fmt.Errorf("%s job failed after %d retries: %w", name, retry, err)
+	}
+	return resp, nil
+}
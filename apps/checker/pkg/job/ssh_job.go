@@ -0,0 +1,32 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
+)
+
+// sshJob runs the SSH handshake; a host key that no longer matches the pin
+// fails the check like any other connection error.
+func (jr jobRunner) sshJob(ctx context.Context, monitor *v1.TCPMonitor, region string) (*TCPPrivateRegionData, error) {
+	opts := checker.SSHOptions{
+		HostKeyFingerprint: monitor.GetSsh().GetHostKeyFingerprint(),
+		Username:           monitor.GetSsh().GetUsername(),
+		PrivateKey:         monitor.GetSsh().GetPrivateKey(),
+		Passphrase:         monitor.GetSsh().GetPassphrase(),
+	}
+
+	return jr.protocolJob(ctx, monitor, region, "ssh", func() (protocolOutcome, error) {
+		res, err := checker.PingSSH(ctx, monitor.Uri, opts, time.Duration(monitor.Timeout)*time.Millisecond)
+		if err != nil {
+			return protocolOutcome{}, err
+		}
+		return protocolOutcome{
+			Timing:  res.Timing,
+			Message: fmt.Sprintf("Successfully connected to %s (%s, %s %s)", monitor.Uri, res.Banner, res.HostKeyType, res.HostKeyFingerprint),
+		}, nil
+	})
+}
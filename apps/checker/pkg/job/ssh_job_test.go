@@ -0,0 +1,86 @@
+package job_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/openstatushq/openstatus/apps/checker/pkg/job"
+	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
+)
+
+// sshStandIn completes the key exchange and refuses every login.
+func sshStandIn(t *testing.T) (string, string) {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(priv)
+	require.NoError(t, err)
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(ssh.ConnMetadata, ssh.PublicKey) (*ssh.Permissions, error) {
+			return nil, errors.New("unknown key")
+		},
+	}
+	config.AddHostKey(signer)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				ssh.NewServerConn(conn, config)
+			}()
+		}
+	}()
+
+	return ln.Addr().String(), ssh.FingerprintSHA256(signer.PublicKey())
+}
+
+func TestTCPJob_SSH(t *testing.T) {
+	addr, fingerprint := sshStandIn(t)
+
+	t.Run("a matching host key succeeds", func(t *testing.T) {
+		monitor := &v1.TCPMonitor{
+			Uri:      addr,
+			Protocol: v1.TCPProtocol_TCP_PROTOCOL_SSH,
+			Timeout:  1000,
+			Retry:    1,
+			Ssh:      &v1.SSHOptions{HostKeyFingerprint: fingerprint},
+		}
+
+		data, err := job.NewJobRunner().TCPJob(context.Background(), monitor, "test-region")
+		require.NoError(t, err)
+		assert.Equal(t, "success", data.RequestStatus)
+		assert.Contains(t, data.Message, fingerprint)
+		assert.Contains(t, data.Timing, `"name":"handshake"`)
+	})
+
+	t.Run("a changed host key is reported", func(t *testing.T) {
+		monitor := &v1.TCPMonitor{
+			Uri:      addr,
+			Protocol: v1.TCPProtocol_TCP_PROTOCOL_SSH,
+			Timeout:  1000,
+			Retry:    1,
+			Ssh:      &v1.SSHOptions{HostKeyFingerprint: "SHA256:pinned"},
+		}
+
+		data, err := job.NewJobRunner().TCPJob(context.Background(), monitor, "test-region")
+		require.NoError(t, err)
+		assert.Equal(t, "error", data.RequestStatus)
+		assert.Contains(t, data.Message, "host key mismatch")
+	})
+}
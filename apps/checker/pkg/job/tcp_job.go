@@ -9,7 +9,6 @@ import (
 	"github.com/cenkalti/backoff/v5"
 	"github.com/google/uuid"
 	"github.com/openstatushq/openstatus/apps/checker/checker"
-	"github.com/openstatushq/openstatus/apps/checker/pkg/otel"
 	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
 	"github.com/openstatushq/openstatus/apps/checker/request"
 )
@@ -32,11 +31,21 @@ type TCPPrivateRegionData struct {
 	CronTimestamp int64  `json:"cron_timestamp"`
 	Error         int    `json:"error"`
 	Timing        string `json:"timing"`
+	ErrorCode     string `json:"error_code,omitempty"`
 }
 
 // runAssertions performs all configured assertions for TCP and returns their results
 
-func (jobRunner) TCPJob(ctx context.Context, monitor *v1.TCPMonitor, region string) (*TCPPrivateRegionData, error) {
+func (jr jobRunner) TCPJob(ctx context.Context, monitor *v1.TCPMonitor, region string) (*TCPPrivateRegionData, error) {
+	switch monitor.Protocol {
+	case v1.TCPProtocol_TCP_PROTOCOL_SMTP, v1.TCPProtocol_TCP_PROTOCOL_IMAP, v1.TCPProtocol_TCP_PROTOCOL_POP3:
+		return jr.mailJob(ctx, monitor, region)
+	case v1.TCPProtocol_TCP_PROTOCOL_SSH:
+		return jr.sshJob(ctx, monitor, region)
+	case v1.TCPProtocol_TCP_PROTOCOL_MQTT:
+		return jr.mqttJob(ctx, monitor, region)
+	}
+
 	retry := monitor.Retry
 	if retry == 0 {
 		retry = 3
@@ -65,7 +74,7 @@ func (jobRunner) TCPJob(ctx context.Context, monitor *v1.TCPMonitor, region stri
 				return nil, fmt.Errorf("failed to generate UUID: %w", uuidErr)
 			}
 
-			lastResult = checker.TCPResponse{Error: 1}
+			lastResult = checker.TCPResponse{Error: 1, ErrorCode: checker.Classify(err)}
 
 			// Use current timestamp since connection failed and res.TCPStart would be 0
 			now := time.Now().UnixMilli()
@@ -79,6 +88,7 @@ func (jobRunner) TCPJob(ctx context.Context, monitor *v1.TCPMonitor, region stri
 				RequestStatus: "error",
 				Error:         1,
 				Message:       err.Error(),
+				ErrorCode:     string(lastResult.ErrorCode),
 			}, nil
 		}
 
@@ -120,7 +130,7 @@ func (jobRunner) TCPJob(ctx context.Context, monitor *v1.TCPMonitor, region stri
 		backoff.WithBackOff(backoff.NewExponentialBackOff()),
 	)
 
-	recordTCPOtel(ctx, req, lastResult, region, err != nil)
+	jr.recordTCPOtel(ctx, req, lastResult, region, err != nil)
 
 	if err != nil {
 		return nil, fmt.Errorf("TCP job failed after %d retries: %w", retry, err)
@@ -129,8 +139,14 @@ func (jobRunner) TCPJob(ctx context.Context, monitor *v1.TCPMonitor, region stri
 }
 
 func tcpCheckerRequest(monitor *v1.TCPMonitor) request.TCPCheckerRequest {
-	req := request.TCPCheckerRequest{URI: monitor.Uri}
-	if otelCfg := monitor.GetOtelConfig(); otelCfg.GetEndpoint() != "" {
+	return otelTCPRequest(monitor.Uri, monitor.GetOtelConfig())
+}
+
+// otelTCPRequest builds the request the TCP OTel metrics are labelled with,
+// shared by every job that reports through the TCP pipeline.
+func otelTCPRequest(uri string, otelCfg *v1.OtelConfig) request.TCPCheckerRequest {
+	req := request.TCPCheckerRequest{URI: uri}
+	if otelCfg.GetEndpoint() != "" {
 		req.OtelConfig.Endpoint = otelCfg.GetEndpoint()
 		req.OtelConfig.Headers = headersToMap(otelCfg.GetHeaders())
 	}
@@ -138,7 +154,7 @@ func tcpCheckerRequest(monitor *v1.TCPMonitor) request.TCPCheckerRequest {
 	return req
 }
 
-func recordTCPOtel(ctx context.Context, req request.TCPCheckerRequest, result checker.TCPResponse, region string, failed bool) {
+func (jr jobRunner) recordTCPOtel(ctx context.Context, req request.TCPCheckerRequest, result checker.TCPResponse, region string, failed bool) {
 	if req.OtelConfig.Endpoint == "" {
 		return
 	}
@@ -147,5 +163,6 @@ func recordTCPOtel(ctx context.Context, req request.TCPCheckerRequest, result ch
 		result.Error = 1
 	}
 
-	otel.RecordTCPMetrics(ctx, req, result, region)
+	jr.meters.RecordTCPMetrics(ctx, req, result, region)
+	jr.loggers.RecordTCPResult(ctx, req, result, region)
 }
@@ -45,4 +45,7 @@ func TestTCPJob_Failure(t *testing.T) {
 	if data.Error != 1 {
 		t.Errorf("expected Error 1, got %d", data.Error)
 	}
+	if data.ErrorCode != "connection_refused" {
+		t.Errorf("expected ErrorCode 'connection_refused', got '%s'", data.ErrorCode)
+	}
 }
@@ -0,0 +1,119 @@
+package otel
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// DefaultExportInterval is how often cached providers push their metrics.
+	DefaultExportInterval = 10 * time.Second
+
+	// providerIdleTimeout outlasts the longest monitor periodicity, so the
+	// provider of a monitor checking hourly is not rebuilt between checks.
+	providerIdleTimeout = 2 * time.Hour
+)
+
+type provider interface {
+	Shutdown(ctx context.Context) error
+}
+
+// providerCache keeps one provider per OTLP endpoint and headers. Providers
+// unused for providerIdleTimeout are shut down; shutdown flushes the rest
+// when the process exits.
+type providerCache[P provider] struct {
+	mu      sync.Mutex
+	entries map[string]*cachedProvider[P]
+	build   func(ctx context.Context, endpoint string, headers map[string]string) (P, error)
+}
+
+type cachedProvider[P provider] struct {
+	provider P
+	lastUsed time.Time
+}
+
+func newProviderCache[P provider](build func(ctx context.Context, endpoint string, headers map[string]string) (P, error)) *providerCache[P] {
+	return &providerCache[P]{
+		entries: make(map[string]*cachedProvider[P]),
+		build:   build,
+	}
+}
+
+// providerKey identifies a provider by its endpoint and sorted headers.
+func providerKey(endpoint string, headers map[string]string) string {
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(endpoint)
+	for _, k := range keys {
+		b.WriteString("\n" + k + ": " + headers[k])
+	}
+	return b.String()
+}
+
+func (c *providerCache[P]) get(ctx context.Context, endpoint string, headers map[string]string) (P, error) {
+	key := providerKey(endpoint, headers)
+	now := time.Now()
+
+	c.mu.Lock()
+	var idle []P
+	for k, p := range c.entries {
+		if k != key && now.Sub(p.lastUsed) > providerIdleTimeout {
+			idle = append(idle, p.provider)
+			delete(c.entries, k)
+		}
+	}
+
+	p, ok := c.entries[key]
+	if !ok {
+		provider, err := c.build(ctx, endpoint, headers)
+		if err != nil {
+			c.mu.Unlock()
+			return provider, err
+		}
+		p = &cachedProvider[P]{provider: provider}
+		c.entries[key] = p
+	}
+	p.lastUsed = now
+	c.mu.Unlock()
+
+	if len(idle) > 0 {
+		// The final export must not hold up the check that noticed it.
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			for _, provider := range idle {
+				if err := provider.Shutdown(ctx); err != nil {
+					log.Error().Err(err).Msg("Error shutting down idle otel provider")
+				}
+			}
+		}()
+	}
+
+	return p.provider, nil
+}
+
+func (c *providerCache[P]) shutdown(ctx context.Context) error {
+	c.mu.Lock()
+	entries := c.entries
+	c.entries = make(map[string]*cachedProvider[P])
+	c.mu.Unlock()
+
+	var errs []error
+	for _, p := range entries {
+		if err := p.provider.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,246 @@
+package otel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/request"
+	"github.com/rs/zerolog/log"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	otelLog "go.opentelemetry.io/otel/log"
+	sdkLog "go.opentelemetry.io/otel/sdk/log"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// LoggerProviders keeps one logger provider per OTLP endpoint and headers,
+// exporting every check result as a log record next to its metrics. Providers
+// unused for providerIdleTimeout are shut down; Shutdown flushes the rest
+// when the process exits.
+type LoggerProviders struct {
+	cache *providerCache[*sdkLog.LoggerProvider]
+}
+
+// NewLoggerProviders returns an empty cache of logger providers.
+func NewLoggerProviders() *LoggerProviders {
+	return &LoggerProviders{
+		cache: newProviderCache(newLoggerProvider),
+	}
+}
+
+func newLoggerProvider(ctx context.Context, endpoint string, headers map[string]string) (*sdkLog.LoggerProvider, error) {
+	res, err := newResource()
+	if err != nil {
+		return nil, err
+	}
+
+	exporter, err := otlploghttp.New(ctx,
+		otlploghttp.WithEndpointURL(signalEndpoint(endpoint, "logs")),
+		otlploghttp.WithHeaders(headers),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdkLog.NewLoggerProvider(
+		sdkLog.WithResource(res),
+		sdkLog.WithProcessor(sdkLog.NewBatchProcessor(exporter)),
+	), nil
+}
+
+// Shutdown flushes and closes every cached provider.
+func (l *LoggerProviders) Shutdown(ctx context.Context) error {
+	return l.cache.shutdown(ctx)
+}
+
+// checkResult is what every check type reports as a log record.
+type checkResult struct {
+	timestamp int64
+	failed    bool
+	message   string
+	errorCode checker.ErrorCode
+	// traceID and spanID link the record to the span of the request, if any.
+	traceID, spanID string
+	attrs           []attribute.KeyValue
+}
+
+func (l *LoggerProviders) emit(ctx context.Context, endpoint string, headers map[string]string, result checkResult) {
+	provider, err := l.cache.get(ctx, endpoint, headers)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error setting up otel")
+		return
+	}
+
+	now := time.Now()
+	var record otelLog.Record
+	// Checks that never got a response, like a refused connection, carry no
+	// timestamp of their own.
+	if result.timestamp != 0 {
+		record.SetTimestamp(time.UnixMilli(result.timestamp))
+	} else {
+		record.SetTimestamp(now)
+	}
+	record.SetObservedTimestamp(now)
+	record.SetBody(otelLog.StringValue(result.message))
+	if result.failed {
+		record.SetSeverity(otelLog.SeverityError)
+		record.SetSeverityText("ERROR")
+	} else {
+		record.SetSeverity(otelLog.SeverityInfo)
+		record.SetSeverityText("INFO")
+	}
+
+	attrs := result.attrs
+	if result.failed {
+		code := result.errorCode
+		if code == "" {
+			code = checker.ErrorUnknown
+		}
+		attrs = append(attrs, semconv.ErrorTypeKey.String(string(code)))
+	}
+	for _, kv := range attrs {
+		record.AddAttributes(otelLog.KeyValueFromAttribute(kv))
+	}
+
+	// The SDK reads the trace and span IDs of the record from the context.
+	traceID, traceErr := trace.TraceIDFromHex(result.traceID)
+	spanID, spanErr := trace.SpanIDFromHex(result.spanID)
+	if traceErr == nil && spanErr == nil {
+		ctx = trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		}))
+	}
+
+	provider.Logger("OpenStatus").Emit(ctx, record)
+}
+
+// assertionsAttribute carries the monitor's assertions as their JSON array.
+func assertionsAttribute(raw []json.RawMessage) (attribute.KeyValue, bool) {
+	if len(raw) == 0 {
+		return attribute.KeyValue{}, false
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return attribute.KeyValue{}, false
+	}
+	return attribute.String("openstatus.assertions", string(b)), true
+}
+
+// RecordHTTPResult exports the final attempt of an HTTP check as a log
+// record, with the same attributes as RecordHTTPMetrics plus the response
+// headers and the monitor's assertions.
+func (l *LoggerProviders) RecordHTTPResult(ctx context.Context, req request.HttpCheckerRequest, attempt HTTPAttempt, region string) {
+	res := attempt.Response
+
+	method := req.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	attrs := []attribute.KeyValue{
+		attribute.String("openstatus.probes", region),
+		attribute.String("openstatus.target", req.URL),
+		attribute.String("openstatus.monitor_id", req.MonitorID),
+		attribute.Int64("openstatus.latency", res.Latency),
+		semconv.HTTPRequestMethodKey.String(method),
+		semconv.HTTPResponseStatusCode(res.Status),
+	}
+	for k, v := range res.Headers {
+		attrs = append(attrs, semconv.HTTPResponseHeader(strings.ToLower(k), v))
+	}
+	if kv, ok := assertionsAttribute(req.RawAssertions); ok {
+		attrs = append(attrs, kv)
+	}
+
+	result := checkResult{
+		timestamp: res.Timestamp,
+		message:   fmt.Sprintf("%d %s", res.Status, http.StatusText(res.Status)),
+		traceID:   res.TraceID,
+		spanID:    res.SpanID,
+		attrs:     attrs,
+	}
+	switch {
+	case res.Error != "":
+		result.failed = true
+		result.message = res.Error
+		result.errorCode = res.ErrorCode
+	case attempt.AssertionsFailed:
+		result.failed = true
+		result.message = "Assertions failed"
+		result.errorCode = checker.HTTPErrorCode(res)
+	}
+
+	l.emit(ctx, req.OtelConfig.Endpoint, req.OtelConfig.Headers, result)
+}
+
+// RecordTCPResult exports a TCP check as a log record, with the same
+// attributes as RecordTCPMetrics.
+func (l *LoggerProviders) RecordTCPResult(ctx context.Context, req request.TCPCheckerRequest, res checker.TCPResponse, region string) {
+	attrs := []attribute.KeyValue{
+		attribute.String("openstatus.probes", region),
+		attribute.String("openstatus.target", req.URI),
+		attribute.String("openstatus.monitor_id", req.MonitorID),
+		attribute.Int64("openstatus.latency", res.Latency),
+	}
+	if kv, ok := assertionsAttribute(req.RawAssertions); ok {
+		attrs = append(attrs, kv)
+	}
+
+	result := checkResult{
+		timestamp: res.Timestamp,
+		message:   "Connected to " + req.URI,
+		attrs:     attrs,
+	}
+	if res.Error == 1 {
+		result.failed = true
+		result.message = res.ErrorMessage
+		result.errorCode = res.ErrorCode
+		if result.message == "" {
+			result.message = "Unable to connect to " + req.URI
+		}
+	}
+
+	l.emit(ctx, req.OtelConfig.Endpoint, req.OtelConfig.Headers, result)
+}
+
+// RecordDNSResult exports a DNS check as a log record, with the same
+// attributes as RecordDNSMetrics plus the resolved records. The check failed
+// when errorCode is set.
+func (l *LoggerProviders) RecordDNSResult(ctx context.Context, req request.DNSCheckerRequest, timestamp, latency int64, records map[string][]string, errorCode checker.ErrorCode, message, region string) {
+	attrs := []attribute.KeyValue{
+		attribute.String("openstatus.probes", region),
+		attribute.String("openstatus.target", req.URI),
+		attribute.String("openstatus.monitor_id", req.MonitorID),
+		attribute.Int64("openstatus.latency", latency),
+	}
+	for recordType, values := range records {
+		if len(values) > 0 {
+			attrs = append(attrs, attribute.StringSlice("openstatus.dns.record."+strings.ToLower(recordType), values))
+		}
+	}
+	if kv, ok := assertionsAttribute(req.RawAssertions); ok {
+		attrs = append(attrs, kv)
+	}
+
+	result := checkResult{
+		timestamp: timestamp,
+		message:   "Resolved " + req.URI,
+		errorCode: errorCode,
+		failed:    errorCode != "",
+		attrs:     attrs,
+	}
+	if result.failed && message != "" {
+		result.message = message
+	}
+
+	l.emit(ctx, req.OtelConfig.Endpoint, req.OtelConfig.Headers, result)
+}
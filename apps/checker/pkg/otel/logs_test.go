@@ -0,0 +1,170 @@
+package otel
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	collectorLogs "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	otlpCommon "go.opentelemetry.io/proto/otlp/common/v1"
+	otlpLogs "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type logExport struct {
+	path, auth string
+	records    []*otlpLogs.LogRecord
+}
+
+func newOTLPLogsServer(t *testing.T) (*httptest.Server, chan logExport) {
+	t.Helper()
+	exports := make(chan logExport, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var export collectorLogs.ExportLogsServiceRequest
+		if err := proto.Unmarshal(body, &export); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		got := logExport{path: r.URL.Path, auth: r.Header.Get("Authorization")}
+		for _, rl := range export.ResourceLogs {
+			for _, sl := range rl.ScopeLogs {
+				got.records = append(got.records, sl.LogRecords...)
+			}
+		}
+		exports <- got
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	return server, exports
+}
+
+func logAttributes(record *otlpLogs.LogRecord) map[string]*otlpCommon.AnyValue {
+	attrs := map[string]*otlpCommon.AnyValue{}
+	for _, kv := range record.Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestRecordHTTPResult(t *testing.T) {
+	server, exports := newOTLPLogsServer(t)
+	traceID := "4bf92f3577b34da6a3ce929d0e0e4736"
+	spanID := "00f067aa0ba902b7"
+
+	req := request.HttpCheckerRequest{
+		URL:           "https://example.com",
+		MonitorID:     "mon-1",
+		RawAssertions: []json.RawMessage{json.RawMessage(`{"type":"status","compare":"eq","target":200}`)},
+	}
+	req.OtelConfig.Endpoint = server.URL + "/v1/metrics"
+	req.OtelConfig.Headers = map[string]string{"Authorization": "Bearer token"}
+
+	loggers := NewLoggerProviders()
+	loggers.RecordHTTPResult(context.Background(), req, HTTPAttempt{
+		Response: checker.Response{
+			Status:    500,
+			Latency:   150,
+			Timestamp: 1700000000000,
+			Headers:   map[string]string{"Content-Type": "text/plain"},
+			TraceID:   traceID,
+			SpanID:    spanID,
+		},
+		AssertionsFailed: true,
+	}, "us-east-1")
+	require.NoError(t, loggers.Shutdown(context.Background()))
+
+	got := <-exports
+	assert.Equal(t, "/v1/logs", got.path)
+	assert.Equal(t, "Bearer token", got.auth)
+	require.Len(t, got.records, 1)
+
+	record := got.records[0]
+	assert.Equal(t, "Assertions failed", record.Body.GetStringValue())
+	assert.Equal(t, "ERROR", record.SeverityText)
+	assert.Equal(t, uint64(1700000000000)*1e6, record.TimeUnixNano)
+
+	wantTrace, err := trace.TraceIDFromHex(traceID)
+	require.NoError(t, err)
+	wantSpan, err := trace.SpanIDFromHex(spanID)
+	require.NoError(t, err)
+	assert.Equal(t, wantTrace[:], record.TraceId)
+	assert.Equal(t, wantSpan[:], record.SpanId)
+
+	attrs := logAttributes(record)
+	assert.Equal(t, "us-east-1", attrs["openstatus.probes"].GetStringValue())
+	assert.Equal(t, "https://example.com", attrs["openstatus.target"].GetStringValue())
+	assert.Equal(t, "mon-1", attrs["openstatus.monitor_id"].GetStringValue())
+	assert.Equal(t, int64(500), attrs["http.response.status_code"].GetIntValue())
+	assert.Equal(t, string(checker.ErrorHTTPStatus), attrs["error.type"].GetStringValue())
+	assert.Equal(t, `[{"type":"status","compare":"eq","target":200}]`, attrs["openstatus.assertions"].GetStringValue())
+	header := attrs["http.response.header.content-type"].GetArrayValue()
+	require.NotNil(t, header)
+	require.Len(t, header.Values, 1)
+	assert.Equal(t, "text/plain", header.Values[0].GetStringValue())
+}
+
+func TestRecordTCPResult(t *testing.T) {
+	server, exports := newOTLPLogsServer(t)
+
+	req := request.TCPCheckerRequest{URI: "example.com:443", MonitorID: "mon-2"}
+	req.OtelConfig.Endpoint = server.URL
+
+	loggers := NewLoggerProviders()
+	loggers.RecordTCPResult(context.Background(), req, checker.TCPResponse{Latency: 42, Timestamp: 1700000000000}, "fra")
+	require.NoError(t, loggers.Shutdown(context.Background()))
+
+	got := <-exports
+	require.Len(t, got.records, 1)
+	record := got.records[0]
+	assert.Equal(t, "INFO", record.SeverityText)
+	assert.Equal(t, "Connected to example.com:443", record.Body.GetStringValue())
+	assert.Empty(t, record.TraceId)
+
+	attrs := logAttributes(record)
+	assert.Equal(t, "fra", attrs["openstatus.probes"].GetStringValue())
+	assert.Equal(t, int64(42), attrs["openstatus.latency"].GetIntValue())
+	assert.NotContains(t, attrs, "error.type")
+}
+
+func TestRecordDNSResult(t *testing.T) {
+	server, exports := newOTLPLogsServer(t)
+
+	req := request.DNSCheckerRequest{URI: "example.com"}
+	req.OtelConfig.Endpoint = server.URL
+
+	loggers := NewLoggerProviders()
+	loggers.RecordDNSResult(context.Background(), req, 1700000000000, 12,
+		map[string][]string{"A": {"93.184.216.34"}, "MX": {}},
+		checker.ErrorAssertionFailed, "assertion failed", "ams")
+	require.NoError(t, loggers.Shutdown(context.Background()))
+
+	got := <-exports
+	require.Len(t, got.records, 1)
+	record := got.records[0]
+	assert.Equal(t, "ERROR", record.SeverityText)
+	assert.Equal(t, "assertion failed", record.Body.GetStringValue())
+
+	attrs := logAttributes(record)
+	assert.Equal(t, string(checker.ErrorAssertionFailed), attrs["error.type"].GetStringValue())
+	assert.Equal(t, "93.184.216.34", attrs["openstatus.dns.record.a"].GetArrayValue().GetValues()[0].GetStringValue())
+	assert.NotContains(t, attrs, "openstatus.dns.record.mx")
+}
+
+func TestLoggerProviders_InvalidEndpoint(t *testing.T) {
+	loggers := NewLoggerProviders()
+	req := request.TCPCheckerRequest{URI: "example.com:443"}
+	req.OtelConfig.Endpoint = "://bad"
+
+	// Must not panic; the exporter defers URL validation to export time.
+	loggers.RecordTCPResult(context.Background(), req, checker.TCPResponse{}, "fra")
+	_ = loggers.Shutdown(context.Background())
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/openstatushq/openstatus/apps/checker/request"
 	"github.com/rs/zerolog/log"
 
-	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
 	"go.opentelemetry.io/otel/metric"
@@ -18,22 +17,6 @@ import (
 	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
 )
 
-func setupOTelSDK(ctx context.Context, url string, headers map[string]string) (shutdown func(context.Context) error, err error) {
-	res, err := newResource()
-	if err != nil {
-		return nil, err
-	}
-
-	meterProvider, err := newMeterProvider(ctx, res, url, headers)
-	if err != nil {
-		return nil, err
-	}
-
-	otel.SetMeterProvider(meterProvider)
-
-	return meterProvider.Shutdown, nil
-}
-
 func newResource() (*resource.Resource, error) {
 	return resource.Merge(resource.Default(),
 		resource.NewWithAttributes(semconv.SchemaURL,
@@ -47,6 +30,7 @@ func newMeterProvider(
 	res *resource.Resource,
 	url string,
 	headers map[string]string,
+	interval time.Duration,
 ) (*sdkMetrics.MeterProvider, error) {
 	exporter, err := otlpmetrichttp.New(ctx,
 		otlpmetrichttp.WithEndpointURL(url),
@@ -59,36 +43,75 @@ func newMeterProvider(
 	return sdkMetrics.NewMeterProvider(
 		sdkMetrics.WithResource(res),
 		sdkMetrics.WithReader(sdkMetrics.NewPeriodicReader(exporter,
-			sdkMetrics.WithInterval(3*time.Second))),
+			sdkMetrics.WithInterval(interval))),
 	), nil
 }
 
-// withMeter sets up the OTel SDK, passes a Meter to the callback, then shuts down.
-func withMeter(ctx context.Context, endpoint string, headers map[string]string, fn func(metric.Meter)) {
-	shutdown, err := setupOTelSDK(ctx, endpoint, headers)
+// MeterProviders keeps one meter provider per OTLP endpoint and headers, so
+// checks exporting to the same backend share instruments: counters and
+// histograms stay one continuous stream instead of a new one per check, and
+// checks exporting to different backends never see each other's provider.
+// Providers unused for providerIdleTimeout are shut down; Shutdown flushes
+// the rest when the process exits.
+type MeterProviders struct {
+	cache *providerCache[*sdkMetrics.MeterProvider]
+}
+
+// NewMeterProviders returns an empty cache whose providers export every
+// interval, or every DefaultExportInterval when interval is zero.
+func NewMeterProviders(interval time.Duration) *MeterProviders {
+	if interval <= 0 {
+		interval = DefaultExportInterval
+	}
+	return &MeterProviders{
+		cache: newProviderCache(func(ctx context.Context, endpoint string, headers map[string]string) (*sdkMetrics.MeterProvider, error) {
+			res, err := newResource()
+			if err != nil {
+				return nil, err
+			}
+			return newMeterProvider(ctx, res, endpoint, headers, interval)
+		}),
+	}
+}
+
+func (m *MeterProviders) meter(ctx context.Context, endpoint string, headers map[string]string) (metric.Meter, error) {
+	provider, err := m.cache.get(ctx, endpoint, headers)
+	if err != nil {
+		return nil, err
+	}
+	return provider.Meter("OpenStatus"), nil
+}
+
+// Shutdown flushes and closes every cached provider.
+func (m *MeterProviders) Shutdown(ctx context.Context) error {
+	return m.cache.shutdown(ctx)
+}
+
+// withMeter passes the cached Meter of the endpoint to the callback.
+func (m *MeterProviders) withMeter(ctx context.Context, endpoint string, headers map[string]string, fn func(metric.Meter)) {
+	meter, err := m.meter(ctx, endpoint, headers)
 	if err != nil {
 		log.Ctx(ctx).Error().Err(err).Msg("Error setting up otel")
 		return
 	}
 
-	defer func() {
-		if err := shutdown(ctx); err != nil {
-			log.Ctx(ctx).Error().Err(err).Msg("Error shutting down otel")
-		}
-	}()
-
-	fn(otel.Meter("OpenStatus"))
+	fn(meter)
 }
 
-// recordGauge creates a Float64Gauge and records a value.
-func recordGauge(ctx context.Context, meter metric.Meter, name, description string, value float64, att metric.MeasurementOption) error {
-	gauge, err := meter.Float64Gauge(name,
-		metric.WithDescription(description), metric.WithUnit("ms"))
+// durationBuckets are the histogram boundaries, in milliseconds, of check
+// and phase durations.
+var durationBuckets = []float64{5, 10, 25, 50, 75, 100, 250, 500, 750, 1000, 2500, 5000, 7500, 10000, 30000, 60000}
+
+// recordHistogram records a duration in milliseconds.
+func recordHistogram(ctx context.Context, meter metric.Meter, name, description string, value float64, att metric.MeasurementOption) error {
+	histogram, err := meter.Float64Histogram(name,
+		metric.WithDescription(description), metric.WithUnit("ms"),
+		metric.WithExplicitBucketBoundaries(durationBuckets...))
 	if err != nil {
 		return err
 	}
 
-	gauge.Record(ctx, value, att)
+	histogram.Record(ctx, value, att)
 
 	return nil
 }
@@ -113,16 +136,26 @@ func recordStatusCounter(ctx context.Context, meter metric.Meter, att metric.Mea
 	counter.Add(ctx, 1, att)
 }
 
-func RecordHTTPMetrics(ctx context.Context, req request.HttpCheckerRequest, result checker.Response, region string) {
-	withMeter(ctx, req.OtelConfig.Endpoint, req.OtelConfig.Headers, func(meter metric.Meter) {
-		att := metric.WithAttributes(
+// withErrorType adds the error.type attribute carrying the ErrorCode, or
+// "unknown" when the failure was not classified.
+func withErrorType(attrs []attribute.KeyValue, code checker.ErrorCode) metric.MeasurementOption {
+	if code == "" {
+		code = checker.ErrorUnknown
+	}
+	return metric.WithAttributes(append(attrs, semconv.ErrorTypeKey.String(string(code)))...)
+}
+
+func (m *MeterProviders) RecordHTTPMetrics(ctx context.Context, req request.HttpCheckerRequest, result checker.Response, region string) {
+	m.withMeter(ctx, req.OtelConfig.Endpoint, req.OtelConfig.Headers, func(meter metric.Meter) {
+		attrs := []attribute.KeyValue{
 			attribute.String("openstatus.probes", region),
 			attribute.String("openstatus.target", req.URL),
 			semconv.HTTPResponseStatusCode(result.Status),
-		)
+		}
+		att := metric.WithAttributes(attrs...)
 
 		if result.Error != "" {
-			recordErrorCounter(ctx, meter, att)
+			recordErrorCounter(ctx, meter, withErrorType(attrs, result.ErrorCode))
 			return
 		}
 
@@ -131,53 +164,63 @@ func RecordHTTPMetrics(ctx context.Context, req request.HttpCheckerRequest, resu
 		timings := []struct {
 			name        string
 			description string
-			value       float64
+			start, done int64
 		}{
-			{"openstatus.http.request.duration", "Duration of the check", float64(result.Latency)},
-			{"openstatus.http.dns.duration", "Duration of the DNS lookup", float64(result.Timing.DnsDone - result.Timing.DnsStart)},
-			{"openstatus.http.connection.duration", "Duration of the connection", float64(result.Timing.ConnectDone - result.Timing.ConnectStart)},
-			{"openstatus.http.tls.duration", "Duration of the TLS handshake", float64(result.Timing.TlsHandshakeDone - result.Timing.TlsHandshakeStart)},
-			{"openstatus.http.ttfb.duration", "Duration of the TTFB", float64(result.Timing.FirstByteDone - result.Timing.FirstByteStart)},
-			{"openstatus.http.transfer.duration", "Duration of the transfer", float64(result.Timing.TransferDone - result.Timing.TransferStart)},
+			{"openstatus.http.dns.duration", "Duration of the DNS lookup", result.Timing.DnsStart, result.Timing.DnsDone},
+			{"openstatus.http.connection.duration", "Duration of the connection", result.Timing.ConnectStart, result.Timing.ConnectDone},
+			{"openstatus.http.tls.duration", "Duration of the TLS handshake", result.Timing.TlsHandshakeStart, result.Timing.TlsHandshakeDone},
+			{"openstatus.http.ttfb.duration", "Duration of the TTFB", result.Timing.FirstByteStart, result.Timing.FirstByteDone},
+			{"openstatus.http.transfer.duration", "Duration of the transfer", result.Timing.TransferStart, result.Timing.TransferDone},
 		}
 
+		if err := recordHistogram(ctx, meter, "openstatus.http.request.duration", "Duration of the check", float64(result.Latency), att); err != nil {
+			log.Ctx(ctx).Error().Err(err).Str("metric", "openstatus.http.request.duration").Msg("Error creating histogram")
+		}
 		for _, t := range timings {
-			if err := recordGauge(ctx, meter, t.name, t.description, t.value, att); err != nil {
-				log.Ctx(ctx).Error().Err(err).Str("metric", t.name).Msg("Error creating gauge")
+			// A phase that did not happen, like TLS over plain HTTP, would
+			// only pull the histogram towards zero.
+			if t.start == 0 {
+				continue
+			}
+			if err := recordHistogram(ctx, meter, t.name, t.description, float64(t.done-t.start), att); err != nil {
+				log.Ctx(ctx).Error().Err(err).Str("metric", t.name).Msg("Error creating histogram")
 			}
 		}
 	})
 }
 
-func RecordDNSMetrics(ctx context.Context, req request.DNSCheckerRequest, latency int64, isError bool, region string) {
-	withMeter(ctx, req.OtelConfig.Endpoint, req.OtelConfig.Headers, func(meter metric.Meter) {
-		att := metric.WithAttributes(
+// RecordDNSMetrics records a failed check when errorCode is set.
+func (m *MeterProviders) RecordDNSMetrics(ctx context.Context, req request.DNSCheckerRequest, latency int64, errorCode checker.ErrorCode, region string) {
+	m.withMeter(ctx, req.OtelConfig.Endpoint, req.OtelConfig.Headers, func(meter metric.Meter) {
+		attrs := []attribute.KeyValue{
 			attribute.String("openstatus.probes", region),
 			attribute.String("openstatus.target", req.URI),
-		)
+		}
+		att := metric.WithAttributes(attrs...)
 
-		if isError {
-			recordErrorCounter(ctx, meter, att)
+		if errorCode != "" {
+			recordErrorCounter(ctx, meter, withErrorType(attrs, errorCode))
 			return
 		}
 
 		recordStatusCounter(ctx, meter, att)
 
-		if err := recordGauge(ctx, meter, "openstatus.dns.request.duration", "Duration of the check", float64(latency), att); err != nil {
-			log.Ctx(ctx).Error().Err(err).Str("metric", "openstatus.dns.request.duration").Msg("Error creating gauge")
+		if err := recordHistogram(ctx, meter, "openstatus.dns.request.duration", "Duration of the check", float64(latency), att); err != nil {
+			log.Ctx(ctx).Error().Err(err).Str("metric", "openstatus.dns.request.duration").Msg("Error creating histogram")
 		}
 	})
 }
 
-func RecordTCPMetrics(ctx context.Context, req request.TCPCheckerRequest, result checker.TCPResponse, region string) {
-	withMeter(ctx, req.OtelConfig.Endpoint, req.OtelConfig.Headers, func(meter metric.Meter) {
-		att := metric.WithAttributes(
+func (m *MeterProviders) RecordTCPMetrics(ctx context.Context, req request.TCPCheckerRequest, result checker.TCPResponse, region string) {
+	m.withMeter(ctx, req.OtelConfig.Endpoint, req.OtelConfig.Headers, func(meter metric.Meter) {
+		attrs := []attribute.KeyValue{
 			attribute.String("openstatus.probes", region),
 			attribute.String("openstatus.target", req.URI),
-		)
+		}
+		att := metric.WithAttributes(attrs...)
 
 		if result.Error == 1 {
-			recordErrorCounter(ctx, meter, att)
+			recordErrorCounter(ctx, meter, withErrorType(attrs, result.ErrorCode))
 			return
 		}
 
@@ -193,8 +236,8 @@ func RecordTCPMetrics(ctx context.Context, req request.TCPCheckerRequest, result
 		}
 
 		for _, t := range timings {
-			if err := recordGauge(ctx, meter, t.name, t.description, t.value, att); err != nil {
-				log.Ctx(ctx).Error().Err(err).Str("metric", t.name).Msg("Error creating gauge")
+			if err := recordHistogram(ctx, meter, t.name, t.description, t.value, att); err != nil {
+				log.Ctx(ctx).Error().Err(err).Str("metric", t.name).Msg("Error creating histogram")
 			}
 		}
 	})
@@ -4,7 +4,10 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/openstatushq/openstatus/apps/checker/checker"
 	"github.com/openstatushq/openstatus/apps/checker/request"
@@ -42,15 +45,15 @@ func newOTLPTestServer(t *testing.T) *httptest.Server {
 	return server
 }
 
-// --- recordGauge tests ---
+// --- recordHistogram tests ---
 
-func TestRecordGauge(t *testing.T) {
+func TestRecordHistogram(t *testing.T) {
 	meter, reader := newTestMeter(t)
 	ctx := context.Background()
 	att := metric.WithAttributes(attribute.String("test.key", "test-value"))
 
-	err := recordGauge(ctx, meter, "test.gauge", "A test gauge", 42.5, att)
-	require.NoError(t, err)
+	require.NoError(t, recordHistogram(ctx, meter, "test.duration", "A test duration", 42.5, att))
+	require.NoError(t, recordHistogram(ctx, meter, "test.duration", "A test duration", 180, att))
 
 	rm := collectMetrics(t, reader)
 	require.Len(t, rm.ScopeMetrics, 1)
@@ -59,45 +62,23 @@ func TestRecordGauge(t *testing.T) {
 	require.Len(t, sm.Metrics, 1)
 
 	m := sm.Metrics[0]
-	assert.Equal(t, "test.gauge", m.Name)
-	assert.Equal(t, "A test gauge", m.Description)
+	assert.Equal(t, "test.duration", m.Name)
+	assert.Equal(t, "A test duration", m.Description)
 	assert.Equal(t, "ms", m.Unit)
 
-	gauge, ok := m.Data.(metricdata.Gauge[float64])
-	require.True(t, ok, "expected Gauge[float64] data type")
-	require.Len(t, gauge.DataPoints, 1)
-	assert.Equal(t, 42.5, gauge.DataPoints[0].Value)
+	histogram, ok := m.Data.(metricdata.Histogram[float64])
+	require.True(t, ok, "expected Histogram[float64] data type")
+	require.Len(t, histogram.DataPoints, 1)
+	dp := histogram.DataPoints[0]
+	assert.Equal(t, uint64(2), dp.Count)
+	assert.Equal(t, 222.5, dp.Sum)
+	assert.Equal(t, durationBuckets, dp.Bounds)
 
-	attrs := gauge.DataPoints[0].Attributes
-	val, found := attrs.Value(attribute.Key("test.key"))
+	val, found := dp.Attributes.Value(attribute.Key("test.key"))
 	assert.True(t, found)
 	assert.Equal(t, "test-value", val.AsString())
 }
 
-func TestRecordGauge_MultipleMetrics(t *testing.T) {
-	meter, reader := newTestMeter(t)
-	ctx := context.Background()
-	att := metric.WithAttributes(attribute.String("region", "us-east-1"))
-
-	gauges := []struct {
-		name  string
-		desc  string
-		value float64
-	}{
-		{"http.dns.duration", "DNS duration", 10.0},
-		{"http.tls.duration", "TLS duration", 25.0},
-		{"http.ttfb.duration", "TTFB duration", 50.0},
-	}
-
-	for _, g := range gauges {
-		require.NoError(t, recordGauge(ctx, meter, g.name, g.desc, g.value, att))
-	}
-
-	rm := collectMetrics(t, reader)
-	require.Len(t, rm.ScopeMetrics, 1)
-	assert.Len(t, rm.ScopeMetrics[0].Metrics, 3)
-}
-
 // --- recordErrorCounter tests ---
 
 func TestRecordErrorCounter(t *testing.T) {
@@ -142,55 +123,90 @@ func TestRecordStatusCounter(t *testing.T) {
 	assert.Equal(t, int64(1), sum.DataPoints[0].Value)
 }
 
-// --- setupOTelSDK tests ---
+// --- MeterProviders tests ---
 
-func TestSetupOTelSDK(t *testing.T) {
+func TestMeterProviders_ReusesProviderPerEndpoint(t *testing.T) {
 	server := newOTLPTestServer(t)
 	ctx := context.Background()
+	meters := NewMeterProviders(0)
+	t.Cleanup(func() { _ = meters.Shutdown(ctx) })
 
-	shutdown, err := setupOTelSDK(ctx, server.URL, nil)
+	first, err := meters.meter(ctx, server.URL, map[string]string{"a": "1", "b": "2"})
 	require.NoError(t, err)
-	require.NotNil(t, shutdown)
-	assert.NoError(t, shutdown(ctx))
+	second, err := meters.meter(ctx, server.URL, map[string]string{"b": "2", "a": "1"})
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+
+	otherHeaders, err := meters.meter(ctx, server.URL, map[string]string{"a": "other"})
+	require.NoError(t, err)
+	assert.NotSame(t, first, otherHeaders)
+	assert.Len(t, meters.cache.entries, 2)
 }
 
-func TestSetupOTelSDK_InvalidURL(t *testing.T) {
-	ctx := context.Background()
+func TestMeterProviders_IsolatesConcurrentEndpoints(t *testing.T) {
+	type export struct{ count atomic.Int64 }
+	servers := make([]*httptest.Server, 2)
+	exports := make([]*export, 2)
+	for i := range servers {
+		e := &export{}
+		exports[i] = e
+		servers[i] = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			e.count.Add(1)
+			w.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(servers[i].Close)
+	}
 
-	shutdown, err := setupOTelSDK(ctx, "://invalid", nil)
-	if err != nil {
-		assert.Nil(t, shutdown, "shutdown should be nil when setup fails")
-	} else {
-		require.NotNil(t, shutdown)
-		_ = shutdown(ctx)
+	meters := NewMeterProviders(time.Hour)
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			req := request.HttpCheckerRequest{URL: "https://example.com"}
+			req.OtelConfig.Endpoint = servers[i%2].URL
+			meters.RecordHTTPMetrics(context.Background(), req, checker.Response{Status: 200, Latency: int64(i)}, "us-east-1")
+		}(i)
 	}
+	wg.Wait()
+
+	assert.Len(t, meters.cache.entries, 2)
+	require.NoError(t, meters.Shutdown(context.Background()))
+	assert.Empty(t, meters.cache.entries)
+	// Nothing is exported before the interval; Shutdown flushes each endpoint once.
+	assert.Equal(t, int64(1), exports[0].count.Load())
+	assert.Equal(t, int64(1), exports[1].count.Load())
 }
 
-// --- withMeter tests ---
-
-func TestWithMeter(t *testing.T) {
+func TestMeterProviders_EvictsIdleProviders(t *testing.T) {
 	server := newOTLPTestServer(t)
-	called := false
+	ctx := context.Background()
+	meters := NewMeterProviders(0)
+	t.Cleanup(func() { _ = meters.Shutdown(ctx) })
 
-	withMeter(context.Background(), server.URL, nil, func(meter metric.Meter) {
-		called = true
-		assert.NotNil(t, meter)
-	})
+	_, err := meters.meter(ctx, server.URL, nil)
+	require.NoError(t, err)
+	meters.cache.entries[providerKey(server.URL, nil)].lastUsed = time.Now().Add(-providerIdleTimeout - time.Minute)
 
-	assert.True(t, called, "callback should be called")
+	_, err = meters.meter(ctx, server.URL+"/other", nil)
+	require.NoError(t, err)
+	assert.Len(t, meters.cache.entries, 1)
+	assert.NotContains(t, meters.cache.entries, providerKey(server.URL, nil))
 }
 
-func TestWithMeter_InvalidEndpoint(t *testing.T) {
+func TestMeterProviders_InvalidEndpoint(t *testing.T) {
+	meters := NewMeterProviders(0)
 	called := false
 
 	// Must not panic. The OTLP exporter no longer fails at creation for
 	// invalid URLs (it defers the error to export time), so the callback
 	// will still be invoked.
-	withMeter(context.Background(), "://invalid", nil, func(meter metric.Meter) {
+	meters.withMeter(context.Background(), "://invalid", nil, func(meter metric.Meter) {
 		called = true
 	})
 
 	assert.True(t, called, "callback should be called since exporter defers URL validation")
+	_ = meters.Shutdown(context.Background())
 }
 
 // --- RecordHTTPMetrics tests ---
@@ -222,7 +238,7 @@ func TestRecordHTTPMetrics_Success(t *testing.T) {
 	}
 
 	// Should not panic.
-	RecordHTTPMetrics(context.Background(), req, result, "us-east-1")
+	NewMeterProviders(0).RecordHTTPMetrics(context.Background(), req, result, "us-east-1")
 }
 
 func TestRecordHTTPMetrics_Error(t *testing.T) {
@@ -240,7 +256,7 @@ func TestRecordHTTPMetrics_Error(t *testing.T) {
 	}
 
 	// Should record error counter and not panic.
-	RecordHTTPMetrics(context.Background(), req, result, "us-east-1")
+	NewMeterProviders(0).RecordHTTPMetrics(context.Background(), req, result, "us-east-1")
 }
 
 func TestRecordHTTPMetrics_SetupFailure(t *testing.T) {
@@ -256,7 +272,7 @@ func TestRecordHTTPMetrics_SetupFailure(t *testing.T) {
 	}
 
 	// Must not panic — this was the original nil pointer bug.
-	RecordHTTPMetrics(context.Background(), req, result, "us-east-1")
+	NewMeterProviders(0).RecordHTTPMetrics(context.Background(), req, result, "us-east-1")
 }
 
 // --- RecordTCPMetrics tests ---
@@ -279,7 +295,7 @@ func TestRecordTCPMetrics_Success(t *testing.T) {
 	}
 
 	// Should not panic.
-	RecordTCPMetrics(context.Background(), req, result, "us-east-1")
+	NewMeterProviders(0).RecordTCPMetrics(context.Background(), req, result, "us-east-1")
 }
 
 func TestRecordTCPMetrics_Error(t *testing.T) {
@@ -296,7 +312,7 @@ func TestRecordTCPMetrics_Error(t *testing.T) {
 	}
 
 	// Should record error counter and not panic.
-	RecordTCPMetrics(context.Background(), req, result, "us-east-1")
+	NewMeterProviders(0).RecordTCPMetrics(context.Background(), req, result, "us-east-1")
 }
 
 func TestRecordTCPMetrics_SetupFailure(t *testing.T) {
@@ -311,7 +327,7 @@ func TestRecordTCPMetrics_SetupFailure(t *testing.T) {
 	}
 
 	// Must not panic — same nil pointer guard as HTTP.
-	RecordTCPMetrics(context.Background(), req, result, "us-east-1")
+	NewMeterProviders(0).RecordTCPMetrics(context.Background(), req, result, "us-east-1")
 }
 
 // --- RecordDNSMetrics tests ---
@@ -326,7 +342,7 @@ func TestRecordDNSMetrics_Success(t *testing.T) {
 	req.OtelConfig.Endpoint = server.URL
 
 	// Should not panic.
-	RecordDNSMetrics(context.Background(), req, 30, false, "us-east-1")
+	NewMeterProviders(0).RecordDNSMetrics(context.Background(), req, 30, "", "us-east-1")
 }
 
 func TestRecordDNSMetrics_Error(t *testing.T) {
@@ -339,7 +355,7 @@ func TestRecordDNSMetrics_Error(t *testing.T) {
 	req.OtelConfig.Endpoint = server.URL
 
 	// Should record error counter and not panic.
-	RecordDNSMetrics(context.Background(), req, 0, true, "us-east-1")
+	NewMeterProviders(0).RecordDNSMetrics(context.Background(), req, 0, checker.ErrorDNSNXDomain, "us-east-1")
 }
 
 func TestRecordDNSMetrics_SetupFailure(t *testing.T) {
@@ -350,5 +366,5 @@ func TestRecordDNSMetrics_SetupFailure(t *testing.T) {
 	req.OtelConfig.Endpoint = "://invalid"
 
 	// Must not panic — same nil pointer guard as HTTP.
-	RecordDNSMetrics(context.Background(), req, 30, false, "us-east-1")
+	NewMeterProviders(0).RecordDNSMetrics(context.Background(), req, 30, "", "us-east-1")
 }
@@ -0,0 +1,240 @@
+package otel
+
+import (
+	"context"
+	"crypto/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/request"
+	"github.com/rs/zerolog/log"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdkTrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// spanIDs hands out the span ID queued in next, and random IDs otherwise, so
+// exported spans keep the IDs already sent in traceparent headers.
+type spanIDs struct {
+	traceID trace.TraceID
+	next    trace.SpanID
+}
+
+func (s *spanIDs) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	return s.traceID, s.NewSpanID(ctx, s.traceID)
+}
+
+func (s *spanIDs) NewSpanID(context.Context, trace.TraceID) trace.SpanID {
+	if id := s.next; id.IsValid() {
+		s.next = trace.SpanID{}
+		return id
+	}
+	var id trace.SpanID
+	_, _ = rand.Read(id[:])
+	return id
+}
+
+// signalEndpoint derives the OTLP URL of a signal, like "traces" or "logs",
+// from the metrics endpoint configured on the monitor.
+func signalEndpoint(endpoint, signal string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return endpoint
+	}
+
+	switch {
+	case strings.HasSuffix(u.Path, "/v1/metrics"):
+		u.Path = strings.TrimSuffix(u.Path, "/v1/metrics") + "/v1/" + signal
+	case u.Path == "" || u.Path == "/":
+		u.Path = "/v1/" + signal
+	}
+
+	return u.String()
+}
+
+func withTracer(ctx context.Context, endpoint string, headers map[string]string, ids *spanIDs, fn func(trace.Tracer)) {
+	res, err := newResource()
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error setting up otel")
+		return
+	}
+
+	exporter, err := otlptracehttp.New(ctx,
+		otlptracehttp.WithEndpointURL(signalEndpoint(endpoint, "traces")),
+		otlptracehttp.WithHeaders(headers),
+	)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error setting up otel")
+		return
+	}
+
+	provider := sdkTrace.NewTracerProvider(
+		sdkTrace.WithResource(res),
+		sdkTrace.WithBatcher(exporter),
+		sdkTrace.WithIDGenerator(ids),
+	)
+	defer func() {
+		if err := provider.Shutdown(ctx); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error shutting down otel")
+		}
+	}()
+
+	fn(provider.Tracer("OpenStatus"))
+}
+
+// HTTPAttempt is one request of an HTTP check.
+type HTTPAttempt struct {
+	Response checker.Response
+	// AssertionsFailed is set when a response came back but did not pass the
+	// monitor's assertions.
+	AssertionsFailed bool
+}
+
+// WithCheckTrace returns a context carrying the root span of a check, so the
+// attempts made with it share one trace; see RecordHTTPTrace.
+func WithCheckTrace(ctx context.Context) context.Context {
+	var traceID trace.TraceID
+	var spanID trace.SpanID
+	_, _ = rand.Read(traceID[:])
+	_, _ = rand.Read(spanID[:])
+
+	return trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+}
+
+// RecordHTTPSpan exports the client span of an HTTP check attempt to the
+// monitor's OTLP endpoint. Results that never sent a request carry no trace
+// and are skipped.
+func RecordHTTPSpan(ctx context.Context, req request.HttpCheckerRequest, result checker.Response, region string) {
+	traceID, err := trace.TraceIDFromHex(result.TraceID)
+	if err != nil {
+		return
+	}
+	spanID, err := trace.SpanIDFromHex(result.SpanID)
+	if err != nil {
+		return
+	}
+
+	withTracer(ctx, req.OtelConfig.Endpoint, req.OtelConfig.Headers, &spanIDs{traceID: traceID, next: spanID}, func(tracer trace.Tracer) {
+		recordAttempt(ctx, tracer, req, HTTPAttempt{Response: result}, region, trace.WithNewRoot())
+	})
+}
+
+// RecordHTTPTrace exports a check made with a WithCheckTrace context as one
+// trace: a root span for the check, a client span per attempt, and child
+// spans for the DNS, connect, TLS, time to first byte and transfer phases.
+func RecordHTTPTrace(ctx context.Context, req request.HttpCheckerRequest, attempts []HTTPAttempt, region string) {
+	root := trace.SpanContextFromContext(ctx)
+	if !root.IsValid() || len(attempts) == 0 {
+		return
+	}
+
+	ids := &spanIDs{traceID: root.TraceID(), next: root.SpanID()}
+	withTracer(ctx, req.OtelConfig.Endpoint, req.OtelConfig.Headers, ids, func(tracer trace.Tracer) {
+		first, last := attempts[0].Response, attempts[len(attempts)-1]
+		start := time.UnixMilli(first.Timestamp)
+
+		checkCtx, span := tracer.Start(ctx, "check",
+			trace.WithNewRoot(),
+			trace.WithTimestamp(start),
+			trace.WithAttributes(
+				attribute.String("openstatus.probes", region),
+				attribute.String("openstatus.monitor_id", req.MonitorID),
+				attribute.Int("openstatus.attempts", len(attempts)),
+				semconv.URLFull(req.URL),
+			),
+		)
+
+		for i, attempt := range attempts {
+			spanID, err := trace.SpanIDFromHex(attempt.Response.SpanID)
+			if err != nil {
+				continue
+			}
+			ids.next = spanID
+			recordAttempt(checkCtx, tracer, req, attempt, region, trace.WithAttributes(attribute.Int("openstatus.attempt", i+1)))
+		}
+
+		if last.Response.Error != "" || last.AssertionsFailed {
+			span.SetStatus(codes.Error, "check failed")
+		}
+		span.End(trace.WithTimestamp(attemptEnd(last.Response)))
+	})
+}
+
+func attemptEnd(res checker.Response) time.Time {
+	return time.UnixMilli(res.Timestamp).Add(time.Duration(res.Latency) * time.Millisecond)
+}
+
+func recordAttempt(ctx context.Context, tracer trace.Tracer, req request.HttpCheckerRequest, attempt HTTPAttempt, region string, opts ...trace.SpanStartOption) {
+	res := attempt.Response
+
+	method := req.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	opts = append(opts,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(time.UnixMilli(res.Timestamp)),
+		trace.WithAttributes(
+			attribute.String("openstatus.probes", region),
+			semconv.HTTPRequestMethodKey.String(method),
+			semconv.URLFull(req.URL),
+		),
+	)
+	attemptCtx, span := tracer.Start(ctx, method, opts...)
+
+	phases := []struct {
+		name        string
+		start, done int64
+	}{
+		{"dns", res.Timing.DnsStart, res.Timing.DnsDone},
+		{"connect", res.Timing.ConnectStart, res.Timing.ConnectDone},
+		{"tls", res.Timing.TlsHandshakeStart, res.Timing.TlsHandshakeDone},
+		{"ttfb", res.Timing.FirstByteStart, res.Timing.FirstByteDone},
+		{"transfer", res.Timing.TransferStart, res.Timing.TransferDone},
+	}
+	for _, phase := range phases {
+		// Phases that did not happen, like DNS for an IP or TLS over plain
+		// HTTP, are left out.
+		if phase.start == 0 || phase.done < phase.start {
+			continue
+		}
+		_, child := tracer.Start(attemptCtx, phase.name, trace.WithTimestamp(time.UnixMilli(phase.start)))
+		child.End(trace.WithTimestamp(time.UnixMilli(phase.done)))
+	}
+
+	end := attemptEnd(res)
+	if res.Status != 0 {
+		span.SetAttributes(semconv.HTTPResponseStatusCode(res.Status))
+	}
+	if attempt.AssertionsFailed {
+		span.AddEvent("assertion failed", trace.WithTimestamp(end), trace.WithAttributes(
+			semconv.HTTPResponseStatusCode(res.Status),
+		))
+	}
+	switch {
+	case attempt.AssertionsFailed:
+		span.SetAttributes(semconv.ErrorTypeKey.String(string(checker.HTTPErrorCode(res))))
+		span.SetStatus(codes.Error, "assertions failed")
+	case res.Error != "":
+		code := res.ErrorCode
+		if code == "" {
+			code = checker.ErrorUnknown
+		}
+		span.SetAttributes(semconv.ErrorTypeKey.String(string(code)))
+		span.SetStatus(codes.Error, res.Error)
+	}
+
+	span.End(trace.WithTimestamp(end))
+}
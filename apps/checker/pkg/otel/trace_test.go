@@ -0,0 +1,174 @@
+package otel
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/openstatushq/openstatus/apps/checker/checker"
+	"github.com/openstatushq/openstatus/apps/checker/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	collectorTrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	otlpTrace "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSignalEndpoint(t *testing.T) {
+	tests := map[string]string{
+		"https://otel.example.com":                 "https://otel.example.com/v1/traces",
+		"https://otel.example.com/":                "https://otel.example.com/v1/traces",
+		"https://otel.example.com/v1/metrics":      "https://otel.example.com/v1/traces",
+		"https://otel.example.com/otlp/v1/metrics": "https://otel.example.com/otlp/v1/traces",
+		"https://otel.example.com/custom":          "https://otel.example.com/custom",
+	}
+	for endpoint, want := range tests {
+		assert.Equal(t, want, signalEndpoint(endpoint, "traces"), endpoint)
+	}
+	assert.Equal(t, "https://otel.example.com/v1/logs", signalEndpoint("https://otel.example.com/v1/metrics", "logs"))
+}
+
+func TestRecordHTTPSpan(t *testing.T) {
+	traceID := "4bf92f3577b34da6a3ce929d0e0e4736"
+	spanID := "00f067aa0ba902b7"
+
+	type export struct {
+		path, auth string
+		body       []byte
+	}
+	exports := make(chan export, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		exports <- export{path: r.URL.Path, auth: r.Header.Get("Authorization"), body: body}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	req := request.HttpCheckerRequest{URL: "https://example.com", MonitorID: "mon-1"}
+	req.OtelConfig.Endpoint = server.URL + "/v1/metrics"
+	req.OtelConfig.Headers = map[string]string{"Authorization": "Bearer token"}
+
+	RecordHTTPSpan(context.Background(), req, checker.Response{
+		Status:    500,
+		Latency:   150,
+		Timestamp: 1700000000000,
+		Error:     "Error",
+		ErrorCode: checker.ErrorHTTPStatus,
+		TraceID:   traceID,
+		SpanID:    spanID,
+	}, "us-east-1")
+
+	got := <-exports
+	assert.Equal(t, "/v1/traces", got.path)
+	assert.Equal(t, "Bearer token", got.auth)
+
+	// The exported span carries the IDs that were sent in traceparent.
+	wantTrace, err := trace.TraceIDFromHex(traceID)
+	require.NoError(t, err)
+	wantSpan, err := trace.SpanIDFromHex(spanID)
+	require.NoError(t, err)
+	assert.Contains(t, string(got.body), string(wantTrace[:]))
+	assert.Contains(t, string(got.body), string(wantSpan[:]))
+
+	t.Run("results without a trace are skipped", func(t *testing.T) {
+		RecordHTTPSpan(context.Background(), req, checker.Response{Status: 200}, "us-east-1")
+		select {
+		case <-exports:
+			t.Fatal("expected no export")
+		default:
+		}
+	})
+}
+
+func exportedSpans(t *testing.T, body []byte) map[string]*otlpTrace.Span {
+	t.Helper()
+	var export collectorTrace.ExportTraceServiceRequest
+	require.NoError(t, proto.Unmarshal(body, &export))
+
+	spans := map[string]*otlpTrace.Span{}
+	for _, rs := range export.ResourceSpans {
+		for _, ss := range rs.ScopeSpans {
+			for _, span := range ss.Spans {
+				spans[span.Name] = span
+			}
+		}
+	}
+	return spans
+}
+
+func TestRecordHTTPTrace(t *testing.T) {
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies <- body
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	req := request.HttpCheckerRequest{URL: "https://example.com", Method: http.MethodPost, MonitorID: "mon-1"}
+	req.OtelConfig.Endpoint = server.URL
+	req.OtelConfig.Traces = true
+
+	ctx := WithCheckTrace(context.Background())
+	root := trace.SpanContextFromContext(ctx)
+	require.True(t, root.IsValid())
+
+	attempt := checker.Response{
+		Status:    200,
+		Latency:   150,
+		Timestamp: 1700000000000,
+		TraceID:   root.TraceID().String(),
+		SpanID:    "00f067aa0ba902b7",
+		Timing: checker.Timing{
+			DnsStart:       1700000000000,
+			DnsDone:        1700000000010,
+			ConnectStart:   1700000000010,
+			ConnectDone:    1700000000030,
+			FirstByteStart: 1700000000030,
+			FirstByteDone:  1700000000100,
+			TransferStart:  1700000000100,
+			TransferDone:   1700000000150,
+		},
+	}
+
+	RecordHTTPTrace(ctx, req, []HTTPAttempt{{Response: attempt, AssertionsFailed: true}}, "us-east-1")
+
+	spans := exportedSpans(t, <-bodies)
+	require.Len(t, spans, 6)
+
+	check := spans["check"]
+	rootID := root.SpanID()
+	assert.Equal(t, rootID[:], check.SpanId)
+	assert.Empty(t, check.ParentSpanId)
+
+	post := spans[http.MethodPost]
+	assert.Equal(t, check.SpanId, post.ParentSpanId)
+	wantSpan, err := trace.SpanIDFromHex(attempt.SpanID)
+	require.NoError(t, err)
+	assert.Equal(t, wantSpan[:], post.SpanId)
+	assert.Equal(t, otlpTrace.Span_SPAN_KIND_CLIENT, post.Kind)
+	assert.Equal(t, otlpTrace.Status_STATUS_CODE_ERROR, post.Status.Code)
+	require.Len(t, post.Events, 1)
+	assert.Equal(t, "assertion failed", post.Events[0].Name)
+
+	for _, phase := range []string{"dns", "connect", "ttfb", "transfer"} {
+		span, ok := spans[phase]
+		require.True(t, ok, phase)
+		assert.Equal(t, post.SpanId, span.ParentSpanId, phase)
+		assert.Equal(t, check.TraceId, span.TraceId, phase)
+	}
+	assert.NotContains(t, spans, "tls")
+	assert.Equal(t, uint64(1700000000010*1e6), spans["dns"].EndTimeUnixNano)
+
+	t.Run("a context without a check trace is skipped", func(t *testing.T) {
+		RecordHTTPTrace(context.Background(), req, []HTTPAttempt{{Response: attempt}}, "us-east-1")
+		select {
+		case <-bodies:
+			t.Fatal("expected no export")
+		default:
+		}
+	})
+}
@@ -0,0 +1,137 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// requestStatuses are the values of the status label of
+// openstatus_monitor_last_status, one series per status.
+var requestStatuses = []string{"success", "degraded", "error"}
+
+// Metrics exposes what the probe is doing to Prometheus. A nil *Metrics
+// records nothing.
+type Metrics struct {
+	lastStatus        *prometheus.GaugeVec
+	lastLatency       *prometheus.GaugeVec
+	checkDuration     *prometheus.HistogramVec
+	checkErrors       *prometheus.CounterVec
+	ingestFailures    *prometheus.CounterVec
+	configFetchErrors prometheus.Counter
+	scheduledTasks    prometheus.Gauge
+}
+
+// NewMetrics creates the probe metrics and registers them with reg.
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		lastStatus: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "openstatus_monitor_last_status",
+			Help: "Status of the last check of the monitor: 1 for the current status, 0 for the others.",
+		}, []string{"monitor_id", "type", "status"}),
+		lastLatency: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "openstatus_monitor_last_latency_milliseconds",
+			Help: "Latency of the last check of the monitor.",
+		}, []string{"monitor_id", "type"}),
+		checkDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "openstatus_check_duration_seconds",
+			Help:    "Duration of a check, retries included.",
+			Buckets: []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 120},
+		}, []string{"type"}),
+		checkErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "openstatus_check_errors_total",
+			Help: "Checks that ended with an error instead of a result.",
+		}, []string{"type"}),
+		ingestFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "openstatus_ingest_failures_total",
+			Help: "Check results the ingest server did not accept.",
+		}, []string{"type"}),
+		configFetchErrors: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "openstatus_config_fetch_errors_total",
+			Help: "Failed fetches of the monitors assigned to the probe.",
+		}),
+		scheduledTasks: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "openstatus_scheduled_tasks",
+			Help: "Monitors currently scheduled on the probe.",
+		}),
+	}
+
+	reg.MustRegister(
+		m.lastStatus,
+		m.lastLatency,
+		m.checkDuration,
+		m.checkErrors,
+		m.ingestFailures,
+		m.configFetchErrors,
+		m.scheduledTasks,
+	)
+
+	return m
+}
+
+// checkDone records a check that produced a result.
+func (m *Metrics) checkDone(monitorID, monitorType string, start time.Time, status string, latency int64) {
+	if m == nil {
+		return
+	}
+
+	m.checkDuration.WithLabelValues(monitorType).Observe(time.Since(start).Seconds())
+	m.setStatus(monitorID, monitorType, status)
+	m.lastLatency.WithLabelValues(monitorID, monitorType).Set(float64(latency))
+}
+
+// checkFailed records a check that ended with an error, which counts as an
+// error status for the monitor.
+func (m *Metrics) checkFailed(monitorID, monitorType string, start time.Time) {
+	if m == nil {
+		return
+	}
+
+	m.checkDuration.WithLabelValues(monitorType).Observe(time.Since(start).Seconds())
+	m.checkErrors.WithLabelValues(monitorType).Inc()
+	m.setStatus(monitorID, monitorType, "error")
+}
+
+func (m *Metrics) setStatus(monitorID, monitorType, status string) {
+	for _, s := range requestStatuses {
+		value := 0.0
+		if s == status {
+			value = 1
+		}
+		m.lastStatus.WithLabelValues(monitorID, monitorType, s).Set(value)
+	}
+}
+
+func (m *Metrics) ingestFailed(monitorType string) {
+	if m == nil {
+		return
+	}
+
+	m.ingestFailures.WithLabelValues(monitorType).Inc()
+}
+
+func (m *Metrics) configFetchFailed() {
+	if m == nil {
+		return
+	}
+
+	m.configFetchErrors.Inc()
+}
+
+func (m *Metrics) setScheduledTasks(n int) {
+	if m == nil {
+		return
+	}
+
+	m.scheduledTasks.Set(float64(n))
+}
+
+// forget drops the series of a monitor that is no longer scheduled.
+func (m *Metrics) forget(monitorID string) {
+	if m == nil {
+		return
+	}
+
+	m.lastStatus.DeletePartialMatch(prometheus.Labels{"monitor_id": monitorID})
+	m.lastLatency.DeletePartialMatch(prometheus.Labels{"monitor_id": monitorID})
+}
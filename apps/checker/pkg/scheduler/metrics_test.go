@@ -0,0 +1,133 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/madflojo/tasks"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/scheduler"
+	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMonitorManager_Metrics(t *testing.T) {
+	ctx := t.Context()
+
+	monitors := []*v1.GraphQLMonitor{{Id: "graphql1", Url: "https://openstat.us/graphql", Periodicity: "1h"}}
+	ingestErr := errors.New("ingest unavailable")
+	client := &mockClient{
+		MonitorsFunc: func(ctx context.Context, req *connect.Request[v1.MonitorsRequest]) (*connect.Response[v1.MonitorsResponse], error) {
+			return connect.NewResponse(&v1.MonitorsResponse{GraphqlMonitors: monitors}), nil
+		},
+		IngestHTTPFunc: func(ctx context.Context, req *connect.Request[v1.IngestHTTPRequest]) (*connect.Response[v1.IngestHTTPResponse], error) {
+			return nil, ingestErr
+		},
+	}
+
+	s := tasks.New()
+	defer s.Stop()
+
+	reg := prometheus.NewRegistry()
+	mm := &scheduler.MonitorManager{
+		Client:    client,
+		JobRunner: &mockJobRunner{},
+		Scheduler: s,
+		Metrics:   scheduler.NewMetrics(reg),
+	}
+
+	mm.UpdateMonitors(ctx)
+	task, err := s.Lookup("graphql1")
+	if err != nil {
+		t.Fatalf("expected a task scheduled for graphql1: %v", err)
+	}
+	if err := task.FuncWithTaskContext(tasks.TaskContext{}); !errors.Is(err, ingestErr) {
+		t.Fatalf("expected the ingest error, got %v", err)
+	}
+
+	expected := `
+# HELP openstatus_ingest_failures_total Check results the ingest server did not accept.
+# TYPE openstatus_ingest_failures_total counter
+openstatus_ingest_failures_total{type="graphql"} 1
+# HELP openstatus_monitor_last_status Status of the last check of the monitor: 1 for the current status, 0 for the others.
+# TYPE openstatus_monitor_last_status gauge
+openstatus_monitor_last_status{monitor_id="graphql1",status="degraded",type="graphql"} 0
+openstatus_monitor_last_status{monitor_id="graphql1",status="error",type="graphql"} 0
+openstatus_monitor_last_status{monitor_id="graphql1",status="success",type="graphql"} 1
+# HELP openstatus_scheduled_tasks Monitors currently scheduled on the probe.
+# TYPE openstatus_scheduled_tasks gauge
+openstatus_scheduled_tasks 1
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(expected),
+		"openstatus_ingest_failures_total", "openstatus_monitor_last_status", "openstatus_scheduled_tasks"); err != nil {
+		t.Error(err)
+	}
+	if got := testutil.CollectAndCount(reg, "openstatus_check_duration_seconds"); got != 1 {
+		t.Errorf("expected one check duration histogram, got %d", got)
+	}
+
+	// A removed monitor takes its series with it.
+	monitors = nil
+	mm.UpdateMonitors(ctx)
+	if got := testutil.CollectAndCount(reg, "openstatus_monitor_last_status", "openstatus_monitor_last_latency_milliseconds"); got != 0 {
+		t.Errorf("expected the series of the removed monitor to be dropped, got %d", got)
+	}
+}
+
+func TestMonitorManager_ReadyAndHealthy(t *testing.T) {
+	ctx := t.Context()
+
+	fetchErr := errors.New("platform unavailable")
+	var failing bool
+	client := &mockClient{
+		MonitorsFunc: func(ctx context.Context, req *connect.Request[v1.MonitorsRequest]) (*connect.Response[v1.MonitorsResponse], error) {
+			if failing {
+				return nil, fetchErr
+			}
+			return connect.NewResponse(&v1.MonitorsResponse{}), nil
+		},
+	}
+
+	s := tasks.New()
+	defer s.Stop()
+
+	reg := prometheus.NewRegistry()
+	mm := &scheduler.MonitorManager{Client: client, JobRunner: &mockJobRunner{}, Scheduler: s, Metrics: scheduler.NewMetrics(reg)}
+
+	if err := mm.Ready(); err == nil {
+		t.Error("expected the probe not to be ready before the first fetch")
+	}
+	if err := mm.Healthy(0); err != nil {
+		t.Errorf("expected the probe to be healthy while starting, got %v", err)
+	}
+
+	mm.UpdateMonitors(ctx)
+	if err := mm.Ready(); err != nil {
+		t.Errorf("expected the probe to be ready, got %v", err)
+	}
+
+	failing = true
+	mm.UpdateMonitors(ctx)
+	if err := mm.Ready(); !errors.Is(err, fetchErr) {
+		t.Errorf("expected the fetch error, got %v", err)
+	}
+	if err := mm.Healthy(time.Hour); err != nil {
+		t.Errorf("expected one failed fetch to be tolerated, got %v", err)
+	}
+	if err := mm.Healthy(0); !errors.Is(err, fetchErr) {
+		t.Errorf("expected the probe to be unhealthy past maxAge, got %v", err)
+	}
+
+	expected := `
+# HELP openstatus_config_fetch_errors_total Failed fetches of the monitors assigned to the probe.
+# TYPE openstatus_config_fetch_errors_total counter
+openstatus_config_fetch_errors_total 1
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(expected), "openstatus_config_fetch_errors_total"); err != nil {
+		t.Error(err)
+	}
+}
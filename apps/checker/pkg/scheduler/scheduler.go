@@ -3,12 +3,15 @@ package scheduler
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"sync"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/madflojo/tasks"
+	"github.com/openstatushq/openstatus/apps/checker/checker"
 	"github.com/openstatushq/openstatus/apps/checker/pkg/job"
 	v1 "github.com/openstatushq/openstatus/apps/checker/proto/private_location/v1"
 	"google.golang.org/protobuf/proto"
@@ -28,8 +31,61 @@ type MonitorManager struct {
 	Client    v1.PrivateLocationServiceClient
 	JobRunner job.JobRunner
 	Scheduler *tasks.Scheduler
-	mu        sync.Mutex
-	configs   map[string][]byte
+	// Metrics is optional; nil records nothing.
+	Metrics *Metrics
+	mu      sync.Mutex
+	configs map[string][]byte
+
+	// The outcome of UpdateMonitors, behind mu, for Ready and Healthy.
+	firstUpdate   time.Time
+	lastSuccess   time.Time
+	lastUpdateErr error
+}
+
+var errNoUpdate = errors.New("monitors not fetched yet")
+
+// recordUpdate stores the outcome of an UpdateMonitors run.
+func (mm *MonitorManager) recordUpdate(err error) {
+	mm.mu.Lock()
+	defer mm.mu.Unlock()
+
+	now := time.Now()
+	if mm.firstUpdate.IsZero() {
+		mm.firstUpdate = now
+	}
+	mm.lastUpdateErr = err
+	if err == nil {
+		mm.lastSuccess = now
+	}
+}
+
+// Ready returns the error of the last UpdateMonitors, so the probe is only
+// ready while it checks the monitors currently assigned to it.
+func (mm *MonitorManager) Ready() error {
+	mm.mu.Lock()
+	defer mm.mu.Unlock()
+
+	if mm.firstUpdate.IsZero() {
+		return errNoUpdate
+	}
+	return mm.lastUpdateErr
+}
+
+// Healthy returns an error once UpdateMonitors has failed for longer than
+// maxAge, counted from the last success or, without one, from the first run.
+// Unlike Ready it tolerates the odd failed fetch.
+func (mm *MonitorManager) Healthy(maxAge time.Duration) error {
+	mm.mu.Lock()
+	defer mm.mu.Unlock()
+
+	since := mm.lastSuccess
+	if since.IsZero() {
+		since = mm.firstUpdate
+	}
+	if mm.lastUpdateErr == nil || since.IsZero() || time.Since(since) <= maxAge {
+		return nil
+	}
+	return fmt.Errorf("monitors not fetched for %s: %w", time.Since(since).Round(time.Second), mm.lastUpdateErr)
 }
 
 // shouldSchedule reports whether a task has to be created for the monitor, and
@@ -70,6 +126,8 @@ func (mm *MonitorManager) UpdateMonitors(ctx context.Context) {
 	res, err := mm.Client.Monitors(ctx, &connect.Request[v1.MonitorsRequest]{})
 	if err != nil {
 		log.Printf("Failed to fetch monitors: %v", err)
+		mm.Metrics.configFetchFailed()
+		mm.recordUpdate(err)
 		return
 	}
 
@@ -95,31 +153,19 @@ func (mm *MonitorManager) UpdateMonitors(ctx context.Context) {
 				monitor := m
 				c := context.Background()
 				log.Printf("Starting job for monitor %s (%s)", monitor.Id, monitor.Url)
+				start := time.Now()
 				data, err := mm.JobRunner.HTTPJob(c, monitor, res.Msg.Region)
 
 				if err != nil {
 					log.Printf("Monitor check failed for %s (%s): %v", monitor.Id, monitor.Url, err)
+					mm.Metrics.checkFailed(monitor.Id, "http", start)
 					return err
 				}
-				resp, ingestErr := mm.Client.IngestHTTP(c, &connect.Request[v1.IngestHTTPRequest]{
-					Msg: &v1.IngestHTTPRequest{
-						MonitorId:     monitor.Id,
-						Id:            data.ID,
-						Url:           monitor.Url,
-						Message:       data.Message,
-						Latency:       data.Latency,
-						Timing:        data.Timing,
-						Headers:       data.Headers,
-						Body:          data.Body,
-						RequestStatus: data.RequestStatus,
-						StatusCode:    int64(data.StatusCode),
-						Error:         int64(data.Error),
-						CronTimestamp: data.CronTimestamp,
-						Timestamp:     data.Timestamp,
-					},
-				})
+				mm.Metrics.checkDone(monitor.Id, "http", start, data.RequestStatus, data.Latency)
+				resp, ingestErr := mm.ingestHTTP(c, monitor.Id, monitor.Url, data)
 				if ingestErr != nil {
 					log.Printf("Failed to ingest HTTP result for %s (%s): %v", monitor.Id, monitor.Url, ingestErr)
+					mm.Metrics.ingestFailed("http")
 					return ingestErr
 				}
 				log.Printf("Monitor check for %s (%s) ingested with status %q (code %d), ingest response: %v", monitor.Id, monitor.Url, data.RequestStatus, data.StatusCode, resp)
@@ -134,6 +180,19 @@ func (mm *MonitorManager) UpdateMonitors(ctx context.Context) {
 		log.Printf("Started monitoring job for %s (%s)", m.Id, m.Url)
 	}
 
+	// GraphQL monitors: results share the HTTP ingest path
+	scheduleMonitors(mm, currentIDs, res.Msg.GraphqlMonitors, monitorJob[*v1.GraphQLMonitor, *job.HttpPrivateRegionData]{
+		kind:   "graphql",
+		target: (*v1.GraphQLMonitor).GetUrl,
+		run: func(ctx context.Context, m *v1.GraphQLMonitor) (*job.HttpPrivateRegionData, error) {
+			return mm.JobRunner.GraphQLJob(ctx, m, res.Msg.Region)
+		},
+		outcome: httpOutcome,
+		ingest: func(ctx context.Context, m *v1.GraphQLMonitor, data *job.HttpPrivateRegionData) (any, error) {
+			return mm.ingestHTTP(ctx, m.Id, m.Url, data)
+		},
+	})
+
 	// TCP monitors: start jobs for new monitors
 	for _, m := range res.Msg.TcpMonitors {
 		currentIDs[m.Id] = struct{}{}
@@ -155,26 +214,18 @@ func (mm *MonitorManager) UpdateMonitors(ctx context.Context) {
 				monitor := m
 				c := context.Background()
 				log.Printf("Starting TCP job for monitor %s (%s)", monitor.Id, monitor.Uri)
+				start := time.Now()
 				data, err := mm.JobRunner.TCPJob(c, monitor, res.Msg.Region)
 				if err != nil {
 					log.Printf("TCP monitor check failed for %s (%s): %v", monitor.Id, monitor.Uri, err)
+					mm.Metrics.checkFailed(monitor.Id, "tcp", start)
 					return err
 				}
-				resp, ingestErr := mm.Client.IngestTCP(c, &connect.Request[v1.IngestTCPRequest]{
-					Msg: &v1.IngestTCPRequest{
-						MonitorId:     monitor.Id,
-						Id:            data.ID,
-						Uri:           monitor.Uri,
-						Message:       data.Message,
-						Latency:       data.Latency,
-						RequestStatus: data.RequestStatus,
-						Error:         int64(data.Error),
-						CronTimestamp: data.CronTimestamp,
-						Timestamp:     data.Timestamp,
-					},
-				})
+				mm.Metrics.checkDone(monitor.Id, "tcp", start, data.RequestStatus, data.Latency)
+				resp, ingestErr := mm.ingestTCP(c, monitor.Id, monitor.Uri, data)
 				if ingestErr != nil {
 					log.Printf("Failed to ingest TCP result for %s (%s): %v", monitor.Id, monitor.Uri, ingestErr)
+					mm.Metrics.ingestFailed("tcp")
 					return ingestErr
 				}
 				log.Printf("TCP monitor check for %s (%s) ingested with status %q, ingest response: %v", monitor.Id, monitor.Uri, data.RequestStatus, resp)
@@ -190,6 +241,48 @@ func (mm *MonitorManager) UpdateMonitors(ctx context.Context) {
 		log.Printf("Started TCP monitoring job for %s (%s)", m.Id, m.Uri)
 	}
 
+	// Database monitors: results share the TCP ingest path. The monitor URI
+	// carries credentials, so only the redacted URI is logged or sent.
+	scheduleMonitors(mm, currentIDs, res.Msg.DatabaseMonitors, monitorJob[*v1.DatabaseMonitor, *job.TCPPrivateRegionData]{
+		kind: "database",
+		target: func(m *v1.DatabaseMonitor) string {
+			return checker.RedactURI(m.GetUri())
+		},
+		run: func(ctx context.Context, m *v1.DatabaseMonitor) (*job.TCPPrivateRegionData, error) {
+			return mm.JobRunner.DatabaseJob(ctx, m, res.Msg.Region)
+		},
+		outcome: tcpOutcome,
+		ingest: func(ctx context.Context, m *v1.DatabaseMonitor, data *job.TCPPrivateRegionData) (any, error) {
+			return mm.ingestTCP(ctx, m.Id, data.URI, data)
+		},
+	})
+
+	// NTP monitors: results share the TCP ingest path.
+	scheduleMonitors(mm, currentIDs, res.Msg.NtpMonitors, monitorJob[*v1.NTPMonitor, *job.TCPPrivateRegionData]{
+		kind:   "ntp",
+		target: (*v1.NTPMonitor).GetUri,
+		run: func(ctx context.Context, m *v1.NTPMonitor) (*job.TCPPrivateRegionData, error) {
+			return mm.JobRunner.NTPJob(ctx, m, res.Msg.Region)
+		},
+		outcome: tcpOutcome,
+		ingest: func(ctx context.Context, m *v1.NTPMonitor, data *job.TCPPrivateRegionData) (any, error) {
+			return mm.ingestTCP(ctx, m.Id, m.Uri, data)
+		},
+	})
+
+	// Domain monitors: results share the TCP ingest path.
+	scheduleMonitors(mm, currentIDs, res.Msg.DomainMonitors, monitorJob[*v1.DomainMonitor, *job.TCPPrivateRegionData]{
+		kind:   "domain",
+		target: (*v1.DomainMonitor).GetUri,
+		run: func(ctx context.Context, m *v1.DomainMonitor) (*job.TCPPrivateRegionData, error) {
+			return mm.JobRunner.DomainJob(ctx, m, res.Msg.Region)
+		},
+		outcome: tcpOutcome,
+		ingest: func(ctx context.Context, m *v1.DomainMonitor, data *job.TCPPrivateRegionData) (any, error) {
+			return mm.ingestTCP(ctx, m.Id, m.Uri, data)
+		},
+	})
+
 	for _, m := range res.Msg.DnsMonitors {
 		currentIDs[m.Id] = struct{}{}
 		if !mm.shouldSchedule(m.Id, m) {
@@ -207,11 +300,14 @@ func (mm *MonitorManager) UpdateMonitors(ctx context.Context) {
 				monitor := m
 				c := context.Background()
 				log.Printf("Starting DNS job for monitor %s (%s)", monitor.Id, monitor.Uri)
+				start := time.Now()
 				data, err := mm.JobRunner.DNSJob(c, monitor)
 				if err != nil {
 					log.Printf("DNS monitor check failed for %s (%s): %v", monitor.Id, monitor.Uri, err)
+					mm.Metrics.checkFailed(monitor.Id, "dns", start)
 					return err
 				}
+				mm.Metrics.checkDone(monitor.Id, "dns", start, data.RequestStatus, data.Latency)
 				resp, ingestErr := mm.Client.IngestDNS(c, &connect.Request[v1.IngestDNSRequest]{
 					Msg: &v1.IngestDNSRequest{
 						MonitorId:     monitor.Id,
@@ -224,10 +320,12 @@ func (mm *MonitorManager) UpdateMonitors(ctx context.Context) {
 						CronTimestamp: data.CronTimestamp,
 						Timestamp:     data.Timestamp,
 						Records:       toProtoRecords(data.Records),
+						ErrorCode:     data.ErrorCode,
 					},
 				})
 				if ingestErr != nil {
 					log.Printf("Failed to ingest DNS result for %s (%s): %v", monitor.Id, monitor.Uri, ingestErr)
+					mm.Metrics.ingestFailed("dns")
 					return ingestErr
 				}
 				log.Printf("DNS monitor check for %s (%s) ingested with status %q, ingest response: %v", monitor.Id, monitor.Uri, data.RequestStatus, resp)
@@ -248,10 +346,88 @@ func (mm *MonitorManager) UpdateMonitors(ctx context.Context) {
 		if _, stillExists := currentIDs[id]; !stillExists {
 			mm.Scheduler.Del(id)
 			delete(mm.configs, id)
+			mm.Metrics.forget(id)
 		}
 	}
+	mm.Metrics.setScheduledTasks(len(mm.Scheduler.Tasks()))
 	mm.mu.Unlock()
 
+	mm.recordUpdate(nil)
+}
+
+// scheduledMonitor is what the scheduler needs from a monitor message.
+type scheduledMonitor interface {
+	proto.Message
+	GetId() string
+	GetPeriodicity() string
+}
+
+// monitorJob describes how one type of monitor is checked and its result
+// ingested.
+type monitorJob[M scheduledMonitor, D any] struct {
+	// kind names the monitor type in logs and metrics.
+	kind string
+	// target is logged with the monitor, so it must not carry credentials.
+	target func(M) string
+	run    func(context.Context, M) (D, error)
+	// outcome returns the request status and latency of a result.
+	outcome func(D) (string, int64)
+	ingest  func(context.Context, M, D) (any, error)
+}
+
+func httpOutcome(data *job.HttpPrivateRegionData) (string, int64) {
+	return data.RequestStatus, data.Latency
+}
+
+func tcpOutcome(data *job.TCPPrivateRegionData) (string, int64) {
+	return data.RequestStatus, data.Latency
+}
+
+// scheduleMonitors adds the monitors to currentIDs and starts a task for
+// each one that is new or whose config changed.
+func scheduleMonitors[M scheduledMonitor, D any](mm *MonitorManager, currentIDs map[string]struct{}, monitors []M, j monitorJob[M, D]) {
+	for _, m := range monitors {
+		id, target := m.GetId(), j.target(m)
+		currentIDs[id] = struct{}{}
+		if !mm.shouldSchedule(id, m) {
+			continue
+		}
+
+		task := tasks.Task{
+			Interval:          time.Duration(intervalToSecond(m.GetPeriodicity())) * time.Second,
+			RunSingleInstance: true,
+			ErrFunc: func(e error) {
+				log.Printf("An error occurred when executing %s task  %s", j.kind, e)
+			},
+			FuncWithTaskContext: func(tasks.TaskContext) error {
+				c := context.Background()
+				log.Printf("Starting %s job for monitor %s (%s)", j.kind, id, target)
+				start := time.Now()
+				data, err := j.run(c, m)
+				if err != nil {
+					log.Printf("%s monitor check failed for %s (%s): %v", j.kind, id, target, err)
+					mm.Metrics.checkFailed(id, j.kind, start)
+					return err
+				}
+				status, latency := j.outcome(data)
+				mm.Metrics.checkDone(id, j.kind, start, status, latency)
+				resp, err := j.ingest(c, m, data)
+				if err != nil {
+					log.Printf("Failed to ingest %s result for %s (%s): %v", j.kind, id, target, err)
+					mm.Metrics.ingestFailed(j.kind)
+					return err
+				}
+				log.Printf("%s monitor check for %s (%s) ingested with status %q, ingest response: %v", j.kind, id, target, status, resp)
+				return nil
+			},
+		}
+
+		if err := mm.Scheduler.AddWithID(id, &task); err != nil {
+			log.Printf("Failed to add %s monitor job for %s (%s): %v", j.kind, id, target, err)
+			continue
+		}
+		log.Printf("Started %s monitoring job for %s (%s)", j.kind, id, target)
+	}
 }
 
 func toProtoRecords(records map[string][]string) map[string]*v1.Records {
@@ -287,3 +463,44 @@ func intervalToSecond(interval string) int {
 		return 0
 	}
 }
+
+func (mm *MonitorManager) ingestHTTP(ctx context.Context, monitorID, url string, data *job.HttpPrivateRegionData) (*connect.Response[v1.IngestHTTPResponse], error) {
+	return mm.Client.IngestHTTP(ctx, &connect.Request[v1.IngestHTTPRequest]{
+		Msg: &v1.IngestHTTPRequest{
+			MonitorId:     monitorID,
+			Id:            data.ID,
+			Url:           url,
+			Message:       data.Message,
+			Latency:       data.Latency,
+			Timing:        data.Timing,
+			Headers:       data.Headers,
+			Body:          data.Body,
+			RequestStatus: data.RequestStatus,
+			StatusCode:    int64(data.StatusCode),
+			Error:         int64(data.Error),
+			CronTimestamp: data.CronTimestamp,
+			Timestamp:     data.Timestamp,
+			Diagnosis:     data.Diagnosis,
+			ErrorCode:     data.ErrorCode,
+			TraceId:       data.TraceID,
+		},
+	})
+}
+
+func (mm *MonitorManager) ingestTCP(ctx context.Context, monitorID, uri string, data *job.TCPPrivateRegionData) (*connect.Response[v1.IngestTCPResponse], error) {
+	return mm.Client.IngestTCP(ctx, &connect.Request[v1.IngestTCPRequest]{
+		Msg: &v1.IngestTCPRequest{
+			MonitorId:     monitorID,
+			Id:            data.ID,
+			Uri:           uri,
+			Message:       data.Message,
+			Latency:       data.Latency,
+			Timing:        data.Timing,
+			RequestStatus: data.RequestStatus,
+			Error:         int64(data.Error),
+			CronTimestamp: data.CronTimestamp,
+			Timestamp:     data.Timestamp,
+			ErrorCode:     data.ErrorCode,
+		},
+	})
+}
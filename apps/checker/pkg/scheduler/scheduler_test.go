@@ -20,6 +20,7 @@ type mockJobRunner struct {
 	HTTPJobCalled atomic.Bool
 	TCPJobCalled  atomic.Bool
 	DNSJobCalled  atomic.Bool
+	GraphQLCalled atomic.Bool
 	mu            sync.Mutex
 	httpRegion    string
 	tcpRegion     string
@@ -35,6 +36,27 @@ func (m *mockJobRunner) HTTPJob(ctx context.Context, monitor *v1.HTTPMonitor, re
 	return &job.HttpPrivateRegionData{}, nil
 }
 
+func (m *mockJobRunner) GraphQLJob(ctx context.Context, monitor *v1.GraphQLMonitor, region string) (*job.HttpPrivateRegionData, error) {
+	m.GraphQLCalled.Store(true)
+	return &job.HttpPrivateRegionData{ID: "graphql-result-1", RequestStatus: "success", StatusCode: 200}, nil
+}
+
+func (m *mockJobRunner) DatabaseJob(ctx context.Context, monitor *v1.DatabaseMonitor, region string) (*job.TCPPrivateRegionData, error) {
+	return &job.TCPPrivateRegionData{ID: "database-result-1", URI: "postgres://monitor:xxxxx@db:5432/app", RequestStatus: "success", Timing: `{"tcpStart":1,"tcpDone":2}`}, nil
+}
+
+func (m *mockJobRunner) NTPJob(ctx context.Context, monitor *v1.NTPMonitor, region string) (*job.TCPPrivateRegionData, error) {
+	return &job.TCPPrivateRegionData{ID: "ntp-result-1", URI: monitor.Uri, RequestStatus: "success", Message: "stratum 2", Timing: `{"tcpStart":1,"tcpDone":2}`}, nil
+}
+
+func (m *mockJobRunner) DomainJob(ctx context.Context, monitor *v1.DomainMonitor, region string) (*job.TCPPrivateRegionData, error) {
+	return &job.TCPPrivateRegionData{ID: "domain-result-1", URI: monitor.Uri, RequestStatus: "success", Message: "openstatus.dev expires 2027-01-02 (75 days)", Timing: `{"tcpStart":1,"tcpDone":2}`}, nil
+}
+
+func (m *mockJobRunner) Shutdown(ctx context.Context) error {
+	return nil
+}
+
 func (m *mockJobRunner) HTTPMonitor() *v1.HTTPMonitor {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -310,3 +332,178 @@ func TestMonitorManager_IngestsDNSResult(t *testing.T) {
 		t.Errorf("expected the A records to be forwarded, got %v", got)
 	}
 }
+
+func TestMonitorManager_IngestsGraphQLResultAsHTTP(t *testing.T) {
+	ctx := t.Context()
+
+	graphqlMonitor := &v1.GraphQLMonitor{Id: "gql1", Url: "https://api.openstatus.dev/graphql", Periodicity: "1h"}
+
+	var ingested *v1.IngestHTTPRequest
+	client := &mockClient{
+		MonitorsFunc: func(ctx context.Context, req *connect.Request[v1.MonitorsRequest]) (*connect.Response[v1.MonitorsResponse], error) {
+			return connect.NewResponse(&v1.MonitorsResponse{
+				GraphqlMonitors: []*v1.GraphQLMonitor{graphqlMonitor},
+				Region:          "frankfurt-dc1",
+			}), nil
+		},
+		IngestHTTPFunc: func(ctx context.Context, req *connect.Request[v1.IngestHTTPRequest]) (*connect.Response[v1.IngestHTTPResponse], error) {
+			ingested = req.Msg
+			return connect.NewResponse(&v1.IngestHTTPResponse{}), nil
+		},
+	}
+	jobRunner := &mockJobRunner{}
+
+	s := tasks.New()
+	defer s.Stop()
+
+	mm := &scheduler.MonitorManager{Client: client, JobRunner: jobRunner, Scheduler: s}
+
+	mm.UpdateMonitors(ctx)
+	runScheduledTask(t, mm.Scheduler, "gql1")
+
+	if !jobRunner.GraphQLCalled.Load() {
+		t.Fatalf("expected GraphQLJob to be called")
+	}
+	if ingested == nil {
+		t.Fatalf("expected IngestHTTP to be called")
+	}
+	if ingested.MonitorId != "gql1" || ingested.Id != "graphql-result-1" {
+		t.Errorf("expected the GraphQL result to be forwarded, got %v", ingested)
+	}
+	if ingested.Url != graphqlMonitor.Url {
+		t.Errorf("expected url %q, got %q", graphqlMonitor.Url, ingested.Url)
+	}
+}
+
+func TestMonitorManager_IngestsDatabaseResultWithoutCredentials(t *testing.T) {
+	ctx := t.Context()
+
+	databaseMonitor := &v1.DatabaseMonitor{
+		Id:          "db1",
+		Engine:      v1.DatabaseEngine_DATABASE_ENGINE_POSTGRES,
+		Uri:         "postgres://monitor:secret@db:5432/app",
+		Periodicity: "1h",
+	}
+
+	var ingested *v1.IngestTCPRequest
+	client := &mockClient{
+		MonitorsFunc: func(ctx context.Context, req *connect.Request[v1.MonitorsRequest]) (*connect.Response[v1.MonitorsResponse], error) {
+			return connect.NewResponse(&v1.MonitorsResponse{
+				DatabaseMonitors: []*v1.DatabaseMonitor{databaseMonitor},
+				Region:           "frankfurt-dc1",
+			}), nil
+		},
+		IngestTCPFunc: func(ctx context.Context, req *connect.Request[v1.IngestTCPRequest]) (*connect.Response[v1.IngestTCPResponse], error) {
+			ingested = req.Msg
+			return connect.NewResponse(&v1.IngestTCPResponse{}), nil
+		},
+	}
+
+	s := tasks.New()
+	defer s.Stop()
+
+	mm := &scheduler.MonitorManager{Client: client, JobRunner: &mockJobRunner{}, Scheduler: s}
+
+	mm.UpdateMonitors(ctx)
+	runScheduledTask(t, mm.Scheduler, "db1")
+
+	if ingested == nil {
+		t.Fatalf("expected IngestTCP to be called")
+	}
+	if ingested.Uri != "postgres://monitor:xxxxx@db:5432/app" {
+		t.Errorf("expected the redacted uri to be ingested, got %q", ingested.Uri)
+	}
+	if ingested.Timing == "" {
+		t.Errorf("expected the phase timing to be forwarded")
+	}
+}
+
+func TestMonitorManager_IngestsNTPResultAsTCP(t *testing.T) {
+	ctx := t.Context()
+
+	ntpMonitor := &v1.NTPMonitor{
+		Id:          "ntp1",
+		Uri:         "ntp.internal:123",
+		Periodicity: "1h",
+	}
+
+	var ingested *v1.IngestTCPRequest
+	client := &mockClient{
+		MonitorsFunc: func(ctx context.Context, req *connect.Request[v1.MonitorsRequest]) (*connect.Response[v1.MonitorsResponse], error) {
+			return connect.NewResponse(&v1.MonitorsResponse{
+				NtpMonitors: []*v1.NTPMonitor{ntpMonitor},
+				Region:      "frankfurt-dc1",
+			}), nil
+		},
+		IngestTCPFunc: func(ctx context.Context, req *connect.Request[v1.IngestTCPRequest]) (*connect.Response[v1.IngestTCPResponse], error) {
+			ingested = req.Msg
+			return connect.NewResponse(&v1.IngestTCPResponse{}), nil
+		},
+	}
+
+	s := tasks.New()
+	defer s.Stop()
+
+	mm := &scheduler.MonitorManager{Client: client, JobRunner: &mockJobRunner{}, Scheduler: s}
+
+	mm.UpdateMonitors(ctx)
+	runScheduledTask(t, mm.Scheduler, "ntp1")
+
+	if ingested == nil {
+		t.Fatalf("expected IngestTCP to be called")
+	}
+	if ingested.Uri != "ntp.internal:123" || ingested.MonitorId != "ntp1" {
+		t.Errorf("unexpected ingest request %v", ingested)
+	}
+	if ingested.Message != "stratum 2" {
+		t.Errorf("expected the NTP summary to be forwarded, got %q", ingested.Message)
+	}
+}
+
+func TestMonitorManager_SchedulesDomainMonitor(t *testing.T) {
+	ctx := t.Context()
+
+	domainMonitor := &v1.DomainMonitor{
+		Id:          "domain1",
+		Uri:         "openstatus.dev",
+		Periodicity: "1h",
+	}
+
+	var ingested *v1.IngestTCPRequest
+	client := &mockClient{
+		MonitorsFunc: func(ctx context.Context, req *connect.Request[v1.MonitorsRequest]) (*connect.Response[v1.MonitorsResponse], error) {
+			return connect.NewResponse(&v1.MonitorsResponse{
+				DomainMonitors: []*v1.DomainMonitor{domainMonitor},
+				Region:         "frankfurt-dc1",
+			}), nil
+		},
+		IngestTCPFunc: func(ctx context.Context, req *connect.Request[v1.IngestTCPRequest]) (*connect.Response[v1.IngestTCPResponse], error) {
+			ingested = req.Msg
+			return connect.NewResponse(&v1.IngestTCPResponse{}), nil
+		},
+	}
+
+	s := tasks.New()
+	defer s.Stop()
+
+	mm := &scheduler.MonitorManager{Client: client, JobRunner: &mockJobRunner{}, Scheduler: s}
+
+	mm.UpdateMonitors(ctx)
+
+	task, err := mm.Scheduler.Lookup("domain1")
+	if err != nil {
+		t.Fatalf("expected the domain monitor to be scheduled: %v", err)
+	}
+	if task.Interval != time.Hour {
+		t.Errorf("expected an hourly interval, got %s", task.Interval)
+	}
+
+	runScheduledTask(t, mm.Scheduler, "domain1")
+
+	if ingested == nil {
+		t.Fatalf("expected IngestTCP to be called")
+	}
+	if ingested.Uri != "openstatus.dev" || ingested.MonitorId != "domain1" {
+		t.Errorf("unexpected ingest request %v", ingested)
+	}
+}
@@ -0,0 +1,84 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ClickHouseConfig locates the ClickHouse HTTP interface.
+type ClickHouseConfig struct {
+	// URL of the HTTP interface, like "http://clickhouse:8123".
+	URL      string
+	Database string
+	User     string
+	Password string
+}
+
+// ClickHouse inserts every result as a JSONEachRow row, so the JSON keys of
+// the result are the column names. Keys without a column are skipped.
+type ClickHouse struct {
+	httpClient *http.Client
+	config     ClickHouseConfig
+	tables     Tables
+}
+
+func NewClickHouse(httpClient *http.Client, config ClickHouseConfig, tables Tables) *ClickHouse {
+	if config.Database == "" {
+		config.Database = "default"
+	}
+	return &ClickHouse{
+		httpClient: httpClient,
+		config:     config,
+		tables:     tables,
+	}
+}
+
+// clickHouseIdentifier quotes a ClickHouse identifier.
+func clickHouseIdentifier(name string) string {
+	return "`" + strings.NewReplacer(`\`, `\\`, "`", "\\`").Replace(name) + "`"
+}
+
+func (c *ClickHouse) SendEvent(ctx context.Context, event any, dataSourceName string) error {
+	row, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("unable to encode payload: %w", err)
+	}
+
+	requestURL, err := url.Parse(c.config.URL)
+	if err != nil {
+		return fmt.Errorf("unable to parse url: %w", err)
+	}
+	q := requestURL.Query()
+	q.Set("query", fmt.Sprintf("INSERT INTO %s.%s FORMAT JSONEachRow",
+		clickHouseIdentifier(c.config.Database), clickHouseIdentifier(c.tables.Table(dataSourceName))))
+	q.Set("input_format_skip_unknown_fields", "1")
+	requestURL.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL.String(), bytes.NewReader(row))
+	if err != nil {
+		return fmt.Errorf("unable to create request: %w", err)
+	}
+	if c.config.User != "" {
+		req.Header.Set("X-ClickHouse-User", c.config.User)
+		req.Header.Set("X-ClickHouse-Key", c.config.Password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package sink_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/openstatushq/openstatus/apps/checker/pkg/sink"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClickHouse_SendEvent(t *testing.T) {
+	var query, user, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("query")
+		user = r.Header.Get("X-ClickHouse-User")
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+	}))
+	defer server.Close()
+
+	s := sink.NewClickHouse(server.Client(), sink.ClickHouseConfig{
+		URL:      server.URL,
+		Database: "openstatus",
+		User:     "checker",
+		Password: "secret",
+	}, sink.Tables{"ping_response__v8": "http_results"})
+
+	err := s.SendEvent(context.Background(), map[string]any{"monitorId": "1", "latency": 42}, "ping_response__v8")
+	require.NoError(t, err)
+	require.Equal(t, "INSERT INTO `openstatus`.`http_results` FORMAT JSONEachRow", query)
+	require.Equal(t, "checker", user)
+	require.JSONEq(t, `{"monitorId":"1","latency":42}`, body)
+}
+
+func TestClickHouse_SendEventError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Table default.tcp_response__v0 does not exist", http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	s := sink.NewClickHouse(server.Client(), sink.ClickHouseConfig{URL: server.URL}, nil)
+
+	err := s.SendEvent(context.Background(), map[string]any{}, "tcp_response__v0")
+	require.ErrorContains(t, err, "does not exist")
+}
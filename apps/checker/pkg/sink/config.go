@@ -0,0 +1,91 @@
+package sink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/openstatushq/openstatus/apps/checker/pkg/tinybird"
+)
+
+// FromEnv builds the sinks listed in RESULT_SINKS, a comma separated list of
+// tinybird, clickhouse, postgres and file. It defaults to tinybird. With
+// several sinks every result is written to each of them.
+//
+// RESULT_SINK_TABLES maps datasources to other table names, see ParseTables.
+// tinybirdOpts tunes the tinybird sink.
+func FromEnv(ctx context.Context, httpClient *http.Client, tinybirdToken string, tinybirdOpts tinybird.BatchOptions) (Sink, error) {
+	tables, err := ParseTables(os.Getenv("RESULT_SINK_TABLES"))
+	if err != nil {
+		return nil, err
+	}
+
+	names := os.Getenv("RESULT_SINKS")
+	if names == "" {
+		names = "tinybird"
+	}
+
+	var sinks Fanout
+	for _, name := range strings.Split(names, ",") {
+		s, err := fromEnv(ctx, strings.TrimSpace(name), httpClient, tinybirdToken, tinybirdOpts, tables)
+		if err != nil {
+			return nil, errors.Join(err, sinks.Close(ctx))
+		}
+		sinks = append(sinks, s)
+	}
+
+	if len(sinks) == 1 {
+		return sinks[0], nil
+	}
+	return sinks, nil
+}
+
+func fromEnv(ctx context.Context, name string, httpClient *http.Client, tinybirdToken string, tinybirdOpts tinybird.BatchOptions, tables Tables) (Sink, error) {
+	switch name {
+	case "tinybird":
+		return tinybird.NewBatchClient(httpClient, tinybirdToken, tinybirdOpts), nil
+	case "clickhouse":
+		config := ClickHouseConfig{
+			URL:      os.Getenv("CLICKHOUSE_URL"),
+			Database: os.Getenv("CLICKHOUSE_DATABASE"),
+			User:     os.Getenv("CLICKHOUSE_USER"),
+			Password: os.Getenv("CLICKHOUSE_PASSWORD"),
+		}
+		if config.URL == "" {
+			return nil, errors.New("CLICKHOUSE_URL is required for the clickhouse sink")
+		}
+		return NewClickHouse(httpClient, config, tables), nil
+	case "postgres":
+		connString := os.Getenv("POSTGRES_URL")
+		if connString == "" {
+			return nil, errors.New("POSTGRES_URL is required for the postgres sink")
+		}
+		return NewPostgres(ctx, connString, tables)
+	case "file":
+		config := FileConfig{Dir: os.Getenv("RESULT_FILE_DIR")}
+		if config.Dir == "" {
+			return nil, errors.New("RESULT_FILE_DIR is required for the file sink")
+		}
+		if v := os.Getenv("RESULT_FILE_MAX_BYTES"); v != "" {
+			maxBytes, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid RESULT_FILE_MAX_BYTES: %w", err)
+			}
+			config.MaxBytes = maxBytes
+		}
+		if v := os.Getenv("RESULT_FILE_MAX_FILES"); v != "" {
+			maxFiles, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid RESULT_FILE_MAX_FILES: %w", err)
+			}
+			config.MaxFiles = maxFiles
+		}
+		return NewFile(config, tables)
+	default:
+		return nil, fmt.Errorf("unknown result sink %q", name)
+	}
+}
@@ -0,0 +1,26 @@
+package sink
+
+import (
+	"context"
+	"errors"
+)
+
+// Fanout sends every result to all its sinks. A sink that fails does not
+// keep the result from the others.
+type Fanout []Sink
+
+func (f Fanout) SendEvent(ctx context.Context, event any, dataSourceName string) error {
+	var errs []error
+	for _, s := range f {
+		errs = append(errs, s.SendEvent(ctx, event, dataSourceName))
+	}
+	return errors.Join(errs...)
+}
+
+func (f Fanout) Close(ctx context.Context) error {
+	var errs []error
+	for _, s := range f {
+		errs = append(errs, Close(ctx, s))
+	}
+	return errors.Join(errs...)
+}
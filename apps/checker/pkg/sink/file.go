@@ -0,0 +1,149 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// FileConfig sets where NDJSON files are written and when they rotate.
+type FileConfig struct {
+	Dir string
+	// MaxBytes is the size a file rotates at. Defaults to 100 MiB.
+	MaxBytes int64
+	// MaxFiles is how many rotated files are kept per table. Defaults to 10.
+	MaxFiles int
+}
+
+// File appends every result as a JSON line to <table>.ndjson. A file about
+// to grow past MaxBytes is renamed to <table>-<timestamp>.ndjson first, and
+// the oldest rotated files beyond MaxFiles are removed.
+type File struct {
+	config FileConfig
+	tables Tables
+
+	mu    sync.Mutex
+	files map[string]*ndjsonFile
+}
+
+type ndjsonFile struct {
+	file *os.File
+	size int64
+}
+
+func NewFile(config FileConfig, tables Tables) (*File, error) {
+	if config.MaxBytes <= 0 {
+		config.MaxBytes = 100 << 20
+	}
+	if config.MaxFiles <= 0 {
+		config.MaxFiles = 10
+	}
+	if err := os.MkdirAll(config.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("unable to create result directory: %w", err)
+	}
+
+	return &File{
+		config: config,
+		tables: tables,
+		files:  make(map[string]*ndjsonFile),
+	}, nil
+}
+
+func (f *File) SendEvent(_ context.Context, event any, dataSourceName string) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("unable to encode payload: %w", err)
+	}
+	line = append(line, '\n')
+
+	table := f.tables.Table(dataSourceName)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := f.open(table)
+	if err != nil {
+		return err
+	}
+	if file.size > 0 && file.size+int64(len(line)) > f.config.MaxBytes {
+		if file, err = f.rotate(table); err != nil {
+			return err
+		}
+	}
+
+	n, err := file.file.Write(line)
+	file.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("unable to write result: %w", err)
+	}
+
+	return nil
+}
+
+func (f *File) path(table string) string {
+	return filepath.Join(f.config.Dir, table+".ndjson")
+}
+
+func (f *File) open(table string) (*ndjsonFile, error) {
+	if file, ok := f.files[table]; ok {
+		return file, nil
+	}
+
+	file, err := os.OpenFile(f.path(table), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open result file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("unable to open result file: %w", err)
+	}
+
+	f.files[table] = &ndjsonFile{file: file, size: info.Size()}
+	return f.files[table], nil
+}
+
+func (f *File) rotate(table string) (*ndjsonFile, error) {
+	if err := f.files[table].file.Close(); err != nil {
+		return nil, fmt.Errorf("unable to close result file: %w", err)
+	}
+	delete(f.files, table)
+
+	rotated := filepath.Join(f.config.Dir, fmt.Sprintf("%s-%s.ndjson", table, time.Now().UTC().Format("20060102T150405.000000000")))
+	if err := os.Rename(f.path(table), rotated); err != nil {
+		return nil, fmt.Errorf("unable to rotate result file: %w", err)
+	}
+
+	// The timestamp sorts rotated files from oldest to newest.
+	old, err := filepath.Glob(filepath.Join(f.config.Dir, table+"-*.ndjson"))
+	if err != nil {
+		return nil, fmt.Errorf("unable to list rotated result files: %w", err)
+	}
+	sort.Strings(old)
+	for len(old) > f.config.MaxFiles {
+		if err := os.Remove(old[0]); err != nil {
+			return nil, fmt.Errorf("unable to remove rotated result file: %w", err)
+		}
+		old = old[1:]
+	}
+
+	return f.open(table)
+}
+
+func (f *File) Close(context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var errs []error
+	for table, file := range f.files {
+		errs = append(errs, file.file.Close())
+		delete(f.files, table)
+	}
+	return errors.Join(errs...)
+}
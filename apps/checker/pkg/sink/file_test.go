@@ -0,0 +1,52 @@
+package sink_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/openstatushq/openstatus/apps/checker/pkg/sink"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile_SendEvent(t *testing.T) {
+	dir := t.TempDir()
+	s, err := sink.NewFile(sink.FileConfig{Dir: dir}, sink.Tables{"ping_response__v8": "http"})
+	require.NoError(t, err)
+
+	for _, id := range []string{"1", "2"} {
+		require.NoError(t, s.SendEvent(context.Background(), map[string]string{"monitorId": id}, "ping_response__v8"))
+	}
+	require.NoError(t, s.Close(context.Background()))
+
+	content, err := os.ReadFile(filepath.Join(dir, "http.ndjson"))
+	require.NoError(t, err)
+	require.Equal(t, "{\"monitorId\":\"1\"}\n{\"monitorId\":\"2\"}\n", string(content))
+}
+
+func TestFile_Rotation(t *testing.T) {
+	dir := t.TempDir()
+	// Every line is 18 bytes, so each file holds two results.
+	s, err := sink.NewFile(sink.FileConfig{Dir: dir, MaxBytes: 40, MaxFiles: 2}, nil)
+	require.NoError(t, err)
+	defer s.Close(context.Background())
+
+	for _, id := range []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"} {
+		require.NoError(t, s.SendEvent(context.Background(), map[string]string{"monitorId": id}, "tcp_response__v0"))
+	}
+
+	rotated, err := filepath.Glob(filepath.Join(dir, "tcp_response__v0-*.ndjson"))
+	require.NoError(t, err)
+	require.Len(t, rotated, 2)
+
+	newest, err := os.ReadFile(rotated[1])
+	require.NoError(t, err)
+	require.Equal(t, 2, strings.Count(string(newest), "\n"))
+	require.Contains(t, string(newest), `"8"`)
+
+	current, err := os.ReadFile(filepath.Join(dir, "tcp_response__v0.ndjson"))
+	require.NoError(t, err)
+	require.Equal(t, "{\"monitorId\":\"9\"}\n", string(current))
+}
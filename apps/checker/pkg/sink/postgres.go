@@ -0,0 +1,52 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Postgres inserts every result with jsonb_populate_record, so the JSON keys
+// of the result are the column names, like "monitorId", and keys without a
+// column are skipped. Tables may be schema-qualified: "results.http".
+type Postgres struct {
+	pool   *pgxpool.Pool
+	tables Tables
+}
+
+func NewPostgres(ctx context.Context, connString string, tables Tables) (*Postgres, error) {
+	pool, err := pgxpool.New(ctx, connString)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to postgres: %w", err)
+	}
+
+	return &Postgres{pool: pool, tables: tables}, nil
+}
+
+// insertStatement inserts the JSON object passed as $1 into the table.
+func insertStatement(table string) string {
+	name := pgx.Identifier(strings.Split(table, ".")).Sanitize()
+	return fmt.Sprintf("INSERT INTO %[1]s SELECT * FROM jsonb_populate_record(NULL::%[1]s, $1::jsonb)", name)
+}
+
+func (p *Postgres) SendEvent(ctx context.Context, event any, dataSourceName string) error {
+	row, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("unable to encode payload: %w", err)
+	}
+
+	if _, err := p.pool.Exec(ctx, insertStatement(p.tables.Table(dataSourceName)), string(row)); err != nil {
+		return fmt.Errorf("unable to insert into postgres: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Postgres) Close(context.Context) error {
+	p.pool.Close()
+	return nil
+}
@@ -0,0 +1,29 @@
+package sink
+
+import "testing"
+
+func TestInsertStatement(t *testing.T) {
+	tests := []struct {
+		table string
+		want  string
+	}{
+		{
+			table: "ping_response__v8",
+			want:  `INSERT INTO "ping_response__v8" SELECT * FROM jsonb_populate_record(NULL::"ping_response__v8", $1::jsonb)`,
+		},
+		{
+			table: "results.http",
+			want:  `INSERT INTO "results"."http" SELECT * FROM jsonb_populate_record(NULL::"results"."http", $1::jsonb)`,
+		},
+		{
+			table: `bad"table`,
+			want:  `INSERT INTO "bad""table" SELECT * FROM jsonb_populate_record(NULL::"bad""table", $1::jsonb)`,
+		},
+	}
+
+	for _, tt := range tests {
+		if got := insertStatement(tt.table); got != tt.want {
+			t.Errorf("insertStatement(%q) = %s, want %s", tt.table, got, tt.want)
+		}
+	}
+}
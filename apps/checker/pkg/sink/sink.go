@@ -0,0 +1,63 @@
+// Package sink stores check results. Tinybird is the default; self-hosters
+// can write to ClickHouse, PostgreSQL or NDJSON files instead, or to several
+// at once.
+package sink
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Sink stores a check result. event is the result row and dataSourceName
+// the Tinybird datasource it belongs to, like "ping_response__v8"; other
+// sinks write it to the table the datasource maps to.
+//
+// tinybird.Client is a Sink.
+type Sink interface {
+	SendEvent(ctx context.Context, event any, dataSourceName string) error
+}
+
+// Closer is implemented by sinks that buffer results or hold connections.
+type Closer interface {
+	Close(ctx context.Context) error
+}
+
+// Close flushes and closes the sink when it is a Closer.
+func Close(ctx context.Context, s Sink) error {
+	if c, ok := s.(Closer); ok {
+		return c.Close(ctx)
+	}
+	return nil
+}
+
+// Tables maps datasource names to table names. Datasources without an entry
+// keep their name as table name.
+type Tables map[string]string
+
+// Table returns the table the results of the datasource are written to.
+func (t Tables) Table(dataSourceName string) string {
+	if table, ok := t[dataSourceName]; ok {
+		return table
+	}
+	return dataSourceName
+}
+
+// ParseTables reads a mapping written as
+// "ping_response__v8=http_results,tcp_response__v0=tcp_results".
+func ParseTables(s string) (Tables, error) {
+	tables := Tables{}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		dataSource, table, ok := strings.Cut(pair, "=")
+		dataSource, table = strings.TrimSpace(dataSource), strings.TrimSpace(table)
+		if !ok || dataSource == "" || table == "" {
+			return nil, fmt.Errorf("invalid table mapping %q, expected datasource=table", pair)
+		}
+		tables[dataSource] = table
+	}
+	return tables, nil
+}
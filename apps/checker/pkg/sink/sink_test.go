@@ -0,0 +1,86 @@
+package sink_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/openstatushq/openstatus/apps/checker/pkg/sink"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/tinybird"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingSink struct {
+	err    error
+	events []string
+	closed bool
+}
+
+func (s *recordingSink) SendEvent(_ context.Context, _ any, dataSourceName string) error {
+	s.events = append(s.events, dataSourceName)
+	return s.err
+}
+
+func (s *recordingSink) Close(context.Context) error {
+	s.closed = true
+	return nil
+}
+
+func TestParseTables(t *testing.T) {
+	tables, err := sink.ParseTables("ping_response__v8=http_results, tcp_response__v0 = tcp_results,")
+	require.NoError(t, err)
+	require.Equal(t, "http_results", tables.Table("ping_response__v8"))
+	require.Equal(t, "tcp_results", tables.Table("tcp_response__v0"))
+	require.Equal(t, "dns_response__v0", tables.Table("dns_response__v0"))
+
+	_, err = sink.ParseTables("ping_response__v8")
+	require.Error(t, err)
+}
+
+func TestFanout(t *testing.T) {
+	failing := &recordingSink{err: errors.New("boom")}
+	ok := &recordingSink{}
+	fanout := sink.Fanout{failing, ok}
+
+	err := fanout.SendEvent(context.Background(), map[string]any{}, "ping_response__v8")
+	require.ErrorContains(t, err, "boom")
+	require.Equal(t, []string{"ping_response__v8"}, ok.events)
+
+	require.NoError(t, sink.Close(context.Background(), fanout))
+	require.True(t, failing.closed)
+	require.True(t, ok.closed)
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Run("defaults to tinybird", func(t *testing.T) {
+		t.Setenv("RESULT_SINKS", "")
+		s, err := sink.FromEnv(context.Background(), http.DefaultClient, "token", tinybird.BatchOptions{})
+		require.NoError(t, err)
+		require.IsType(t, &tinybird.BatchClient{}, s)
+		require.NoError(t, sink.Close(context.Background(), s))
+	})
+
+	t.Run("several sinks fan out", func(t *testing.T) {
+		t.Setenv("RESULT_SINKS", "clickhouse,file")
+		t.Setenv("CLICKHOUSE_URL", "http://localhost:8123")
+		t.Setenv("RESULT_FILE_DIR", t.TempDir())
+		s, err := sink.FromEnv(context.Background(), http.DefaultClient, "", tinybird.BatchOptions{})
+		require.NoError(t, err)
+		require.Len(t, s, 2)
+		require.NoError(t, sink.Close(context.Background(), s))
+	})
+
+	t.Run("unknown sink", func(t *testing.T) {
+		t.Setenv("RESULT_SINKS", "tinybird,kafka")
+		_, err := sink.FromEnv(context.Background(), http.DefaultClient, "token", tinybird.BatchOptions{})
+		require.ErrorContains(t, err, `unknown result sink "kafka"`)
+	})
+
+	t.Run("missing configuration", func(t *testing.T) {
+		t.Setenv("RESULT_SINKS", "clickhouse")
+		t.Setenv("CLICKHOUSE_URL", "")
+		_, err := sink.FromEnv(context.Background(), http.DefaultClient, "", tinybird.BatchOptions{})
+		require.ErrorContains(t, err, "CLICKHOUSE_URL")
+	})
+}
@@ -0,0 +1,169 @@
+// Package statuspolicy decides when check results change the status of a
+// monitor, so that a single failed check does not page anyone.
+package statuspolicy
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	StatusActive   = "active"
+	StatusDegraded = "degraded"
+	StatusError    = "error"
+)
+
+// Policy holds the per-monitor settings. The zero Policy changes the status
+// on every check that differs from it, without flap detection.
+type Policy struct {
+	// FailureThreshold is the number of consecutive failed checks before
+	// the monitor is in error.
+	FailureThreshold int
+	// RecoveryThreshold is the number of consecutive successful checks
+	// before a monitor in error recovers.
+	RecoveryThreshold int
+	// FlapThreshold marks the monitor flapping when its status changes more
+	// than that many times within FlapWindow. 0 disables flap detection.
+	FlapThreshold int
+	FlapWindow    time.Duration
+}
+
+// State is what the policy remembers about a monitor between checks.
+type State struct {
+	// Status is the status decided by the policy, empty until the first
+	// decision.
+	Status string
+	// Reported is the status last reported to workflows.
+	Reported string
+	// Failures and Successes count the consecutive failed and successful
+	// checks.
+	Failures  int
+	Successes int
+	// FlapCount counts the status changes since FlapWindowStart.
+	FlapCount       int
+	FlapWindowStart time.Time
+	// Flapping monitors do not report status changes until a FlapWindow
+	// passed; then their status is reported if it changed meanwhile.
+	Flapping bool
+}
+
+// Next applies the status observed by a check, active, degraded or error, and
+// returns the new state and whether its Status has to be reported.
+func (p Policy) Next(s State, observed string, now time.Time) (State, bool) {
+	if observed == StatusError {
+		s.Failures++
+		s.Successes = 0
+	} else {
+		s.Successes++
+		s.Failures = 0
+	}
+
+	next := s.Status
+	switch {
+	case observed == StatusError:
+		if s.Failures >= max(p.FailureThreshold, 1) {
+			next = StatusError
+		}
+	case s.Status == StatusError:
+		if s.Successes >= max(p.RecoveryThreshold, 1) {
+			next = observed
+		}
+	default:
+		// Moving between active and degraded is not held back.
+		next = observed
+	}
+
+	if s.Flapping && now.Sub(s.FlapWindowStart) >= p.FlapWindow {
+		s.Flapping = false
+		s.FlapCount = 0
+		s.FlapWindowStart = now
+	}
+
+	if next != s.Status {
+		if s.Status != "" && p.FlapThreshold > 0 {
+			if now.Sub(s.FlapWindowStart) >= p.FlapWindow {
+				s.FlapCount = 0
+				s.FlapWindowStart = now
+			}
+			s.FlapCount++
+			if s.FlapCount > p.FlapThreshold {
+				s.Flapping = true
+			}
+		}
+		s.Status = next
+	}
+
+	if s.Flapping || s.Status == s.Reported {
+		return s, false
+	}
+	s.Reported = s.Status
+	return s, true
+}
+
+// Tracker keeps the state of monitors in memory, for checkers that are only
+// told the status a monitor currently has.
+//
+// The state is per process: it is lost on restart and not shared between
+// checker instances, so a monitor whose checks land on different instances,
+// or a restarted one, counts its consecutive results again from zero. Flap
+// detection is limited the same way. Private locations persist the state in
+// private_location_status instead.
+type Tracker struct {
+	mu        sync.Mutex
+	states    map[string]trackedState
+	lastSweep time.Time
+}
+
+type trackedState struct {
+	State
+	seen time.Time
+}
+
+// trackerIdleTimeout forgets monitors that were not checked for that long.
+const trackerIdleTimeout = 24 * time.Hour
+
+func NewTracker() *Tracker {
+	return &Tracker{states: make(map[string]trackedState)}
+}
+
+// Observe applies the observed status to the monitor identified by key.
+// current is the status the monitor has according to the caller; it is
+// trusted over what the tracker remembers. When it differs from the status
+// last reported, the monitor was changed elsewhere, or the report was lost:
+// the status and the consecutive checks start over from current. It returns
+// the decided status and whether it has to be reported.
+//
+// A nil Tracker remembers nothing: every check starts from current.
+func (t *Tracker) Observe(key string, p Policy, current, observed string, now time.Time) (string, bool) {
+	if t == nil {
+		s, report := p.Next(State{Status: current, Reported: current}, observed, now)
+		return s.Status, report
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if now.Sub(t.lastSweep) > time.Hour {
+		for k, s := range t.states {
+			if now.Sub(s.seen) > trackerIdleTimeout {
+				delete(t.states, k)
+			}
+		}
+		t.lastSweep = now
+	}
+
+	tracked, ok := t.states[key]
+	if !ok {
+		tracked.Status = current
+	}
+	if current != "" && current != tracked.Reported {
+		tracked.Status = current
+		tracked.Reported = current
+		tracked.Failures = 0
+		tracked.Successes = 0
+	}
+
+	s, report := p.Next(tracked.State, observed, now)
+	t.states[key] = trackedState{State: s, seen: now}
+	return s.Status, report
+}
@@ -0,0 +1,155 @@
+package statuspolicy_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openstatushq/openstatus/apps/checker/pkg/statuspolicy"
+)
+
+type step struct {
+	observed string
+	status   string
+	report   bool
+}
+
+func run(t *testing.T, p statuspolicy.Policy, s statuspolicy.State, interval time.Duration, steps []step) statuspolicy.State {
+	t.Helper()
+	now := time.UnixMilli(1700000000000)
+	for i, step := range steps {
+		var report bool
+		s, report = p.Next(s, step.observed, now)
+		assert.Equal(t, step.status, s.Status, "step %d: status", i)
+		assert.Equal(t, step.report, report, "step %d: report", i)
+		now = now.Add(interval)
+	}
+	return s
+}
+
+func TestPolicy_ZeroReportsEveryChange(t *testing.T) {
+	run(t, statuspolicy.Policy{}, statuspolicy.State{}, time.Minute, []step{
+		{"active", "active", true},
+		{"active", "active", false},
+		{"error", "error", true},
+		{"degraded", "degraded", true},
+		{"active", "active", true},
+	})
+}
+
+func TestPolicy_Thresholds(t *testing.T) {
+	p := statuspolicy.Policy{FailureThreshold: 3, RecoveryThreshold: 2}
+	active := statuspolicy.State{Status: "active", Reported: "active"}
+
+	run(t, p, active, time.Minute, []step{
+		{"error", "active", false},
+		{"error", "active", false},
+		{"active", "active", false}, // resets the failures
+		{"error", "active", false},
+		{"error", "active", false},
+		{"error", "error", true},
+		{"degraded", "error", false},
+		{"error", "error", false}, // resets the successes
+		{"active", "error", false},
+		{"degraded", "degraded", true},
+	})
+}
+
+func TestPolicy_FirstFailuresWaitForThreshold(t *testing.T) {
+	run(t, statuspolicy.Policy{FailureThreshold: 2}, statuspolicy.State{}, time.Minute, []step{
+		{"error", "", false},
+		{"error", "error", true},
+	})
+}
+
+func TestPolicy_FlapSuppression(t *testing.T) {
+	p := statuspolicy.Policy{FlapThreshold: 2, FlapWindow: 10 * time.Minute}
+	active := statuspolicy.State{Status: "active", Reported: "active"}
+
+	s := run(t, p, active, time.Minute, []step{
+		{"error", "error", true},
+		{"active", "active", true},
+		{"error", "error", false}, // third change within the window
+		{"active", "active", false},
+		{"error", "error", false},
+	})
+	assert.True(t, s.Flapping)
+
+	// Once a window passed, the status is reported if it changed meanwhile.
+	s, report := p.Next(s, "error", time.UnixMilli(1700000000000).Add(20*time.Minute))
+	assert.False(t, s.Flapping)
+	assert.True(t, report)
+	assert.Equal(t, "error", s.Reported)
+}
+
+func TestTracker(t *testing.T) {
+	tracker := statuspolicy.NewTracker()
+	p := statuspolicy.Policy{FailureThreshold: 2}
+	now := time.UnixMilli(1700000000000)
+
+	status, report := tracker.Observe("1-ams", p, "active", "error", now)
+	assert.Equal(t, "active", status)
+	assert.False(t, report)
+
+	// Another monitor does not share the count.
+	status, report = tracker.Observe("2-ams", p, "active", "error", now)
+	assert.Equal(t, "active", status)
+	assert.False(t, report)
+
+	status, report = tracker.Observe("1-ams", p, "active", "error", now.Add(time.Minute))
+	assert.Equal(t, "error", status)
+	assert.True(t, report)
+
+	// The caller still sees active: the count starts over, and the lost
+	// report is sent again once the threshold is reached.
+	status, report = tracker.Observe("1-ams", p, "active", "error", now.Add(2*time.Minute))
+	assert.Equal(t, "active", status)
+	assert.False(t, report)
+	status, report = tracker.Observe("1-ams", p, "active", "error", now.Add(3*time.Minute))
+	assert.Equal(t, "error", status)
+	assert.True(t, report)
+
+	status, report = tracker.Observe("1-ams", p, "error", "error", now.Add(4*time.Minute))
+	assert.Equal(t, "error", status)
+	assert.False(t, report)
+}
+
+func TestTracker_CurrentChangedElsewhere(t *testing.T) {
+	p := statuspolicy.Policy{FailureThreshold: 3, RecoveryThreshold: 2}
+	now := time.UnixMilli(1700000000000)
+
+	tests := []struct {
+		name     string
+		tracked  []string // observed checks while the caller reports active
+		current  string
+		observed string
+		status   string
+		report   bool
+	}{
+		{"failure below the threshold keeps error", []string{"active"}, "error", "error", "error", false},
+		{"failing streak does not carry over", []string{"error", "error"}, "error", "active", "error", false},
+		{"success below recovery keeps error", []string{"active", "active"}, "error", "active", "error", false},
+		{"degraded below recovery keeps error", []string{"active"}, "error", "degraded", "error", false},
+		{"recovery elsewhere is trusted", []string{"error", "error", "error"}, "active", "error", "active", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := statuspolicy.NewTracker()
+			for i, observed := range tt.tracked {
+				tracker.Observe("1-ams", p, "active", observed, now.Add(time.Duration(i)*time.Minute))
+			}
+
+			status, report := tracker.Observe("1-ams", p, tt.current, tt.observed, now.Add(time.Hour))
+			assert.Equal(t, tt.status, status)
+			assert.Equal(t, tt.report, report)
+		})
+	}
+}
+
+func TestTracker_Nil(t *testing.T) {
+	var tracker *statuspolicy.Tracker
+	status, report := tracker.Observe("1-ams", statuspolicy.Policy{}, "active", "error", time.Now())
+	assert.Equal(t, "error", status)
+	assert.True(t, report)
+}
@@ -0,0 +1,79 @@
+package statuspolicy
+
+import "fmt"
+
+const (
+	QuorumAny      = "any"
+	QuorumMajority = "majority"
+	QuorumAll      = "all"
+	// QuorumCount needs Count locations, whatever their number.
+	QuorumCount = "n_of_m"
+)
+
+// Quorum decides the status of a monitor from the statuses of its locations,
+// so that a single location with a broken network does not put it in error.
+type Quorum struct {
+	Mode  string
+	Count int
+}
+
+// ParseQuorum validates the mode and, for QuorumCount, the count.
+func ParseQuorum(mode string, count int) (Quorum, error) {
+	switch mode {
+	case QuorumAny, QuorumMajority, QuorumAll:
+		return Quorum{Mode: mode}, nil
+	case QuorumCount:
+		if count < 1 {
+			return Quorum{}, fmt.Errorf("quorum %s needs a count of at least 1, got %d", mode, count)
+		}
+		return Quorum{Mode: mode, Count: count}, nil
+	default:
+		return Quorum{}, fmt.Errorf("unknown quorum %q", mode)
+	}
+}
+
+// Required returns how many of the locations have to agree.
+func (q Quorum) Required(locations int) int {
+	switch q.Mode {
+	case QuorumMajority:
+		return locations/2 + 1
+	case QuorumAll:
+		return max(locations, 1)
+	case QuorumCount:
+		return max(q.Count, 1)
+	default:
+		return 1
+	}
+}
+
+// Status returns error when enough locations are in error, degraded when
+// enough are degraded or in error, and active otherwise. How many are enough
+// depends on the locations the monitor is assigned to, whether or not they
+// have a status, so that a lone location is no majority. Without any status
+// it returns an empty one.
+func (q Quorum) Status(statuses []string, locations int) string {
+	if len(statuses) == 0 {
+		return ""
+	}
+
+	var failing, degraded int
+	for _, status := range statuses {
+		switch status {
+		case StatusError:
+			failing++
+			degraded++
+		case StatusDegraded:
+			degraded++
+		}
+	}
+
+	required := q.Required(max(locations, len(statuses)))
+	switch {
+	case failing >= required:
+		return StatusError
+	case degraded >= required:
+		return StatusDegraded
+	default:
+		return StatusActive
+	}
+}
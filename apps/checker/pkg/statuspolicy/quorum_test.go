@@ -0,0 +1,62 @@
+package statuspolicy_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/checker/pkg/statuspolicy"
+)
+
+func TestQuorum_Status(t *testing.T) {
+	oneDown := []string{"error", "active", "active"}
+	twoDown := []string{"error", "error", "active"}
+	allDown := []string{"error", "error", "error"}
+	mixed := []string{"error", "degraded", "active"}
+
+	tests := []struct {
+		name     string
+		quorum   statuspolicy.Quorum
+		statuses []string
+		want     string
+	}{
+		{"any with one location down", statuspolicy.Quorum{Mode: statuspolicy.QuorumAny}, oneDown, "error"},
+		{"majority with one location down", statuspolicy.Quorum{Mode: statuspolicy.QuorumMajority}, oneDown, "active"},
+		{"majority with two locations down", statuspolicy.Quorum{Mode: statuspolicy.QuorumMajority}, twoDown, "error"},
+		{"majority of two", statuspolicy.Quorum{Mode: statuspolicy.QuorumMajority}, []string{"error", "active"}, "active"},
+		{"all with two locations down", statuspolicy.Quorum{Mode: statuspolicy.QuorumAll}, twoDown, "active"},
+		{"all with every location down", statuspolicy.Quorum{Mode: statuspolicy.QuorumAll}, allDown, "error"},
+		{"two of three", statuspolicy.Quorum{Mode: statuspolicy.QuorumCount, Count: 2}, twoDown, "error"},
+		{"count above the locations", statuspolicy.Quorum{Mode: statuspolicy.QuorumCount, Count: 4}, allDown, "active"},
+		{"errors count as degraded", statuspolicy.Quorum{Mode: statuspolicy.QuorumMajority}, mixed, "degraded"},
+		{"no statuses", statuspolicy.Quorum{Mode: statuspolicy.QuorumAny}, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.quorum.Status(tt.statuses, len(tt.statuses)))
+		})
+	}
+}
+
+func TestQuorum_StatusCountsAssignedLocations(t *testing.T) {
+	majority := statuspolicy.Quorum{Mode: statuspolicy.QuorumMajority}
+	all := statuspolicy.Quorum{Mode: statuspolicy.QuorumAll}
+
+	// The other two locations are silent: one location is no majority of three.
+	assert.Equal(t, "active", majority.Status([]string{"error"}, 3))
+	assert.Equal(t, "error", majority.Status([]string{"error", "error"}, 3))
+	assert.Equal(t, "active", all.Status([]string{"error", "error"}, 3))
+}
+
+func TestParseQuorum(t *testing.T) {
+	q, err := statuspolicy.ParseQuorum("n_of_m", 2)
+	require.NoError(t, err)
+	assert.Equal(t, statuspolicy.Quorum{Mode: statuspolicy.QuorumCount, Count: 2}, q)
+
+	_, err = statuspolicy.ParseQuorum("n_of_m", 0)
+	assert.Error(t, err)
+
+	_, err = statuspolicy.ParseQuorum("most", 0)
+	assert.ErrorContains(t, err, `unknown quorum "most"`)
+}
@@ -0,0 +1,264 @@
+package tinybird
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// ErrClosed is returned by SendEvent once the BatchClient is closed.
+var ErrClosed = errors.New("tinybird: batch client closed")
+
+// BatchOptions tunes a BatchClient; zero values take the defaults.
+type BatchOptions struct {
+	// MaxBatchSize is the most events sent in one request. Defaults to 500.
+	MaxBatchSize int
+	// FlushInterval is how long an event waits for its batch to fill up.
+	// Defaults to one second.
+	FlushInterval time.Duration
+	// MaxBufferedEvents bounds the events held in memory, sent or not;
+	// SendEvent blocks while the buffer is full. Defaults to 10000.
+	MaxBufferedEvents int
+	// MaxRetries is how often a batch is retried on a 429, a 5xx or a
+	// network error before it is dropped. Defaults to 5.
+	MaxRetries uint
+	// OnDrop is called with each batch that could not be delivered. Defaults
+	// to logging the error.
+	OnDrop func(dataSourceName string, events int, err error)
+}
+
+// BatchClient sends events to the events API as NDJSON, one request per
+// datasource and batch instead of one per event. SendEvent only queues the
+// event: delivery errors are logged, not returned. Close flushes the queue.
+type BatchClient struct {
+	client client
+	opts   BatchOptions
+
+	// slots holds a token per buffered event.
+	slots chan struct{}
+	// full wakes the sender when a batch reached MaxBatchSize.
+	full chan struct{}
+
+	mu      sync.Mutex
+	pending map[string][][]byte
+	closed  bool
+
+	// ctx bounds the requests of the sender; Close cancels it once its own
+	// context is done.
+	ctx    context.Context
+	cancel context.CancelFunc
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+type batch struct {
+	dataSourceName string
+	events         [][]byte
+}
+
+func NewBatchClient(httpClient *http.Client, apiKey string, opts BatchOptions) *BatchClient {
+	if opts.MaxBatchSize <= 0 {
+		opts.MaxBatchSize = 500
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = time.Second
+	}
+	if opts.MaxBufferedEvents <= 0 {
+		opts.MaxBufferedEvents = 10000
+	}
+	if opts.MaxRetries == 0 {
+		opts.MaxRetries = 5
+	}
+	if opts.OnDrop == nil {
+		opts.OnDrop = logDrop
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	b := &BatchClient{
+		client: client{
+			httpClient: httpClient,
+			apiKey:     apiKey,
+			baseURL:    getBaseURL(),
+		},
+		opts:    opts,
+		slots:   make(chan struct{}, opts.MaxBufferedEvents),
+		full:    make(chan struct{}, 1),
+		pending: make(map[string][][]byte),
+		ctx:     ctx,
+		cancel:  cancel,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go b.run()
+
+	return b
+}
+
+// SendEvent queues the event for its datasource. It blocks while the buffer
+// is full and gives up when ctx is done.
+func (b *BatchClient) SendEvent(ctx context.Context, event any, dataSourceName string) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("unable to encode payload")
+		return fmt.Errorf("unable to encode payload: %w", err)
+	}
+
+	select {
+	case b.slots <- struct{}{}:
+	case <-ctx.Done():
+		return fmt.Errorf("tinybird buffer full: %w", ctx.Err())
+	}
+
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		<-b.slots
+		return ErrClosed
+	}
+	b.pending[dataSourceName] = append(b.pending[dataSourceName], line)
+	full := len(b.pending[dataSourceName]) >= b.opts.MaxBatchSize
+	b.mu.Unlock()
+
+	if full {
+		select {
+		case b.full <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// Close stops accepting events and sends the queued ones. Batches still
+// unsent when ctx is done are dropped.
+func (b *BatchClient) Close(ctx context.Context) error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	b.mu.Unlock()
+
+	close(b.stop)
+	select {
+	case <-b.done:
+		b.cancel()
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		<-b.done
+		return ctx.Err()
+	}
+}
+
+func (b *BatchClient) run() {
+	defer close(b.done)
+
+	ticker := time.NewTicker(b.opts.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.full:
+			b.flush(false)
+		case <-ticker.C:
+			b.flush(true)
+		case <-b.stop:
+			b.flush(true)
+			return
+		}
+	}
+}
+
+// flush sends the full batches, and the partial ones too when all is set.
+func (b *BatchClient) flush(all bool) {
+	for _, batch := range b.take(all) {
+		if err := b.send(b.ctx, batch); err != nil {
+			b.opts.OnDrop(batch.dataSourceName, len(batch.events), err)
+		}
+		for range batch.events {
+			<-b.slots
+		}
+	}
+}
+
+func logDrop(dataSourceName string, events int, err error) {
+	log.Error().Err(err).Str("datasource", dataSourceName).Int("events", events).Msg("dropping tinybird batch")
+}
+
+func (b *BatchClient) take(all bool) []batch {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var batches []batch
+	for name, events := range b.pending {
+		for len(events) >= b.opts.MaxBatchSize {
+			batches = append(batches, batch{dataSourceName: name, events: events[:b.opts.MaxBatchSize:b.opts.MaxBatchSize]})
+			events = events[b.opts.MaxBatchSize:]
+		}
+		if all && len(events) > 0 {
+			batches = append(batches, batch{dataSourceName: name, events: events})
+			events = nil
+		}
+		if len(events) == 0 {
+			delete(b.pending, name)
+		} else {
+			b.pending[name] = events
+		}
+	}
+
+	return batches
+}
+
+func (b *BatchClient) send(ctx context.Context, batch batch) error {
+	requestURL, err := b.client.eventsURL(batch.dataSourceName)
+	if err != nil {
+		return err
+	}
+	body := bytes.Join(batch.events, []byte("\n"))
+
+	op := func() (struct{}, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, bytes.NewReader(body))
+		if err != nil {
+			return struct{}{}, backoff.Permanent(fmt.Errorf("unable to create request: %w", err))
+		}
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", b.client.apiKey))
+		req.Header.Set("Content-Type", "application/x-ndjson")
+
+		resp, err := b.client.httpClient.Do(req)
+		if err != nil {
+			return struct{}{}, fmt.Errorf("unable to send request: %w", err)
+		}
+		defer resp.Body.Close()
+
+		switch {
+		case resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted:
+			return struct{}{}, nil
+		case resp.StatusCode == http.StatusTooManyRequests:
+			if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+				return struct{}{}, backoff.RetryAfter(seconds)
+			}
+			return struct{}{}, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		case resp.StatusCode >= http.StatusInternalServerError:
+			return struct{}{}, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		default:
+			return struct{}{}, backoff.Permanent(fmt.Errorf("unexpected status code: %d", resp.StatusCode))
+		}
+	}
+
+	_, err = backoff.Retry(ctx, op,
+		backoff.WithBackOff(backoff.NewExponentialBackOff()),
+		backoff.WithMaxTries(b.opts.MaxRetries+1),
+	)
+	return err
+}
@@ -0,0 +1,141 @@
+package tinybird_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openstatushq/openstatus/apps/checker/pkg/tinybird"
+	"github.com/stretchr/testify/require"
+)
+
+type recordedRequest struct {
+	url  string
+	body string
+}
+
+// recordingHTTPClient answers with the given status codes in turn, then 202.
+func recordingHTTPClient(statuses ...int) (*http.Client, func() []recordedRequest) {
+	var mu sync.Mutex
+	var requests []recordedRequest
+	interceptor := &interceptorHTTPClient{
+		f: func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+
+			mu.Lock()
+			defer mu.Unlock()
+			requests = append(requests, recordedRequest{url: req.URL.String(), body: string(body)})
+
+			status := http.StatusAccepted
+			if len(statuses) > 0 {
+				status, statuses = statuses[0], statuses[1:]
+			}
+			return &http.Response{StatusCode: status, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(""))}, nil
+		},
+	}
+
+	return interceptor.GetHTTPClient(), func() []recordedRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]recordedRequest(nil), requests...)
+	}
+}
+
+func TestBatchClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	t.Run("it should send full batches as NDJSON per datasource", func(t *testing.T) {
+		httpClient, requests := recordingHTTPClient()
+		client := tinybird.NewBatchClient(httpClient, "apiKey", tinybird.BatchOptions{MaxBatchSize: 2, FlushInterval: time.Hour})
+
+		require.NoError(t, client.SendEvent(ctx, map[string]int{"a": 1}, "first"))
+		require.NoError(t, client.SendEvent(ctx, map[string]int{"b": 2}, "second"))
+		require.NoError(t, client.SendEvent(ctx, map[string]int{"a": 3}, "first"))
+
+		require.Eventually(t, func() bool { return len(requests()) == 1 }, time.Second, 5*time.Millisecond)
+		got := requests()[0]
+		require.Equal(t, "https://api.tinybird.co/v0/events?name=first", got.url)
+		require.Equal(t, "{\"a\":1}\n{\"a\":3}", got.body)
+
+		// The partial batch waits for the interval, or for Close.
+		require.NoError(t, client.Close(ctx))
+		require.Len(t, requests(), 2)
+		require.Equal(t, "https://api.tinybird.co/v0/events?name=second", requests()[1].url)
+		require.Equal(t, `{"b":2}`, requests()[1].body)
+
+		require.ErrorIs(t, client.SendEvent(ctx, "late", "first"), tinybird.ErrClosed)
+	})
+
+	t.Run("it should flush partial batches after the interval", func(t *testing.T) {
+		httpClient, requests := recordingHTTPClient()
+		client := tinybird.NewBatchClient(httpClient, "apiKey", tinybird.BatchOptions{FlushInterval: 10 * time.Millisecond})
+		t.Cleanup(func() { _ = client.Close(context.Background()) })
+
+		require.NoError(t, client.SendEvent(ctx, "event", "test"))
+		require.Eventually(t, func() bool { return len(requests()) == 1 }, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("it should retry on 429 and 5xx but not on other errors", func(t *testing.T) {
+		httpClient, requests := recordingHTTPClient(http.StatusTooManyRequests, http.StatusServiceUnavailable)
+		client := tinybird.NewBatchClient(httpClient, "apiKey", tinybird.BatchOptions{FlushInterval: time.Hour})
+
+		require.NoError(t, client.SendEvent(ctx, "event", "test"))
+		require.NoError(t, client.Close(ctx))
+		require.Len(t, requests(), 3)
+
+		httpClient, requests = recordingHTTPClient(http.StatusBadRequest)
+		client = tinybird.NewBatchClient(httpClient, "apiKey", tinybird.BatchOptions{FlushInterval: time.Hour})
+
+		require.NoError(t, client.SendEvent(ctx, "event", "test"))
+		require.NoError(t, client.Close(ctx))
+		require.Len(t, requests(), 1)
+	})
+
+	t.Run("it should block while the buffer is full", func(t *testing.T) {
+		release := make(chan struct{})
+		interceptor := &interceptorHTTPClient{
+			f: func(req *http.Request) (*http.Response, error) {
+				<-release
+				return &http.Response{StatusCode: http.StatusAccepted, Body: io.NopCloser(strings.NewReader(""))}, nil
+			},
+		}
+		client := tinybird.NewBatchClient(interceptor.GetHTTPClient(), "apiKey", tinybird.BatchOptions{MaxBatchSize: 1, MaxBufferedEvents: 1})
+
+		require.NoError(t, client.SendEvent(ctx, "first", "test"))
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, client.SendEvent(timeoutCtx, "second", "test"), context.DeadlineExceeded)
+
+		close(release)
+		require.NoError(t, client.SendEvent(ctx, "third", "test"))
+		require.NoError(t, client.Close(ctx))
+	})
+
+	t.Run("it should hand undelivered batches to OnDrop", func(t *testing.T) {
+		interceptor := &interceptorHTTPClient{
+			f: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: http.StatusBadRequest, Body: io.NopCloser(strings.NewReader(""))}, nil
+			},
+		}
+		var dropped []string
+		client := tinybird.NewBatchClient(interceptor.GetHTTPClient(), "apiKey", tinybird.BatchOptions{
+			FlushInterval: time.Hour,
+			OnDrop: func(dataSourceName string, events int, err error) {
+				dropped = append(dropped, fmt.Sprintf("%s:%d", dataSourceName, events))
+			},
+		})
+
+		require.NoError(t, client.SendEvent(ctx, "first", "test"))
+		require.NoError(t, client.SendEvent(ctx, "second", "test"))
+		require.NoError(t, client.Close(ctx))
+		require.Equal(t, []string{"test:2"}, dropped)
+	})
+}
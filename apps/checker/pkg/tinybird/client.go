@@ -39,24 +39,34 @@ func NewClient(httpClient *http.Client, apiKey string) Client {
 	}
 }
 
-func (c client) SendEvent(ctx context.Context, event any, dataSourceName string) error {
+// eventsURL is the events API URL of the datasource.
+func (c client) eventsURL(dataSourceName string) (string, error) {
 	requestURL, err := url.Parse(c.baseURL)
 	if err != nil {
-		log.Ctx(ctx).Error().Err(err).Msg("unable to parse url")
-		return fmt.Errorf("unable to parse url: %w", err)
+		return "", fmt.Errorf("unable to parse url: %w", err)
 	}
 
 	q := requestURL.Query()
 	q.Add("name", dataSourceName)
 	requestURL.RawQuery = q.Encode()
 
+	return requestURL.String(), nil
+}
+
+func (c client) SendEvent(ctx context.Context, event any, dataSourceName string) error {
+	requestURL, err := c.eventsURL(dataSourceName)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("unable to parse url")
+		return err
+	}
+
 	var payload bytes.Buffer
 	if err := json.NewEncoder(&payload).Encode(event); err != nil {
 		log.Ctx(ctx).Error().Err(err).Msg("unable to encode payload")
 		return fmt.Errorf("unable to encode payload: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL.String(), bytes.NewReader(payload.Bytes()))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, bytes.NewReader(payload.Bytes()))
 	if err != nil {
 		log.Ctx(ctx).Error().Err(err).Msg("unable to create request")
 		return fmt.Errorf("unable to create request: %w", err)
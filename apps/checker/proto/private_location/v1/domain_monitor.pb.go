@@ -0,0 +1,219 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: private_location/v1/domain_monitor.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DomainMonitor struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Registered domain name, e.g. openstatus.dev.
+	Uri         string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	Periodicity string `protobuf:"bytes,3,opt,name=periodicity,proto3" json:"periodicity,omitempty"`
+	Timeout     int64  `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	DegradedAt  *int64 `protobuf:"varint,5,opt,name=degraded_at,json=degradedAt,proto3,oneof" json:"degraded_at,omitempty"`
+	Retry       int64  `protobuf:"varint,6,opt,name=retry,proto3" json:"retry,omitempty"`
+	// RDAP server to query instead of the one the IANA bootstrap registry
+	// lists for the TLD.
+	RdapBaseUrl      string             `protobuf:"bytes,7,opt,name=rdap_base_url,json=rdapBaseUrl,proto3" json:"rdap_base_url,omitempty"`
+	ExpiryAssertions []*ExpiryAssertion `protobuf:"bytes,8,rep,name=expiry_assertions,json=expiryAssertions,proto3" json:"expiry_assertions,omitempty"`
+	// Evaluated against the space-joined RDAP status values.
+	StatusAssertions []*ValueAssertion `protobuf:"bytes,9,rep,name=status_assertions,json=statusAssertions,proto3" json:"status_assertions,omitempty"`
+	OtelConfig       *OtelConfig       `protobuf:"bytes,20,opt,name=otel_config,json=otelConfig,proto3" json:"otel_config,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DomainMonitor) Reset() {
+	*x = DomainMonitor{}
+	mi := &file_private_location_v1_domain_monitor_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DomainMonitor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainMonitor) ProtoMessage() {}
+
+func (x *DomainMonitor) ProtoReflect() protoreflect.Message {
+	mi := &file_private_location_v1_domain_monitor_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainMonitor.ProtoReflect.Descriptor instead.
+func (*DomainMonitor) Descriptor() ([]byte, []int) {
+	return file_private_location_v1_domain_monitor_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DomainMonitor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DomainMonitor) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *DomainMonitor) GetPeriodicity() string {
+	if x != nil {
+		return x.Periodicity
+	}
+	return ""
+}
+
+func (x *DomainMonitor) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *DomainMonitor) GetDegradedAt() int64 {
+	if x != nil && x.DegradedAt != nil {
+		return *x.DegradedAt
+	}
+	return 0
+}
+
+func (x *DomainMonitor) GetRetry() int64 {
+	if x != nil {
+		return x.Retry
+	}
+	return 0
+}
+
+func (x *DomainMonitor) GetRdapBaseUrl() string {
+	if x != nil {
+		return x.RdapBaseUrl
+	}
+	return ""
+}
+
+func (x *DomainMonitor) GetExpiryAssertions() []*ExpiryAssertion {
+	if x != nil {
+		return x.ExpiryAssertions
+	}
+	return nil
+}
+
+func (x *DomainMonitor) GetStatusAssertions() []*ValueAssertion {
+	if x != nil {
+		return x.StatusAssertions
+	}
+	return nil
+}
+
+func (x *DomainMonitor) GetOtelConfig() *OtelConfig {
+	if x != nil {
+		return x.OtelConfig
+	}
+	return nil
+}
+
+var File_private_location_v1_domain_monitor_proto protoreflect.FileDescriptor
+
+const file_private_location_v1_domain_monitor_proto_rawDesc = "" +
+	"\n" +
+	"(private_location/v1/domain_monitor.proto\x12\x13private_location.v1\x1a$private_location/v1/assertions.proto\x1a\x1eprivate_location/v1/otel.proto\"\xc4\x03\n" +
+	"\rDomainMonitor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03uri\x18\x02 \x01(\tR\x03uri\x12 \n" +
+	"\vperiodicity\x18\x03 \x01(\tR\vperiodicity\x12\x18\n" +
+	"\atimeout\x18\x04 \x01(\x03R\atimeout\x12$\n" +
+	"\vdegraded_at\x18\x05 \x01(\x03H\x00R\n" +
+	"degradedAt\x88\x01\x01\x12\x14\n" +
+	"\x05retry\x18\x06 \x01(\x03R\x05retry\x12\"\n" +
+	"\rrdap_base_url\x18\a \x01(\tR\vrdapBaseUrl\x12Q\n" +
+	"\x11expiry_assertions\x18\b \x03(\v2$.private_location.v1.ExpiryAssertionR\x10expiryAssertions\x12P\n" +
+	"\x11status_assertions\x18\t \x03(\v2#.private_location.v1.ValueAssertionR\x10statusAssertions\x12@\n" +
+	"\votel_config\x18\x14 \x01(\v2\x1f.private_location.v1.OtelConfigR\n" +
+	"otelConfigB\x0e\n" +
+	"\f_degraded_atBJZHgithub.com/openstatushq/openstatus/packages/proto/private_location/v1;v1b\x06proto3"
+
+var (
+	file_private_location_v1_domain_monitor_proto_rawDescOnce sync.Once
+	file_private_location_v1_domain_monitor_proto_rawDescData []byte
+)
+
+func file_private_location_v1_domain_monitor_proto_rawDescGZIP() []byte {
+	file_private_location_v1_domain_monitor_proto_rawDescOnce.Do(func() {
+		file_private_location_v1_domain_monitor_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_private_location_v1_domain_monitor_proto_rawDesc), len(file_private_location_v1_domain_monitor_proto_rawDesc)))
+	})
+	return file_private_location_v1_domain_monitor_proto_rawDescData
+}
+
+var file_private_location_v1_domain_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_private_location_v1_domain_monitor_proto_goTypes = []any{
+	(*DomainMonitor)(nil),   // 0: private_location.v1.DomainMonitor
+	(*ExpiryAssertion)(nil), // 1: private_location.v1.ExpiryAssertion
+	(*ValueAssertion)(nil),  // 2: private_location.v1.ValueAssertion
+	(*OtelConfig)(nil),      // 3: private_location.v1.OtelConfig
+}
+var file_private_location_v1_domain_monitor_proto_depIdxs = []int32{
+	1, // 0: private_location.v1.DomainMonitor.expiry_assertions:type_name -> private_location.v1.ExpiryAssertion
+	2, // 1: private_location.v1.DomainMonitor.status_assertions:type_name -> private_location.v1.ValueAssertion
+	3, // 2: private_location.v1.DomainMonitor.otel_config:type_name -> private_location.v1.OtelConfig
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_private_location_v1_domain_monitor_proto_init() }
+func file_private_location_v1_domain_monitor_proto_init() {
+	if File_private_location_v1_domain_monitor_proto != nil {
+		return
+	}
+	file_private_location_v1_assertions_proto_init()
+	file_private_location_v1_otel_proto_init()
+	file_private_location_v1_domain_monitor_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_private_location_v1_domain_monitor_proto_rawDesc), len(file_private_location_v1_domain_monitor_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_private_location_v1_domain_monitor_proto_goTypes,
+		DependencyIndexes: file_private_location_v1_domain_monitor_proto_depIdxs,
+		MessageInfos:      file_private_location_v1_domain_monitor_proto_msgTypes,
+	}.Build()
+	File_private_location_v1_domain_monitor_proto = out.File
+	file_private_location_v1_domain_monitor_proto_goTypes = nil
+	file_private_location_v1_domain_monitor_proto_depIdxs = nil
+}
@@ -36,6 +36,7 @@ type HTTPMonitor struct {
 	StatusCodeAssertions []*StatusCodeAssertion `protobuf:"bytes,11,rep,name=status_code_assertions,json=statusCodeAssertions,proto3" json:"status_code_assertions,omitempty"`
 	BodyAssertions       []*BodyAssertion       `protobuf:"bytes,12,rep,name=body_assertions,json=bodyAssertions,proto3" json:"body_assertions,omitempty"`
 	HeaderAssertions     []*HeaderAssertion     `protobuf:"bytes,13,rep,name=header_assertions,json=headerAssertions,proto3" json:"header_assertions,omitempty"`
+	Auth                 *HTTPAuth              `protobuf:"bytes,14,opt,name=auth,proto3" json:"auth,omitempty"`
 	OtelConfig           *OtelConfig            `protobuf:"bytes,20,opt,name=otel_config,json=otelConfig,proto3" json:"otel_config,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
@@ -162,6 +163,13 @@ func (x *HTTPMonitor) GetHeaderAssertions() []*HeaderAssertion {
 	return nil
 }
 
+func (x *HTTPMonitor) GetAuth() *HTTPAuth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
 func (x *HTTPMonitor) GetOtelConfig() *OtelConfig {
 	if x != nil {
 		return x.OtelConfig
@@ -169,11 +177,298 @@ func (x *HTTPMonitor) GetOtelConfig() *OtelConfig {
 	return nil
 }
 
+// HTTPAuth authenticates the requests of an HTTP monitor.
+type HTTPAuth struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Oauth2        *OAuth2ClientCredentials `protobuf:"bytes,1,opt,name=oauth2,proto3" json:"oauth2,omitempty"`
+	Hmac          *HMACSigning             `protobuf:"bytes,2,opt,name=hmac,proto3" json:"hmac,omitempty"`
+	Sigv4         *SigV4Signing            `protobuf:"bytes,3,opt,name=sigv4,proto3" json:"sigv4,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPAuth) Reset() {
+	*x = HTTPAuth{}
+	mi := &file_private_location_v1_http_monitor_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPAuth) ProtoMessage() {}
+
+func (x *HTTPAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_private_location_v1_http_monitor_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPAuth.ProtoReflect.Descriptor instead.
+func (*HTTPAuth) Descriptor() ([]byte, []int) {
+	return file_private_location_v1_http_monitor_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HTTPAuth) GetOauth2() *OAuth2ClientCredentials {
+	if x != nil {
+		return x.Oauth2
+	}
+	return nil
+}
+
+func (x *HTTPAuth) GetHmac() *HMACSigning {
+	if x != nil {
+		return x.Hmac
+	}
+	return nil
+}
+
+func (x *HTTPAuth) GetSigv4() *SigV4Signing {
+	if x != nil {
+		return x.Sigv4
+	}
+	return nil
+}
+
+// OAuth2ClientCredentials fetches a bearer token with the client credentials
+// grant; the probe reuses it across checks until it expires.
+type OAuth2ClientCredentials struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokenUrl      string                 `protobuf:"bytes,1,opt,name=token_url,json=tokenUrl,proto3" json:"token_url,omitempty"`
+	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret  string                 `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Audience      string                 `protobuf:"bytes,5,opt,name=audience,proto3" json:"audience,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuth2ClientCredentials) Reset() {
+	*x = OAuth2ClientCredentials{}
+	mi := &file_private_location_v1_http_monitor_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuth2ClientCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuth2ClientCredentials) ProtoMessage() {}
+
+func (x *OAuth2ClientCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_private_location_v1_http_monitor_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuth2ClientCredentials.ProtoReflect.Descriptor instead.
+func (*OAuth2ClientCredentials) Descriptor() ([]byte, []int) {
+	return file_private_location_v1_http_monitor_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OAuth2ClientCredentials) GetTokenUrl() string {
+	if x != nil {
+		return x.TokenUrl
+	}
+	return ""
+}
+
+func (x *OAuth2ClientCredentials) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OAuth2ClientCredentials) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *OAuth2ClientCredentials) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OAuth2ClientCredentials) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+// HMACSigning signs "timestamp\nMETHOD\n/path?query\nbody" with a shared
+// secret. Algorithm is sha256 (default) or sha512.
+type HMACSigning struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Secret          string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Algorithm       string                 `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	SignatureHeader string                 `protobuf:"bytes,3,opt,name=signature_header,json=signatureHeader,proto3" json:"signature_header,omitempty"`
+	TimestampHeader string                 `protobuf:"bytes,4,opt,name=timestamp_header,json=timestampHeader,proto3" json:"timestamp_header,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HMACSigning) Reset() {
+	*x = HMACSigning{}
+	mi := &file_private_location_v1_http_monitor_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HMACSigning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HMACSigning) ProtoMessage() {}
+
+func (x *HMACSigning) ProtoReflect() protoreflect.Message {
+	mi := &file_private_location_v1_http_monitor_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HMACSigning.ProtoReflect.Descriptor instead.
+func (*HMACSigning) Descriptor() ([]byte, []int) {
+	return file_private_location_v1_http_monitor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HMACSigning) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *HMACSigning) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *HMACSigning) GetSignatureHeader() string {
+	if x != nil {
+		return x.SignatureHeader
+	}
+	return ""
+}
+
+func (x *HMACSigning) GetTimestampHeader() string {
+	if x != nil {
+		return x.TimestampHeader
+	}
+	return ""
+}
+
+// SigV4Signing signs requests with AWS Signature Version 4; service defaults
+// to execute-api.
+type SigV4Signing struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccessKeyId     string                 `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	SecretAccessKey string                 `protobuf:"bytes,2,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
+	SessionToken    string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Region          string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	Service         string                 `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SigV4Signing) Reset() {
+	*x = SigV4Signing{}
+	mi := &file_private_location_v1_http_monitor_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SigV4Signing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigV4Signing) ProtoMessage() {}
+
+func (x *SigV4Signing) ProtoReflect() protoreflect.Message {
+	mi := &file_private_location_v1_http_monitor_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SigV4Signing.ProtoReflect.Descriptor instead.
+func (*SigV4Signing) Descriptor() ([]byte, []int) {
+	return file_private_location_v1_http_monitor_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SigV4Signing) GetAccessKeyId() string {
+	if x != nil {
+		return x.AccessKeyId
+	}
+	return ""
+}
+
+func (x *SigV4Signing) GetSecretAccessKey() string {
+	if x != nil {
+		return x.SecretAccessKey
+	}
+	return ""
+}
+
+func (x *SigV4Signing) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SigV4Signing) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *SigV4Signing) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
 var File_private_location_v1_http_monitor_proto protoreflect.FileDescriptor
 
 const file_private_location_v1_http_monitor_proto_rawDesc = "" +
 	"\n" +
-	"&private_location/v1/http_monitor.proto\x12\x13private_location.v1\x1a$private_location/v1/assertions.proto\x1a\x1eprivate_location/v1/otel.proto\"\x88\x05\n" +
+	"&private_location/v1/http_monitor.proto\x12\x13private_location.v1\x1a$private_location/v1/assertions.proto\x1a\x1eprivate_location/v1/otel.proto\"\xbb\x05\n" +
 	"\vHTTPMonitor\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12 \n" +
@@ -189,10 +484,32 @@ const file_private_location_v1_http_monitor_proto_rawDesc = "" +
 	" \x03(\v2\x1c.private_location.v1.HeadersR\aheaders\x12^\n" +
 	"\x16status_code_assertions\x18\v \x03(\v2(.private_location.v1.StatusCodeAssertionR\x14statusCodeAssertions\x12K\n" +
 	"\x0fbody_assertions\x18\f \x03(\v2\".private_location.v1.BodyAssertionR\x0ebodyAssertions\x12Q\n" +
-	"\x11header_assertions\x18\r \x03(\v2$.private_location.v1.HeaderAssertionR\x10headerAssertions\x12@\n" +
+	"\x11header_assertions\x18\r \x03(\v2$.private_location.v1.HeaderAssertionR\x10headerAssertions\x121\n" +
+	"\x04auth\x18\x0e \x01(\v2\x1d.private_location.v1.HTTPAuthR\x04auth\x12@\n" +
 	"\votel_config\x18\x14 \x01(\v2\x1f.private_location.v1.OtelConfigR\n" +
 	"otelConfigB\x0e\n" +
-	"\f_degraded_atBJZHgithub.com/openstatushq/openstatus/packages/proto/private_location/v1;v1b\x06proto3"
+	"\f_degraded_at\"\xbf\x01\n" +
+	"\bHTTPAuth\x12D\n" +
+	"\x06oauth2\x18\x01 \x01(\v2,.private_location.v1.OAuth2ClientCredentialsR\x06oauth2\x124\n" +
+	"\x04hmac\x18\x02 \x01(\v2 .private_location.v1.HMACSigningR\x04hmac\x127\n" +
+	"\x05sigv4\x18\x03 \x01(\v2!.private_location.v1.SigV4SigningR\x05sigv4\"\xac\x01\n" +
+	"\x17OAuth2ClientCredentials\x12\x1b\n" +
+	"\ttoken_url\x18\x01 \x01(\tR\btokenUrl\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x03 \x01(\tR\fclientSecret\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\x1a\n" +
+	"\baudience\x18\x05 \x01(\tR\baudience\"\x99\x01\n" +
+	"\vHMACSigning\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1c\n" +
+	"\talgorithm\x18\x02 \x01(\tR\talgorithm\x12)\n" +
+	"\x10signature_header\x18\x03 \x01(\tR\x0fsignatureHeader\x12)\n" +
+	"\x10timestamp_header\x18\x04 \x01(\tR\x0ftimestampHeader\"\xb5\x01\n" +
+	"\fSigV4Signing\x12\"\n" +
+	"\raccess_key_id\x18\x01 \x01(\tR\vaccessKeyId\x12*\n" +
+	"\x11secret_access_key\x18\x02 \x01(\tR\x0fsecretAccessKey\x12#\n" +
+	"\rsession_token\x18\x03 \x01(\tR\fsessionToken\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12\x18\n" +
+	"\aservice\x18\x05 \x01(\tR\aserviceBJZHgithub.com/openstatushq/openstatus/packages/proto/private_location/v1;v1b\x06proto3"
 
 var (
 	file_private_location_v1_http_monitor_proto_rawDescOnce sync.Once
@@ -206,26 +523,34 @@ func file_private_location_v1_http_monitor_proto_rawDescGZIP() []byte {
 	return file_private_location_v1_http_monitor_proto_rawDescData
 }
 
-var file_private_location_v1_http_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_private_location_v1_http_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_private_location_v1_http_monitor_proto_goTypes = []any{
-	(*HTTPMonitor)(nil),         // 0: private_location.v1.HTTPMonitor
-	(*Headers)(nil),             // 1: private_location.v1.Headers
-	(*StatusCodeAssertion)(nil), // 2: private_location.v1.StatusCodeAssertion
-	(*BodyAssertion)(nil),       // 3: private_location.v1.BodyAssertion
-	(*HeaderAssertion)(nil),     // 4: private_location.v1.HeaderAssertion
-	(*OtelConfig)(nil),          // 5: private_location.v1.OtelConfig
+	(*HTTPMonitor)(nil),             // 0: private_location.v1.HTTPMonitor
+	(*HTTPAuth)(nil),                // 1: private_location.v1.HTTPAuth
+	(*OAuth2ClientCredentials)(nil), // 2: private_location.v1.OAuth2ClientCredentials
+	(*HMACSigning)(nil),             // 3: private_location.v1.HMACSigning
+	(*SigV4Signing)(nil),            // 4: private_location.v1.SigV4Signing
+	(*Headers)(nil),                 // 5: private_location.v1.Headers
+	(*StatusCodeAssertion)(nil),     // 6: private_location.v1.StatusCodeAssertion
+	(*BodyAssertion)(nil),           // 7: private_location.v1.BodyAssertion
+	(*HeaderAssertion)(nil),         // 8: private_location.v1.HeaderAssertion
+	(*OtelConfig)(nil),              // 9: private_location.v1.OtelConfig
 }
 var file_private_location_v1_http_monitor_proto_depIdxs = []int32{
-	1, // 0: private_location.v1.HTTPMonitor.headers:type_name -> private_location.v1.Headers
-	2, // 1: private_location.v1.HTTPMonitor.status_code_assertions:type_name -> private_location.v1.StatusCodeAssertion
-	3, // 2: private_location.v1.HTTPMonitor.body_assertions:type_name -> private_location.v1.BodyAssertion
-	4, // 3: private_location.v1.HTTPMonitor.header_assertions:type_name -> private_location.v1.HeaderAssertion
-	5, // 4: private_location.v1.HTTPMonitor.otel_config:type_name -> private_location.v1.OtelConfig
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	5, // 0: private_location.v1.HTTPMonitor.headers:type_name -> private_location.v1.Headers
+	6, // 1: private_location.v1.HTTPMonitor.status_code_assertions:type_name -> private_location.v1.StatusCodeAssertion
+	7, // 2: private_location.v1.HTTPMonitor.body_assertions:type_name -> private_location.v1.BodyAssertion
+	8, // 3: private_location.v1.HTTPMonitor.header_assertions:type_name -> private_location.v1.HeaderAssertion
+	1, // 4: private_location.v1.HTTPMonitor.auth:type_name -> private_location.v1.HTTPAuth
+	9, // 5: private_location.v1.HTTPMonitor.otel_config:type_name -> private_location.v1.OtelConfig
+	2, // 6: private_location.v1.HTTPAuth.oauth2:type_name -> private_location.v1.OAuth2ClientCredentials
+	3, // 7: private_location.v1.HTTPAuth.hmac:type_name -> private_location.v1.HMACSigning
+	4, // 8: private_location.v1.HTTPAuth.sigv4:type_name -> private_location.v1.SigV4Signing
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_private_location_v1_http_monitor_proto_init() }
@@ -242,7 +567,7 @@ func file_private_location_v1_http_monitor_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_private_location_v1_http_monitor_proto_rawDesc), len(file_private_location_v1_http_monitor_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -74,9 +74,11 @@ func (x *Headers) GetValue() string {
 }
 
 type OtelConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	Headers       []*Headers             `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Headers  []*Headers             `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
+	// Export every check as a trace with phase spans, not only metrics.
+	Traces        bool `protobuf:"varint,3,opt,name=traces,proto3" json:"traces,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -125,6 +127,13 @@ func (x *OtelConfig) GetHeaders() []*Headers {
 	return nil
 }
 
+func (x *OtelConfig) GetTraces() bool {
+	if x != nil {
+		return x.Traces
+	}
+	return false
+}
+
 var File_private_location_v1_otel_proto protoreflect.FileDescriptor
 
 const file_private_location_v1_otel_proto_rawDesc = "" +
@@ -132,11 +141,12 @@ const file_private_location_v1_otel_proto_rawDesc = "" +
 	"\x1eprivate_location/v1/otel.proto\x12\x13private_location.v1\"1\n" +
 	"\aHeaders\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value\"`\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"x\n" +
 	"\n" +
 	"OtelConfig\x12\x1a\n" +
 	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x126\n" +
-	"\aheaders\x18\x02 \x03(\v2\x1c.private_location.v1.HeadersR\aheadersBJZHgithub.com/openstatushq/openstatus/packages/proto/private_location/v1;v1b\x06proto3"
+	"\aheaders\x18\x02 \x03(\v2\x1c.private_location.v1.HeadersR\aheaders\x12\x16\n" +
+	"\x06traces\x18\x03 \x01(\bR\x06tracesBJZHgithub.com/openstatushq/openstatus/packages/proto/private_location/v1;v1b\x06proto3"
 
 var (
 	file_private_location_v1_otel_proto_rawDescOnce sync.Once
@@ -84,12 +84,74 @@ type HttpCheckerRequest struct {
 	DegradedAfter   int64             `json:"degradedAfter,omitempty"`
 	Retry           int64             `json:"retry,omitempty"`
 	FollowRedirects bool              `json:"followRedirects,omitempty"`
-	OtelConfig      struct {
+	Auth            *HTTPAuth         `json:"auth,omitempty"`
+	StatusPolicy
+	OtelConfig struct {
 		Endpoint string            `json:"endpoint"`
 		Headers  map[string]string `json:"headers,omitempty"`
+		// Traces exports every check as a trace with phase spans.
+		Traces bool `json:"traces,omitempty"`
 	} `json:"otelConfig"`
 }
 
+// StatusPolicy holds when the checks of a monitor change its status.
+// FailureThreshold and RecoveryThreshold are the consecutive failed and
+// successful checks that change the status. More than FlapThreshold status
+// changes within FlapWindow, in milliseconds, mark the monitor flapping.
+type StatusPolicy struct {
+	FailureThreshold  int   `json:"failureThreshold,omitempty"`
+	RecoveryThreshold int   `json:"recoveryThreshold,omitempty"`
+	FlapThreshold     int   `json:"flapThreshold,omitempty"`
+	FlapWindow        int64 `json:"flapWindow,omitempty"`
+}
+
+// HTTPAuth authenticates the requests of an HTTP check.
+type HTTPAuth struct {
+	OAuth2 *OAuth2ClientCredentials `json:"oauth2,omitempty"`
+	HMAC   *HMACSigning             `json:"hmac,omitempty"`
+	SigV4  *SigV4Signing            `json:"sigv4,omitempty"`
+}
+
+// OAuth2ClientCredentials fetches a bearer token with the client credentials
+// grant before the check runs.
+type OAuth2ClientCredentials struct {
+	TokenURL     string   `json:"tokenUrl"`
+	ClientID     string   `json:"clientId"`
+	ClientSecret string   `json:"clientSecret"`
+	Scopes       []string `json:"scopes,omitempty"`
+	Audience     string   `json:"audience,omitempty"`
+}
+
+// HMACSigning signs the method, path, body and a timestamp with a shared
+// secret. Algorithm is sha256 (default) or sha512; the headers default to
+// X-Signature and X-Timestamp.
+type HMACSigning struct {
+	Secret          string `json:"secret"`
+	Algorithm       string `json:"algorithm,omitempty"`
+	SignatureHeader string `json:"signatureHeader,omitempty"`
+	TimestampHeader string `json:"timestampHeader,omitempty"`
+}
+
+// SigV4Signing signs the request with AWS Signature Version 4, for endpoints
+// behind IAM auth. Service defaults to execute-api (API Gateway).
+type SigV4Signing struct {
+	AccessKeyID     string `json:"accessKeyId"`
+	SecretAccessKey string `json:"secretAccessKey"`
+	SessionToken    string `json:"sessionToken,omitempty"`
+	Region          string `json:"region"`
+	Service         string `json:"service,omitempty"`
+}
+
+// GraphQLCheckerRequest is an HTTP check whose body is a GraphQL operation.
+// The request is always sent as a JSON POST, and jsonBody assertions are
+// evaluated against the `data` object of the response.
+type GraphQLCheckerRequest struct {
+	HttpCheckerRequest
+	Query         string          `json:"query"`
+	Variables     json.RawMessage `json:"variables,omitempty"`
+	OperationName string          `json:"operationName,omitempty"`
+}
+
 type TCPCheckerRequest struct {
 	Status        string            `json:"status"`
 	WorkspaceID   string            `json:"workspaceId"`
@@ -102,12 +164,23 @@ type TCPCheckerRequest struct {
 	Timeout       int64             `json:"timeout"`
 	DegradedAfter int64             `json:"degradedAfter,omitempty"`
 	Retry         int64             `json:"retry,omitempty"`
-	OtelConfig    struct {
+	StatusPolicy
+	OtelConfig struct {
 		Endpoint string            `json:"endpoint"`
 		Headers  map[string]string `json:"headers,omitempty"`
 	} `json:"otelConfig"`
 }
 
+// MQTTCheckerRequest is a TCP check that publishes to Topic on the broker
+// at URI (host:port) and waits to receive the message back.
+type MQTTCheckerRequest struct {
+	TCPCheckerRequest
+	Topic    string `json:"topic,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	TLS      bool   `json:"tls,omitempty"`
+}
+
 type TCPRequest struct {
 	WorkspaceID   string `json:"workspaceId"`
 	URL           string `json:"url"`
@@ -137,7 +210,8 @@ type DNSCheckerRequest struct {
 	Timeout       int64             `json:"timeout"`
 	DegradedAfter int64             `json:"degradedAfter,omitempty"`
 	Retry         int64             `json:"retry,omitempty"`
-	OtelConfig    struct {
+	StatusPolicy
+	OtelConfig struct {
 		Endpoint string            `json:"endpoint"`
 		Headers  map[string]string `json:"headers,omitempty"`
 	} `json:"otelConfig"`
@@ -10,6 +10,19 @@ const (
 	JobTypeUDP  JobType = "udp"
 	JobTypeHTTP JobType = "http"
 	JobTypeDNS  JobType = "dns"
+
+	JobTypeGraphQL   JobType = "graphql"
+	JobTypeHeartbeat JobType = "heartbeat"
+	JobTypePostgres  JobType = "postgres"
+	JobTypeMySQL     JobType = "mysql"
+	JobTypeRedis     JobType = "redis"
+	JobTypeSMTP      JobType = "smtp"
+	JobTypeIMAP      JobType = "imap"
+	JobTypePOP3      JobType = "pop3"
+	JobTypeSSH       JobType = "ssh"
+	JobTypeMQTT      JobType = "mqtt"
+	JobTypeNTP       JobType = "ntp"
+	JobTypeDomain    JobType = "domain"
 )
 
 type Monitor struct {
@@ -27,8 +40,12 @@ type Monitor struct {
 	Assertions      sql.NullString `db:"assertions"`
 	Retry           int            `db:"retry"`
 	FollowRedirects bool           `db:"follow_redirects"`
+	Auth            sql.NullString `db:"auth" json:"-"`
+	Secret          sql.NullString `db:"secret" json:"-"`
+	RdapBaseURL     sql.NullString `db:"rdap_base_url" json:"-"`
 	OtelEndpoint    sql.NullString `db:"otel_endpoint" json:"-"`
 	OtelHeaders     sql.NullString `db:"otel_headers" json:"-"`
+	OtelTraces      sql.NullBool   `db:"otel_traces" json:"-"`
 	Name            string         `db:"name" json:"-"`
 	ExternalName    sql.NullString `db:"external_name" json:"-"`
 	Description     string         `db:"description" json:"-"`
@@ -44,3 +61,61 @@ type PrivateLocation struct {
 	ID   int    `db:"id"`
 	Name string `db:"name"`
 }
+
+// HeartbeatState tracks whether a push monitor is currently considered alive.
+type HeartbeatState string
+
+const (
+	HeartbeatStateNew  HeartbeatState = "new"
+	HeartbeatStateUp   HeartbeatState = "up"
+	HeartbeatStateDown HeartbeatState = "down"
+)
+
+type Heartbeat struct {
+	MonitorID    int            `db:"monitor_id"`
+	Token        string         `db:"token"`
+	GraceSeconds int64          `db:"grace_seconds"`
+	State        HeartbeatState `db:"state"`
+	LastPingAt   sql.NullInt64  `db:"last_ping_at"`
+	RunStartedAt sql.NullInt64  `db:"run_started_at"`
+	CreatedAt    int64          `db:"created_at"`
+}
+
+// MonitorStatus is the status of a monitor at a private location, as decided
+// by its status policy. ChangedAt, in unix milliseconds, is when it took that
+// status.
+type MonitorStatus struct {
+	MonitorID         int    `db:"monitor_id" json:"monitorId"`
+	PrivateLocationID int    `db:"private_location_id" json:"privateLocationId"`
+	Status            string `db:"status" json:"status"`
+	PreviousStatus    string `db:"previous_status" json:"previousStatus,omitempty"`
+	ChangedAt         int64  `db:"changed_at" json:"changedAt"`
+	Flapping          bool   `db:"flapping" json:"flapping"`
+	// ObservedAt, in unix milliseconds, is when the location last reported
+	// a result.
+	ObservedAt int64 `db:"observed_at" json:"observedAt,omitempty"`
+
+	// ReportedStatus is the status last sent to workflows.
+	ReportedStatus       string `db:"reported_status" json:"-"`
+	ConsecutiveFailures  int    `db:"consecutive_failures" json:"-"`
+	ConsecutiveSuccesses int    `db:"consecutive_successes" json:"-"`
+	FlapCount            int    `db:"flap_count" json:"-"`
+	// FlapWindowStart is in unix milliseconds.
+	FlapWindowStart int64 `db:"flap_window_start" json:"-"`
+}
+
+// StatusPolicy holds the per-monitor settings of statuspolicy.Policy.
+type StatusPolicy struct {
+	FailureThreshold  int   `db:"failure_threshold"`
+	RecoveryThreshold int   `db:"recovery_threshold"`
+	FlapThreshold     int   `db:"flap_threshold"`
+	FlapWindowSeconds int64 `db:"flap_window_seconds"`
+}
+
+// QuorumPolicy holds the per-monitor settings of statuspolicy.Quorum. An
+// empty Quorum reports every location on its own.
+type QuorumPolicy struct {
+	Quorum              string `db:"quorum"`
+	QuorumCount         int    `db:"quorum_count"`
+	QuorumWindowSeconds int64  `db:"quorum_window_seconds"`
+}
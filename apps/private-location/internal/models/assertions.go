@@ -5,11 +5,19 @@ import "encoding/json"
 type AssertionType string
 
 const (
-	AssertionHeader    AssertionType = "header"
-	AssertionTextBody  AssertionType = "textBody"
-	AssertionStatus    AssertionType = "status"
-	AssertionJsonBody  AssertionType = "jsonBody"
-	AssertionDnsRecord AssertionType = "dnsRecord"
+	AssertionHeader     AssertionType = "header"
+	AssertionTextBody   AssertionType = "textBody"
+	AssertionStatus     AssertionType = "status"
+	AssertionJsonBody   AssertionType = "jsonBody"
+	AssertionDnsRecord  AssertionType = "dnsRecord"
+	AssertionRowCount   AssertionType = "rowCount"
+	AssertionValue      AssertionType = "value"
+	AssertionReplyCode  AssertionType = "replyCode"
+	AssertionCapability AssertionType = "capability"
+	AssertionOffset     AssertionType = "offset"
+	AssertionStratum    AssertionType = "stratum"
+	AssertionExpiry     AssertionType = "expiry"
+	AssertionEPPStatus  AssertionType = "eppStatus"
 )
 
 type StringComparator string
@@ -76,6 +84,22 @@ type BodyString struct {
 	Target        string           `json:"target"`
 }
 
+// JsonBodyTarget asserts on the value found at a JSONPath such as
+// `$.user.name` or `$.items[0].id`.
+type JsonBodyTarget struct {
+	AssertionType AssertionType    `json:"type"`
+	Comparator    StringComparator `json:"compare"`
+	Path          string           `json:"path"`
+	Target        string           `json:"target"`
+}
+
+// RowCountTarget asserts on the number of rows a database query returned.
+type RowCountTarget struct {
+	AssertionType AssertionType    `json:"type"`
+	Comparator    NumberComparator `json:"compare"`
+	Target        int64            `json:"target"`
+}
+
 type RecordComparator string
 
 const (
@@ -0,0 +1,300 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+
+	"github.com/openstatushq/openstatus/apps/private-location/internal/database"
+	"github.com/openstatushq/openstatus/apps/private-location/internal/workflows"
+)
+
+// Check-in signals accepted on /heartbeat/{token}/{signal}. A bare
+// /heartbeat/{token} is a success.
+const (
+	heartbeatSuccess = "success"
+	heartbeatStart   = "start"
+	heartbeatFail    = "fail"
+)
+
+const maxHeartbeatMessage = 1024
+
+const heartbeatPathPrefix = "/heartbeat/"
+
+// redactHeartbeatToken hides the token of a /heartbeat/{token} path, keeping
+// the signal and query.
+func redactHeartbeatToken(path string) string {
+	rest, ok := strings.CutPrefix(path, heartbeatPathPrefix)
+	if !ok || rest == "" {
+		return path
+	}
+	end := strings.IndexAny(rest, "/?")
+	if end < 0 {
+		end = len(rest)
+	}
+	return heartbeatPathPrefix + "REDACTED" + rest[end:]
+}
+
+// ensureHeartbeats gives every heartbeat monitor a ping token. The dashboard
+// reads the token back from monitor_heartbeat to show the ping URL.
+func (h *privateLocationHandler) ensureHeartbeats(ctx context.Context) error {
+	_, err := h.db.ExecContext(ctx, `INSERT INTO monitor_heartbeat (monitor_id, token, created_at)
+		SELECT monitor.id, lower(hex(randomblob(16))), strftime('%s', 'now') FROM monitor
+		WHERE monitor.job_type = ? AND monitor.deleted_at IS NULL
+		AND NOT EXISTS (SELECT 1 FROM monitor_heartbeat WHERE monitor_heartbeat.monitor_id = monitor.id)`,
+		database.JobTypeHeartbeat)
+	return err
+}
+
+// heartbeatHandler records a check-in for the monitor owning the token.
+func (h *privateLocationHandler) heartbeatHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	now := time.Now()
+
+	signal := chi.URLParam(r, "signal")
+	if signal == "" {
+		signal = heartbeatSuccess
+	}
+	if signal != heartbeatSuccess && signal != heartbeatStart && signal != heartbeatFail {
+		render.Status(r, http.StatusNotFound)
+		render.PlainText(w, r, "unknown signal")
+		return
+	}
+
+	var hb database.Heartbeat
+	err := h.db.GetContext(ctx, &hb, `SELECT monitor_heartbeat.monitor_id, monitor_heartbeat.token, monitor_heartbeat.grace_seconds, monitor_heartbeat.state, monitor_heartbeat.last_ping_at, monitor_heartbeat.run_started_at, monitor_heartbeat.created_at
+		FROM monitor_heartbeat JOIN monitor ON monitor.id = monitor_heartbeat.monitor_id
+		WHERE monitor_heartbeat.token = ? AND monitor.job_type = ? AND monitor.deleted_at IS NULL AND monitor.active = 1`,
+		chi.URLParam(r, "token"), database.JobTypeHeartbeat)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			render.Status(r, http.StatusNotFound)
+			render.PlainText(w, r, "not found")
+			return
+		}
+		if holder := GetEvent(ctx); holder != nil {
+			holder.Event["error"] = map[string]any{
+				"message": err.Error(),
+				"source":  "database",
+				"type":    "heartbeat_lookup",
+			}
+		}
+		render.Status(r, http.StatusInternalServerError)
+		render.PlainText(w, r, "internal error")
+		return
+	}
+
+	message := heartbeatMessage(r)
+	duration, hasDuration := heartbeatDuration(r, hb, signal, now)
+
+	if holder := GetEvent(ctx); holder != nil {
+		holder.Event["heartbeat"] = map[string]any{
+			"monitor_id": hb.MonitorID,
+			"signal":     signal,
+			"state":      string(hb.State),
+		}
+	}
+
+	if err := h.recordCheckIn(ctx, hb, signal, message, duration, hasDuration, now); err != nil {
+		if holder := GetEvent(ctx); holder != nil {
+			holder.Event["error"] = map[string]any{
+				"message": err.Error(),
+				"source":  "database",
+				"type":    "heartbeat_check_in",
+			}
+		}
+		render.Status(r, http.StatusInternalServerError)
+		render.PlainText(w, r, "internal error")
+		return
+	}
+
+	// Every finishing ping is reported, like every ingested check; only
+	// status changes reach workflows.
+	switch signal {
+	case heartbeatSuccess:
+		h.reportHeartbeat(ctx, hb.MonitorID, "active", message, duration, now)
+	case heartbeatFail:
+		if message == "" {
+			message = "Job reported a failure"
+		}
+		h.reportHeartbeat(ctx, hb.MonitorID, "error", message, duration, now)
+	}
+
+	render.PlainText(w, r, "OK")
+}
+
+func (h *privateLocationHandler) recordCheckIn(ctx context.Context, hb database.Heartbeat, signal, message string, duration int64, hasDuration bool, now time.Time) error {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("unable to generate check-in id: %w", err)
+	}
+
+	tx, err := h.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var durationMs sql.NullInt64
+	if hasDuration {
+		durationMs = sql.NullInt64{Int64: duration, Valid: true}
+	}
+	if _, err := tx.ExecContext(ctx, "INSERT INTO heartbeat_check_in (id, monitor_id, kind, duration_ms, message, created_at) VALUES (?, ?, ?, ?, ?, ?)",
+		id.String(), hb.MonitorID, signal, durationMs, message, now.Unix()); err != nil {
+		return err
+	}
+
+	switch signal {
+	case heartbeatStart:
+		_, err = tx.ExecContext(ctx, "UPDATE monitor_heartbeat SET run_started_at = ? WHERE monitor_id = ?", now.Unix(), hb.MonitorID)
+	case heartbeatSuccess:
+		_, err = tx.ExecContext(ctx, "UPDATE monitor_heartbeat SET state = ?, last_ping_at = ?, run_started_at = NULL WHERE monitor_id = ?", database.HeartbeatStateUp, now.Unix(), hb.MonitorID)
+	case heartbeatFail:
+		// A failing job is still alive: moving last_ping_at keeps the sweeper
+		// from reporting a second, less precise outage for the same run.
+		_, err = tx.ExecContext(ctx, "UPDATE monitor_heartbeat SET state = ?, last_ping_at = ?, run_started_at = NULL WHERE monitor_id = ?", database.HeartbeatStateDown, now.Unix(), hb.MonitorID)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// heartbeatMessage reads an optional message from the `msg` query parameter
+// or the request body, truncated so a chatty job cannot bloat the table.
+func heartbeatMessage(r *http.Request) string {
+	message := r.URL.Query().Get("msg")
+	if message == "" && r.Body != nil {
+		body, _ := io.ReadAll(io.LimitReader(r.Body, maxHeartbeatMessage))
+		message = string(body)
+	}
+	message = strings.TrimSpace(message)
+	if len(message) > maxHeartbeatMessage {
+		message = message[:maxHeartbeatMessage]
+	}
+	return message
+}
+
+// heartbeatDuration prefers an explicit `duration` (ms) and otherwise
+// measures the run from its start signal.
+func heartbeatDuration(r *http.Request, hb database.Heartbeat, signal string, now time.Time) (int64, bool) {
+	if raw := r.URL.Query().Get("duration"); raw != "" {
+		if d, err := strconv.ParseInt(raw, 10, 64); err == nil && d >= 0 {
+			return d, true
+		}
+	}
+	if signal != heartbeatStart && hb.RunStartedAt.Valid {
+		return now.Sub(time.Unix(hb.RunStartedAt.Int64, 0)).Milliseconds(), true
+	}
+	return 0, false
+}
+
+// reportHeartbeat reports the status for every private location the monitor
+// is assigned to, the same way ingested check results are reported.
+func (h *privateLocationHandler) reportHeartbeat(ctx context.Context, monitorID int, status, message string, latency int64, now time.Time) {
+	var locationIDs []int
+	if err := h.db.SelectContext(ctx, &locationIDs, "SELECT private_location_id FROM private_location_to_monitor WHERE monitor_id = ? AND deleted_at IS NULL", monitorID); err != nil {
+		slog.Error("failed to load private locations for heartbeat", "monitor_id", monitorID, "error", err.Error())
+		return
+	}
+
+	for _, locationID := range locationIDs {
+		changes := h.statusChanges(ctx, monitorID, locationID, status, now)
+		if h.WorkflowsClient == nil {
+			continue
+		}
+		for _, change := range changes {
+			h.report(ctx, monitorID, change, workflows.Payload{
+				MonitorID:         strconv.Itoa(monitorID),
+				PrivateLocationID: strconv.Itoa(change.LocationID),
+				Status:            change.Status,
+				Message:           message,
+				CronTimestamp:     now.UnixMilli(),
+				Latency:           latency,
+			})
+		}
+	}
+}
+
+type overdueHeartbeat struct {
+	database.Heartbeat
+	Periodicity string `db:"periodicity"`
+}
+
+// sweepHeartbeats marks every heartbeat whose last ping is older than its
+// period plus grace as down and reports the outage once.
+func (h *privateLocationHandler) sweepHeartbeats(ctx context.Context, now time.Time) error {
+	if err := h.ensureHeartbeats(ctx); err != nil {
+		return fmt.Errorf("unable to provision heartbeats: %w", err)
+	}
+
+	var heartbeats []overdueHeartbeat
+	err := h.db.SelectContext(ctx, &heartbeats, `SELECT monitor_heartbeat.monitor_id, monitor_heartbeat.token, monitor_heartbeat.grace_seconds, monitor_heartbeat.state, monitor_heartbeat.last_ping_at, monitor_heartbeat.run_started_at, monitor_heartbeat.created_at, monitor.periodicity
+		FROM monitor_heartbeat JOIN monitor ON monitor.id = monitor_heartbeat.monitor_id
+		WHERE monitor_heartbeat.state != ? AND monitor.job_type = ? AND monitor.deleted_at IS NULL AND monitor.active = 1`,
+		database.HeartbeatStateDown, database.JobTypeHeartbeat)
+	if err != nil {
+		return fmt.Errorf("unable to load heartbeats: %w", err)
+	}
+
+	for _, hb := range heartbeats {
+		period, err := time.ParseDuration(hb.Periodicity)
+		if err != nil {
+			continue
+		}
+
+		lastSeen := hb.CreatedAt
+		if hb.LastPingAt.Valid {
+			lastSeen = hb.LastPingAt.Int64
+		}
+		deadline := time.Unix(lastSeen, 0).Add(period + time.Duration(hb.GraceSeconds)*time.Second)
+		if !now.After(deadline) {
+			continue
+		}
+
+		// Only the instance that flips the state reports, and a ping that
+		// landed since the select wins.
+		res, err := h.db.ExecContext(ctx, "UPDATE monitor_heartbeat SET state = ? WHERE monitor_id = ? AND state != ? AND COALESCE(last_ping_at, 0) = ?",
+			database.HeartbeatStateDown, hb.MonitorID, database.HeartbeatStateDown, hb.LastPingAt.Int64)
+		if err != nil {
+			return fmt.Errorf("unable to mark heartbeat %d as down: %w", hb.MonitorID, err)
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			continue
+		}
+
+		message := fmt.Sprintf("No heartbeat received within %s (grace %ds)", hb.Periodicity, hb.GraceSeconds)
+		h.reportHeartbeat(ctx, hb.MonitorID, "error", message, 0, now)
+	}
+
+	return nil
+}
+
+// runHeartbeatSweeper sweeps on every tick until ctx is cancelled.
+func (h *privateLocationHandler) runHeartbeatSweeper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := h.sweepHeartbeats(ctx, now); err != nil {
+				slog.Error("heartbeat sweep failed", "error", err.Error())
+			}
+		}
+	}
+}
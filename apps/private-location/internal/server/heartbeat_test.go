@@ -0,0 +1,200 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/private-location/internal/database"
+	"github.com/openstatushq/openstatus/apps/private-location/internal/workflows"
+)
+
+// heartbeatMonitorID is the heartbeat monitor seeded in db_testdata.
+const heartbeatMonitorID = 9
+
+func heartbeatTestHandler(t *testing.T) (*privateLocationHandler, chan workflows.Payload) {
+	t.Helper()
+	db, err := sqlx.Connect("sqlite3", t.TempDir()+"/db")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	dat, err := os.ReadFile("./db_testdata")
+	require.NoError(t, err)
+	db.MustExec(string(dat))
+
+	called := make(chan workflows.Payload, 10)
+	h := &privateLocationHandler{db: db, WorkflowsClient: recordingWorkflowsClient{called: called}}
+	require.NoError(t, h.ensureHeartbeats(context.Background()))
+
+	return h, called
+}
+
+func heartbeatToken(t *testing.T, h *privateLocationHandler) string {
+	t.Helper()
+	var token string
+	require.NoError(t, h.db.Get(&token, "SELECT token FROM monitor_heartbeat WHERE monitor_id = ?", heartbeatMonitorID))
+	require.NotEmpty(t, token)
+	return token
+}
+
+func heartbeatRouter(h *privateLocationHandler) http.Handler {
+	r := chi.NewRouter()
+	r.Get("/heartbeat/{token}", h.heartbeatHandler)
+	r.Post("/heartbeat/{token}", h.heartbeatHandler)
+	r.Get("/heartbeat/{token}/{signal}", h.heartbeatHandler)
+	r.Post("/heartbeat/{token}/{signal}", h.heartbeatHandler)
+	return r
+}
+
+func waitForPayload(t *testing.T, called chan workflows.Payload) workflows.Payload {
+	t.Helper()
+	select {
+	case p := <-called:
+		return p
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected a status report")
+		return workflows.Payload{}
+	}
+}
+
+func TestEnsureHeartbeatsIsIdempotent(t *testing.T) {
+	h, _ := heartbeatTestHandler(t)
+	token := heartbeatToken(t, h)
+
+	require.NoError(t, h.ensureHeartbeats(context.Background()))
+	assert.Equal(t, token, heartbeatToken(t, h))
+}
+
+func TestHeartbeatHandler_SuccessReportsActive(t *testing.T) {
+	h, called := heartbeatTestHandler(t)
+	token := heartbeatToken(t, h)
+
+	w := httptest.NewRecorder()
+	heartbeatRouter(h).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/heartbeat/"+token, nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	payload := waitForPayload(t, called)
+	assert.Equal(t, "9", payload.MonitorID)
+	assert.Equal(t, "1", payload.PrivateLocationID)
+	assert.Equal(t, "active", payload.Status)
+
+	var hb database.Heartbeat
+	require.NoError(t, h.db.Get(&hb, "SELECT * FROM monitor_heartbeat WHERE monitor_id = ?", heartbeatMonitorID))
+	assert.Equal(t, database.HeartbeatStateUp, hb.State)
+	assert.True(t, hb.LastPingAt.Valid)
+}
+
+func TestHeartbeatHandler_StartThenFailRecordsDuration(t *testing.T) {
+	h, called := heartbeatTestHandler(t)
+	token := heartbeatToken(t, h)
+	router := heartbeatRouter(h)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/heartbeat/"+token+"/start", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	// Pretend the run started a minute ago.
+	h.db.MustExec("UPDATE monitor_heartbeat SET run_started_at = ? WHERE monitor_id = ?", time.Now().Add(-time.Minute).Unix(), heartbeatMonitorID)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/heartbeat/"+token+"/fail", strings.NewReader("disk full")))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	payload := waitForPayload(t, called)
+	assert.Equal(t, "error", payload.Status)
+	assert.Equal(t, "disk full", payload.Message)
+	assert.GreaterOrEqual(t, payload.Latency, int64(60000))
+
+	var kinds []string
+	require.NoError(t, h.db.Select(&kinds, "SELECT kind FROM heartbeat_check_in WHERE monitor_id = ? ORDER BY id", heartbeatMonitorID))
+	assert.Equal(t, []string{"start", "fail"}, kinds)
+
+	var duration int64
+	require.NoError(t, h.db.Get(&duration, "SELECT duration_ms FROM heartbeat_check_in WHERE kind = 'fail'"))
+	assert.GreaterOrEqual(t, duration, int64(60000))
+}
+
+func TestHeartbeatHandler_NotFound(t *testing.T) {
+	h, _ := heartbeatTestHandler(t)
+	router := heartbeatRouter(h)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/heartbeat/unknown", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/heartbeat/"+heartbeatToken(t, h)+"/reboot", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestSweepHeartbeats_ReportsMissedPingOnce(t *testing.T) {
+	h, called := heartbeatTestHandler(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	// Within period + grace: nothing to report.
+	h.db.MustExec("UPDATE monitor_heartbeat SET last_ping_at = ? WHERE monitor_id = ?", now.Add(-30*time.Minute).Unix(), heartbeatMonitorID)
+	require.NoError(t, h.sweepHeartbeats(ctx, now))
+	select {
+	case p := <-called:
+		t.Fatalf("unexpected report %v", p)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	h.db.MustExec("UPDATE monitor_heartbeat SET last_ping_at = ? WHERE monitor_id = ?", now.Add(-2*time.Hour).Unix(), heartbeatMonitorID)
+	require.NoError(t, h.sweepHeartbeats(ctx, now))
+	payload := waitForPayload(t, called)
+	assert.Equal(t, "error", payload.Status)
+	assert.Contains(t, payload.Message, "No heartbeat received")
+
+	// Already down: the next sweep stays quiet.
+	require.NoError(t, h.sweepHeartbeats(ctx, now))
+	select {
+	case p := <-called:
+		t.Fatalf("unexpected second report %v", p)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestSweepHeartbeats_UsesConfiguredGrace(t *testing.T) {
+	h, called := heartbeatTestHandler(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	// The platform allows an hour of grace on top of the hourly period.
+	h.db.MustExec("UPDATE monitor_heartbeat SET grace_seconds = 3600, last_ping_at = ? WHERE monitor_id = ?", now.Add(-90*time.Minute).Unix(), heartbeatMonitorID)
+	require.NoError(t, h.sweepHeartbeats(ctx, now))
+	select {
+	case p := <-called:
+		t.Fatalf("unexpected report %v", p)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.NoError(t, h.sweepHeartbeats(ctx, now.Add(time.Hour)))
+	assert.Contains(t, waitForPayload(t, called).Message, "grace 3600s")
+}
+
+func TestRedactHeartbeatToken(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/heartbeat/abc123", "/heartbeat/REDACTED"},
+		{"/heartbeat/abc123/fail", "/heartbeat/REDACTED/fail"},
+		{"/heartbeat/abc123?msg=done", "/heartbeat/REDACTED?msg=done"},
+		{"/heartbeat/", "/heartbeat/"},
+		{"/v1/monitors", "/v1/monitors"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, redactHeartbeatToken(tt.path), tt.path)
+	}
+}
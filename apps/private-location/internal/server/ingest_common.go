@@ -7,6 +7,14 @@ import (
 	"github.com/openstatushq/openstatus/apps/private-location/internal/database"
 )
 
+// Tinybird datasources of the ingested results, also used as the table names
+// of the other sinks.
+const (
+	datasourceHTTP = "ping_response__v8"
+	datasourceTCP  = "tcp_response__v0"
+	datasourceDNS  = "dns_response__v0"
+)
+
 // ingestContext holds common data needed for ingestion
 type ingestContext struct {
 	Monitor  database.Monitor
@@ -47,10 +55,10 @@ func (h *privateLocationHandler) getIngestContext(ctx context.Context, token str
 	}, nil
 }
 
-// sendEventAndUpdateLastSeen sends the event to Tinybird and updates the last_seen_at timestamp
+// sendEventAndUpdateLastSeen sends the event to the result sink and updates the last_seen_at timestamp
 func (h *privateLocationHandler) sendEventAndUpdateLastSeen(ctx context.Context, data any, dataSourceName string, regionID int) {
 	start := time.Now()
-	err := h.TbClient.SendEvent(ctx, data, dataSourceName)
+	err := h.Sink.SendEvent(ctx, data, dataSourceName)
 	duration := time.Since(start).Milliseconds()
 
 	// Enrich wide event with Tinybird operation context
@@ -6,7 +6,6 @@ import (
 	"strconv"
 
 	"connectrpc.com/connect"
-	"github.com/openstatushq/openstatus/apps/private-location/internal/tinybird"
 	private_locationv1 "github.com/openstatushq/openstatus/apps/private-location/proto/private_location/v1"
 )
 
@@ -18,6 +17,7 @@ type DNSResponse struct {
 	Trigger       string `json:"trigger"`
 	URI           string `json:"uri"`
 	RequestStatus string `json:"requestStatus,omitempty"`
+	ErrorCode     string `json:"errorCode,omitempty"`
 	// JSON-encoded map so Tinybird stores it in the single `records` String
 	// column instead of auto-flattening into quarantined records_* columns.
 	Records string `json:"records"`
@@ -53,7 +53,7 @@ func (h *privateLocationHandler) IngestDNS(ctx context.Context, req *connect.Req
 			"monitor_id":   req.Msg.MonitorId,
 			"workspace_id": ic.Monitor.WorkspaceID,
 			"region_id":    ic.Region.ID,
-			"datasource":   tinybird.DatasourceDNS,
+			"datasource":   datasourceDNS,
 		}
 	}
 
@@ -84,9 +84,10 @@ func (h *privateLocationHandler) IngestDNS(ctx context.Context, req *connect.Req
 		RequestStatus: req.Msg.RequestStatus,
 		Records:       string(recordsJSON),
 		ErrorMessage:  req.Msg.Message,
+		ErrorCode:     req.Msg.ErrorCode,
 	}
 
-	h.sendEventAndUpdateLastSeen(ctx, data, tinybird.DatasourceDNS, ic.Region.ID)
+	h.sendEventAndUpdateLastSeen(ctx, data, datasourceDNS, ic.Region.ID)
 
 	h.forwardStatusUpdate(ctx, ic, statusUpdateInput{
 		RequestStatus: data.RequestStatus,
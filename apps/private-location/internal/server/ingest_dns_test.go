@@ -9,8 +9,8 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/tinybird"
 	"github.com/openstatushq/openstatus/apps/private-location/internal/server"
-	"github.com/openstatushq/openstatus/apps/private-location/internal/tinybird"
 	"github.com/openstatushq/openstatus/apps/private-location/internal/workflows"
 	private_locationv1 "github.com/openstatushq/openstatus/apps/private-location/proto/private_location/v1"
 	"github.com/stretchr/testify/require"
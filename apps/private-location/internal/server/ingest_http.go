@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"connectrpc.com/connect"
-	"github.com/openstatushq/openstatus/apps/private-location/internal/tinybird"
 	private_locationv1 "github.com/openstatushq/openstatus/apps/private-location/proto/private_location/v1"
 )
 
@@ -32,6 +31,9 @@ type PingData struct {
 	Timestamp     int64  `json:"timestamp"`
 	StatusCode    int    `json:"statusCode,omitempty"`
 	Error         uint8  `json:"error"`
+	Diagnosis     string `json:"diagnosis,omitempty"`
+	ErrorCode     string `json:"errorCode,omitempty"`
+	TraceID       string `json:"traceId,omitempty"`
 }
 
 func (h *privateLocationHandler) IngestHTTP(ctx context.Context, req *connect.Request[private_locationv1.IngestHTTPRequest]) (*connect.Response[private_locationv1.IngestHTTPResponse], error) {
@@ -55,7 +57,7 @@ func (h *privateLocationHandler) IngestHTTP(ctx context.Context, req *connect.Re
 			"monitor_id":   req.Msg.MonitorId,
 			"workspace_id": ic.Monitor.WorkspaceID,
 			"region_id":    ic.Region.ID,
-			"datasource":   tinybird.DatasourceHTTP,
+			"datasource":   datasourceHTTP,
 		}
 	}
 
@@ -78,9 +80,12 @@ func (h *privateLocationHandler) IngestHTTP(ctx context.Context, req *connect.Re
 		Assertions:    ic.Monitor.Assertions.String,
 		Message:       req.Msg.Message,
 		Error:         uint8(req.Msg.Error),
+		Diagnosis:     req.Msg.Diagnosis,
+		ErrorCode:     req.Msg.ErrorCode,
+		TraceID:       req.Msg.TraceId,
 	}
 
-	h.sendEventAndUpdateLastSeen(ctx, data, tinybird.DatasourceHTTP, ic.Region.ID)
+	h.sendEventAndUpdateLastSeen(ctx, data, datasourceHTTP, ic.Region.ID)
 
 	h.forwardStatusUpdate(ctx, ic, statusUpdateInput{
 		RequestStatus: data.RequestStatus,
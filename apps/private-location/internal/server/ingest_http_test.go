@@ -13,8 +13,8 @@ import (
 	"connectrpc.com/connect"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/tinybird"
 	"github.com/openstatushq/openstatus/apps/private-location/internal/server"
-	"github.com/openstatushq/openstatus/apps/private-location/internal/tinybird"
 	"github.com/openstatushq/openstatus/apps/private-location/internal/workflows"
 	private_locationv1 "github.com/openstatushq/openstatus/apps/private-location/proto/private_location/v1"
 	"github.com/stretchr/testify/require"
@@ -256,6 +256,9 @@ func TestIngestHTTP_ForwardsErrorAndMessage(t *testing.T) {
 		StatusCode:    500,
 		Error:         1,
 		Message:       message,
+		Diagnosis:     `{"host":"example.com","dns":"ok","summary":"DNS ok (1 IP), TCP ok on 1 IP"}`,
+		ErrorCode:     "http_status",
+		TraceId:       "4bf92f3577b34da6a3ce929d0e0e4736",
 	})
 	req.Header().Set("openstatus-token", "my-secret-key")
 
@@ -263,12 +266,18 @@ func TestIngestHTTP_ForwardsErrorAndMessage(t *testing.T) {
 	require.NoError(t, err)
 
 	var event struct {
-		Error   uint8  `json:"error"`
-		Message string `json:"message"`
+		Error     uint8  `json:"error"`
+		Message   string `json:"message"`
+		Diagnosis string `json:"diagnosis"`
+		ErrorCode string `json:"errorCode"`
+		TraceID   string `json:"traceId"`
 	}
 	require.NoError(t, json.Unmarshal(capturedBody, &event))
 	require.Equal(t, uint8(1), event.Error)
 	require.Equal(t, message, event.Message)
+	require.Equal(t, req.Msg.Diagnosis, event.Diagnosis)
+	require.Equal(t, "http_status", event.ErrorCode)
+	require.Equal(t, req.Msg.TraceId, event.TraceID)
 
 	select {
 	case payload := <-workflowsClient.called:
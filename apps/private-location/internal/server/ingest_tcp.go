@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"connectrpc.com/connect"
-	"github.com/openstatushq/openstatus/apps/private-location/internal/tinybird"
 	private_locationv1 "github.com/openstatushq/openstatus/apps/private-location/proto/private_location/v1"
 )
 
@@ -17,6 +16,7 @@ type TCPData struct {
 	Trigger       string `json:"trigger"`
 	URI           string `json:"uri"`
 	RequestStatus string `json:"requestStatus,omitempty"`
+	ErrorCode     string `json:"errorCode,omitempty"`
 
 	RequestId     int64 `json:"requestId,omitempty"`
 	WorkspaceID   int64 `json:"workspaceId"`
@@ -49,7 +49,7 @@ func (h *privateLocationHandler) IngestTCP(ctx context.Context, req *connect.Req
 			"monitor_id":   req.Msg.MonitorId,
 			"workspace_id": ic.Monitor.WorkspaceID,
 			"region_id":    ic.Region.ID,
-			"datasource":   tinybird.DatasourceTCP,
+			"datasource":   datasourceTCP,
 		}
 	}
 
@@ -67,9 +67,10 @@ func (h *privateLocationHandler) IngestTCP(ctx context.Context, req *connect.Req
 		URI:           req.Msg.Uri,
 		RequestStatus: req.Msg.RequestStatus,
 		ErrorMessage:  req.Msg.Message,
+		ErrorCode:     req.Msg.ErrorCode,
 	}
 
-	h.sendEventAndUpdateLastSeen(ctx, data, tinybird.DatasourceTCP, ic.Region.ID)
+	h.sendEventAndUpdateLastSeen(ctx, data, datasourceTCP, ic.Region.ID)
 
 	h.forwardStatusUpdate(ctx, ic, statusUpdateInput{
 		RequestStatus: data.RequestStatus,
@@ -5,7 +5,12 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 
 	"connectrpc.com/connect"
 	"github.com/openstatushq/openstatus/apps/private-location/internal/database"
@@ -174,6 +179,157 @@ func ParseRecordAssertions(ctx context.Context, assertions sql.NullString) []*pr
 	return recordAssertions
 }
 
+// assertionCollectors maps an assertion type to the function decoding it.
+type assertionCollectors map[models.AssertionType]func(json.RawMessage)
+
+// parseAssertionsWith decodes the stored assertions and hands each one to the
+// collector of its type; other types are skipped. kind prefixes the parse
+// errors recorded in the wide event.
+func parseAssertionsWith(ctx context.Context, assertions sql.NullString, kind string, collectors assertionCollectors) {
+	if !assertions.Valid {
+		return
+	}
+	var rawAssertions []json.RawMessage
+	if err := json.Unmarshal([]byte(assertions.String), &rawAssertions); err != nil {
+		addParseError(ctx, kind+"_assertions_unmarshal", err)
+		return
+	}
+	for _, a := range rawAssertions {
+		var assert models.Assertion
+		if err := json.Unmarshal(a, &assert); err != nil {
+			addParseError(ctx, kind+"_assertion_unmarshal", err)
+			continue
+		}
+		if collect, ok := collectors[assert.AssertionType]; ok {
+			collect(a)
+		}
+	}
+}
+
+// collectAssertion returns a collector that decodes an assertion into T and
+// appends its proto form to out.
+func collectAssertion[T, P any](ctx context.Context, errorType string, out *[]P, convert func(T) P) func(json.RawMessage) {
+	return func(raw json.RawMessage) {
+		var target T
+		if err := json.Unmarshal(raw, &target); err != nil {
+			addParseError(ctx, errorType, err)
+			return
+		}
+		*out = append(*out, convert(target))
+	}
+}
+
+// Helper to parse jsonBody assertions, used by GraphQL monitors against `data`
+func ParseJsonBodyAssertions(ctx context.Context, assertions sql.NullString) (jsonBodyAssertions []*private_locationv1.JsonBodyAssertion) {
+	parseAssertionsWith(ctx, assertions, "json_body", assertionCollectors{
+		models.AssertionJsonBody: collectAssertion(ctx, "json_body_target_unmarshal", &jsonBodyAssertions,
+			func(target models.JsonBodyTarget) *private_locationv1.JsonBodyAssertion {
+				return &private_locationv1.JsonBodyAssertion{
+					Path:       target.Path,
+					Comparator: convertStringComparator(target.Comparator),
+					Target:     target.Target,
+				}
+			}),
+	})
+	return jsonBodyAssertions
+}
+
+// Helper to parse database row count and value assertions
+func ParseDatabaseAssertions(ctx context.Context, assertions sql.NullString) (
+	rowCountAssertions []*private_locationv1.RowCountAssertion,
+	valueAssertions []*private_locationv1.ValueAssertion,
+) {
+	parseAssertionsWith(ctx, assertions, "database", assertionCollectors{
+		models.AssertionRowCount: collectAssertion(ctx, "row_count_target_unmarshal", &rowCountAssertions,
+			func(target models.RowCountTarget) *private_locationv1.RowCountAssertion {
+				return &private_locationv1.RowCountAssertion{
+					Target:     target.Target,
+					Comparator: convertNumberComparator(target.Comparator),
+				}
+			}),
+		models.AssertionValue: collectAssertion(ctx, "value_target_unmarshal", &valueAssertions,
+			func(target models.BodyString) *private_locationv1.ValueAssertion {
+				return &private_locationv1.ValueAssertion{
+					Target:     target.Target,
+					Comparator: convertStringComparator(target.Comparator),
+				}
+			}),
+	})
+	return
+}
+
+// Helper to parse mail reply code and capability assertions
+func ParseMailAssertions(ctx context.Context, assertions sql.NullString) (
+	replyCodeAssertions []*private_locationv1.StatusCodeAssertion,
+	capabilityAssertions []*private_locationv1.ValueAssertion,
+) {
+	parseAssertionsWith(ctx, assertions, "mail", assertionCollectors{
+		models.AssertionReplyCode: collectAssertion(ctx, "reply_code_target_unmarshal", &replyCodeAssertions,
+			func(target models.StatusTarget) *private_locationv1.StatusCodeAssertion {
+				return &private_locationv1.StatusCodeAssertion{
+					Target:     target.Target,
+					Comparator: convertNumberComparator(target.Comparator),
+				}
+			}),
+		models.AssertionCapability: collectAssertion(ctx, "capability_target_unmarshal", &capabilityAssertions,
+			func(target models.BodyString) *private_locationv1.ValueAssertion {
+				return &private_locationv1.ValueAssertion{
+					Target:     target.Target,
+					Comparator: convertStringComparator(target.Comparator),
+				}
+			}),
+	})
+	return
+}
+
+// Helper to parse NTP clock offset (ms) and stratum assertions
+func ParseNTPAssertions(ctx context.Context, assertions sql.NullString) (
+	offsetAssertions []*private_locationv1.OffsetAssertion,
+	stratumAssertions []*private_locationv1.StratumAssertion,
+) {
+	parseAssertionsWith(ctx, assertions, "ntp", assertionCollectors{
+		models.AssertionOffset: collectAssertion(ctx, "offset_target_unmarshal", &offsetAssertions,
+			func(target models.StatusTarget) *private_locationv1.OffsetAssertion {
+				return &private_locationv1.OffsetAssertion{
+					Target:     target.Target,
+					Comparator: convertNumberComparator(target.Comparator),
+				}
+			}),
+		models.AssertionStratum: collectAssertion(ctx, "stratum_target_unmarshal", &stratumAssertions,
+			func(target models.StatusTarget) *private_locationv1.StratumAssertion {
+				return &private_locationv1.StratumAssertion{
+					Target:     target.Target,
+					Comparator: convertNumberComparator(target.Comparator),
+				}
+			}),
+	})
+	return
+}
+
+// Helper to parse domain expiry (days) and status assertions
+func ParseDomainAssertions(ctx context.Context, assertions sql.NullString) (
+	expiryAssertions []*private_locationv1.ExpiryAssertion,
+	statusAssertions []*private_locationv1.ValueAssertion,
+) {
+	parseAssertionsWith(ctx, assertions, "domain", assertionCollectors{
+		models.AssertionExpiry: collectAssertion(ctx, "expiry_target_unmarshal", &expiryAssertions,
+			func(target models.StatusTarget) *private_locationv1.ExpiryAssertion {
+				return &private_locationv1.ExpiryAssertion{
+					Target:     target.Target,
+					Comparator: convertNumberComparator(target.Comparator),
+				}
+			}),
+		models.AssertionEPPStatus: collectAssertion(ctx, "epp_status_target_unmarshal", &statusAssertions,
+			func(target models.BodyString) *private_locationv1.ValueAssertion {
+				return &private_locationv1.ValueAssertion{
+					Target:     target.Target,
+					Comparator: convertStringComparator(target.Comparator),
+				}
+			}),
+	})
+	return
+}
+
 func (h *privateLocationHandler) Monitors(ctx context.Context, req *connect.Request[private_locationv1.MonitorsRequest]) (*connect.Response[private_locationv1.MonitorsResponse], error) {
 	token := req.Header().Get("openstatus-token")
 	if token == "" {
@@ -191,41 +347,54 @@ func (h *privateLocationHandler) Monitors(ctx context.Context, req *connect.Requ
 	}
 
 	var monitors []database.Monitor
-	err := h.db.Select(&monitors, "SELECT monitor.id, monitor.job_type, monitor.url, monitor.periodicity, monitor.method, monitor.body, monitor.timeout, monitor.degraded_after, monitor.follow_redirects, monitor.headers, monitor.assertions, monitor.workspace_id, monitor.retry, monitor.otel_endpoint, monitor.otel_headers FROM monitor JOIN private_location_to_monitor a ON monitor.id = a.monitor_id JOIN private_location b ON a.private_location_id = b.id WHERE b.token = ? AND monitor.deleted_at IS NULL and monitor.active = 1", token)
+	err := h.db.Select(&monitors, "SELECT monitor.id, monitor.job_type, monitor.url, monitor.periodicity, monitor.method, monitor.body, monitor.timeout, monitor.degraded_after, monitor.follow_redirects, monitor.headers, monitor.assertions, monitor.workspace_id, monitor.retry, monitor.otel_endpoint, monitor.otel_headers, monitor.otel_traces, monitor.auth, monitor.secret, monitor.rdap_base_url FROM monitor JOIN private_location_to_monitor a ON monitor.id = a.monitor_id JOIN private_location b ON a.private_location_id = b.id WHERE b.token = ? AND monitor.deleted_at IS NULL and monitor.active = 1", token)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	httpMonitors, tcpMonitors, dnsMonitors, workspaceId := mapMonitors(ctx, monitors)
+	res, workspaceId := mapMonitors(ctx, monitors)
+	res.Region = location.Name
 
 	// Enrich wide event with monitor counts
 	if holder := GetEvent(ctx); holder != nil {
+		mailMonitors := countTCPMonitors(res.TcpMonitors,
+			private_locationv1.TCPProtocol_TCP_PROTOCOL_SMTP,
+			private_locationv1.TCPProtocol_TCP_PROTOCOL_IMAP,
+			private_locationv1.TCPProtocol_TCP_PROTOCOL_POP3)
+		sshMonitors := countTCPMonitors(res.TcpMonitors, private_locationv1.TCPProtocol_TCP_PROTOCOL_SSH)
+		mqttMonitors := countTCPMonitors(res.TcpMonitors, private_locationv1.TCPProtocol_TCP_PROTOCOL_MQTT)
+
 		holder.Event["private_location"] = map[string]any{
-			"workspace_id":   workspaceId,
-			"http_monitors":  len(httpMonitors),
-			"tcp_monitors":   len(tcpMonitors),
-			"dns_monitors":   len(dnsMonitors),
-			"total_monitors": len(monitors),
+			"workspace_id":      workspaceId,
+			"http_monitors":     len(res.HttpMonitors),
+			"tcp_monitors":      len(res.TcpMonitors),
+			"dns_monitors":      len(res.DnsMonitors),
+			"graphql_monitors":  len(res.GraphqlMonitors),
+			"database_monitors": len(res.DatabaseMonitors),
+			"mail_monitors":     mailMonitors,
+			"ssh_monitors":      sshMonitors,
+			"mqtt_monitors":     mqttMonitors,
+			"ntp_monitors":      len(res.NtpMonitors),
+			"domain_monitors":   len(res.DomainMonitors),
+			"total_monitors":    len(monitors),
 		}
 	}
 
-	return connect.NewResponse(&private_locationv1.MonitorsResponse{
-		HttpMonitors: httpMonitors,
-		TcpMonitors:  tcpMonitors,
-		DnsMonitors:  dnsMonitors,
-		Region:       location.Name,
-	}), nil
+	return connect.NewResponse(res), nil
 }
 
-func mapMonitors(ctx context.Context, monitors []database.Monitor) (
-	[]*private_locationv1.HTTPMonitor,
-	[]*private_locationv1.TCPMonitor,
-	[]*private_locationv1.DNSMonitor,
-	int,
-) {
+func countTCPMonitors(monitors []*private_locationv1.TCPMonitor, protocols ...private_locationv1.TCPProtocol) int {
+	var n int
+	for _, m := range monitors {
+		if slices.Contains(protocols, m.Protocol) {
+			n++
+		}
+	}
+	return n
+}
+
+func mapMonitors(ctx context.Context, monitors []database.Monitor) (*private_locationv1.MonitorsResponse, int) {
 	var workspaceId int
-	var httpMonitors []*private_locationv1.HTTPMonitor
-	var tcpMonitors []*private_locationv1.TCPMonitor
-	var dnsMonitors []*private_locationv1.DNSMonitor
+	res := &private_locationv1.MonitorsResponse{}
 	for _, monitor := range monitors {
 		if workspaceId == 0 {
 			workspaceId = monitor.WorkspaceID
@@ -233,15 +402,29 @@ func mapMonitors(ctx context.Context, monitors []database.Monitor) (
 
 		switch monitor.JobType {
 		case database.JobTypeHTTP:
-			httpMonitors = append(httpMonitors, toHTTPMonitor(ctx, monitor))
+			res.HttpMonitors = append(res.HttpMonitors, toHTTPMonitor(ctx, monitor))
 		case database.JobTypeTCP:
-			tcpMonitors = append(tcpMonitors, toTCPMonitor(ctx, monitor))
+			res.TcpMonitors = append(res.TcpMonitors, toTCPMonitor(ctx, monitor))
 		case database.JobTypeDNS:
-			dnsMonitors = append(dnsMonitors, toDNSMonitor(ctx, monitor))
+			res.DnsMonitors = append(res.DnsMonitors, toDNSMonitor(ctx, monitor))
+		case database.JobTypeGraphQL:
+			res.GraphqlMonitors = append(res.GraphqlMonitors, toGraphQLMonitor(ctx, monitor))
+		case database.JobTypePostgres, database.JobTypeMySQL, database.JobTypeRedis:
+			res.DatabaseMonitors = append(res.DatabaseMonitors, toDatabaseMonitor(ctx, monitor))
+		case database.JobTypeSMTP, database.JobTypeIMAP, database.JobTypePOP3:
+			res.TcpMonitors = append(res.TcpMonitors, toMailMonitor(ctx, monitor))
+		case database.JobTypeSSH:
+			res.TcpMonitors = append(res.TcpMonitors, toSSHMonitor(ctx, monitor))
+		case database.JobTypeMQTT:
+			res.TcpMonitors = append(res.TcpMonitors, toMQTTMonitor(ctx, monitor))
+		case database.JobTypeNTP:
+			res.NtpMonitors = append(res.NtpMonitors, toNTPMonitor(ctx, monitor))
+		case database.JobTypeDomain:
+			res.DomainMonitors = append(res.DomainMonitors, toDomainMonitor(ctx, monitor))
 		}
 	}
 
-	return httpMonitors, tcpMonitors, dnsMonitors, workspaceId
+	return res, workspaceId
 }
 
 func toHTTPMonitor(ctx context.Context, monitor database.Monitor) *private_locationv1.HTTPMonitor {
@@ -267,10 +450,175 @@ func toHTTPMonitor(ctx context.Context, monitor database.Monitor) *private_locat
 		StatusCodeAssertions: statusAssertions,
 		HeaderAssertions:     headerAssertions,
 		BodyAssertions:       bodyAssertions,
+		Auth:                 parseHTTPAuth(ctx, monitor.Auth),
+		OtelConfig:           buildOtelConfig(ctx, monitor),
+	}
+}
+
+// parseHTTPAuth reads the auth block of an HTTP monitor, stored as
+// {"oauth2": {...}, "hmac": {...}, "sigv4": {...}} with camelCase fields.
+func parseHTTPAuth(ctx context.Context, raw sql.NullString) *private_locationv1.HTTPAuth {
+	if !raw.Valid || raw.String == "" {
+		return nil
+	}
+
+	var auth struct {
+		OAuth2 *struct {
+			TokenURL     string   `json:"tokenUrl"`
+			ClientID     string   `json:"clientId"`
+			ClientSecret string   `json:"clientSecret"`
+			Scopes       []string `json:"scopes"`
+			Audience     string   `json:"audience"`
+		} `json:"oauth2"`
+		HMAC *struct {
+			Secret          string `json:"secret"`
+			Algorithm       string `json:"algorithm"`
+			SignatureHeader string `json:"signatureHeader"`
+			TimestampHeader string `json:"timestampHeader"`
+		} `json:"hmac"`
+		SigV4 *struct {
+			AccessKeyID     string `json:"accessKeyId"`
+			SecretAccessKey string `json:"secretAccessKey"`
+			SessionToken    string `json:"sessionToken"`
+			Region          string `json:"region"`
+			Service         string `json:"service"`
+		} `json:"sigv4"`
+	}
+	if err := json.Unmarshal([]byte(raw.String), &auth); err != nil {
+		addParseError(ctx, "auth_unmarshal", err)
+		return nil
+	}
+
+	res := &private_locationv1.HTTPAuth{}
+	if auth.OAuth2 != nil {
+		res.Oauth2 = &private_locationv1.OAuth2ClientCredentials{
+			TokenUrl:     auth.OAuth2.TokenURL,
+			ClientId:     auth.OAuth2.ClientID,
+			ClientSecret: auth.OAuth2.ClientSecret,
+			Scopes:       auth.OAuth2.Scopes,
+			Audience:     auth.OAuth2.Audience,
+		}
+	}
+	if auth.HMAC != nil {
+		res.Hmac = &private_locationv1.HMACSigning{
+			Secret:          auth.HMAC.Secret,
+			Algorithm:       auth.HMAC.Algorithm,
+			SignatureHeader: auth.HMAC.SignatureHeader,
+			TimestampHeader: auth.HMAC.TimestampHeader,
+		}
+	}
+	if auth.SigV4 != nil {
+		res.Sigv4 = &private_locationv1.SigV4Signing{
+			AccessKeyId:     auth.SigV4.AccessKeyID,
+			SecretAccessKey: auth.SigV4.SecretAccessKey,
+			SessionToken:    auth.SigV4.SessionToken,
+			Region:          auth.SigV4.Region,
+			Service:         auth.SigV4.Service,
+		}
+	}
+	return res
+}
+
+// toGraphQLMonitor reads the operation from the monitor body, which stores
+// the standard {query, variables, operationName} request envelope.
+func toGraphQLMonitor(ctx context.Context, monitor database.Monitor) *private_locationv1.GraphQLMonitor {
+	var headers []*private_locationv1.Headers
+	if err := json.Unmarshal([]byte(monitor.Headers), &headers); err != nil {
+		addParseError(ctx, "headers_unmarshal", err)
+		headers = nil
+	}
+
+	var operation struct {
+		Query         string          `json:"query"`
+		Variables     json.RawMessage `json:"variables"`
+		OperationName string          `json:"operationName"`
+	}
+	if err := json.Unmarshal([]byte(monitor.Body), &operation); err != nil {
+		addParseError(ctx, "graphql_body_unmarshal", err)
+	}
+	var variables string
+	if len(operation.Variables) > 0 && string(operation.Variables) != "null" {
+		variables = string(operation.Variables)
+	}
+
+	statusAssertions, headerAssertions, _ := ParseAssertions(ctx, monitor.Assertions)
+
+	return &private_locationv1.GraphQLMonitor{
+		Id:                   strconv.Itoa(monitor.ID),
+		Url:                  monitor.URL,
+		Periodicity:          monitor.Periodicity,
+		Query:                operation.Query,
+		Variables:            variables,
+		OperationName:        operation.OperationName,
+		Timeout:              monitor.Timeout,
+		DegradedAt:           &monitor.DegradedAfter.Int64,
+		Retry:                int64(monitor.Retry),
+		FollowRedirects:      monitor.FollowRedirects,
+		Headers:              headers,
+		StatusCodeAssertions: statusAssertions,
+		HeaderAssertions:     headerAssertions,
+		DataAssertions:       ParseJsonBodyAssertions(ctx, monitor.Assertions),
+		Auth:                 parseHTTPAuth(ctx, monitor.Auth),
 		OtelConfig:           buildOtelConfig(ctx, monitor),
 	}
 }
 
+var databaseEngines = map[database.JobType]private_locationv1.DatabaseEngine{
+	database.JobTypePostgres: private_locationv1.DatabaseEngine_DATABASE_ENGINE_POSTGRES,
+	database.JobTypeMySQL:    private_locationv1.DatabaseEngine_DATABASE_ENGINE_MYSQL,
+	database.JobTypeRedis:    private_locationv1.DatabaseEngine_DATABASE_ENGINE_REDIS,
+}
+
+// monitorSecret is the JSON stored in the secret column, so that credentials
+// stay out of the monitor url and body.
+type monitorSecret struct {
+	Password   string `json:"password"`
+	PrivateKey string `json:"privateKey"`
+	Passphrase string `json:"passphrase"`
+}
+
+func parseMonitorSecret(ctx context.Context, raw sql.NullString) monitorSecret {
+	var secret monitorSecret
+	if !raw.Valid || raw.String == "" {
+		return secret
+	}
+	if err := json.Unmarshal([]byte(raw.String), &secret); err != nil {
+		addParseError(ctx, "secret_unmarshal", err)
+	}
+	return secret
+}
+
+// toDatabaseMonitor reads the connection URL from the monitor url, adding the
+// password from the secret, and the optional query from the body.
+func toDatabaseMonitor(ctx context.Context, monitor database.Monitor) *private_locationv1.DatabaseMonitor {
+	rowCountAssertions, valueAssertions := ParseDatabaseAssertions(ctx, monitor.Assertions)
+
+	uri := monitor.URL
+	if secret := parseMonitorSecret(ctx, monitor.Secret); secret.Password != "" {
+		u, err := url.Parse(monitor.URL)
+		if err != nil {
+			addParseError(ctx, "database_url_parse", err)
+		} else {
+			u.User = url.UserPassword(u.User.Username(), secret.Password)
+			uri = u.String()
+		}
+	}
+
+	return &private_locationv1.DatabaseMonitor{
+		Id:                 strconv.Itoa(monitor.ID),
+		Engine:             databaseEngines[monitor.JobType],
+		Uri:                uri,
+		Query:              monitor.Body,
+		Periodicity:        monitor.Periodicity,
+		Timeout:            monitor.Timeout,
+		DegradedAt:         &monitor.DegradedAfter.Int64,
+		Retry:              int64(monitor.Retry),
+		RowCountAssertions: rowCountAssertions,
+		ValueAssertions:    valueAssertions,
+		OtelConfig:         buildOtelConfig(ctx, monitor),
+	}
+}
+
 func toTCPMonitor(ctx context.Context, monitor database.Monitor) *private_locationv1.TCPMonitor {
 	return &private_locationv1.TCPMonitor{
 		Id:          strconv.Itoa(monitor.ID),
@@ -283,6 +631,144 @@ func toTCPMonitor(ctx context.Context, monitor database.Monitor) *private_locati
 	}
 }
 
+type mailProtocol struct {
+	protocol    private_locationv1.TCPProtocol
+	port        string
+	implicitTLS string
+	tlsPort     string
+}
+
+var mailProtocols = map[database.JobType]mailProtocol{
+	database.JobTypeSMTP: {private_locationv1.TCPProtocol_TCP_PROTOCOL_SMTP, "25", "smtps", "465"},
+	database.JobTypeIMAP: {private_locationv1.TCPProtocol_TCP_PROTOCOL_IMAP, "143", "imaps", "993"},
+	database.JobTypePOP3: {private_locationv1.TCPProtocol_TCP_PROTOCOL_POP3, "110", "pop3s", "995"},
+}
+
+// toMailMonitor reads the server and options from a URL such as
+// smtp://user@mail.example.com:587?starttls=true&ehlo=probe.example.com and
+// the password from the secret. The secure schemes (smtps, imaps, pop3s)
+// select implicit TLS.
+func toMailMonitor(ctx context.Context, monitor database.Monitor) *private_locationv1.TCPMonitor {
+	proto := mailProtocols[monitor.JobType]
+	replyCodeAssertions, capabilityAssertions := ParseMailAssertions(ctx, monitor.Assertions)
+
+	tcp := toTCPMonitor(ctx, monitor)
+	tcp.Protocol = proto.protocol
+	tcp.ReplyCodeAssertions = replyCodeAssertions
+	tcp.CapabilityAssertions = capabilityAssertions
+
+	u, err := url.Parse(monitor.URL)
+	if err != nil || u.Hostname() == "" {
+		if err == nil {
+			err = fmt.Errorf("missing host in %q", u.Redacted())
+		}
+		addParseError(ctx, "mail_url_parse", err)
+		return tcp
+	}
+
+	mail := &private_locationv1.MailOptions{
+		ImplicitTls: u.Scheme == proto.implicitTLS,
+		EhloName:    u.Query().Get("ehlo"),
+	}
+	mail.Starttls, _ = strconv.ParseBool(u.Query().Get("starttls"))
+	if u.User != nil {
+		mail.Username = u.User.Username()
+		mail.Password = parseMonitorSecret(ctx, monitor.Secret).Password
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = proto.port
+		if mail.ImplicitTls {
+			port = proto.tlsPort
+		}
+	}
+	tcp.Uri = net.JoinHostPort(u.Hostname(), port)
+	tcp.Mail = mail
+
+	return tcp
+}
+
+// toSSHMonitor reads the server from a URL such as ssh://probe@bastion:22,
+// the pinned host key from the body, which stores {hostKeyFingerprint}, and
+// the login key and its passphrase from the secret.
+func toSSHMonitor(ctx context.Context, monitor database.Monitor) *private_locationv1.TCPMonitor {
+	tcp := toTCPMonitor(ctx, monitor)
+	tcp.Protocol = private_locationv1.TCPProtocol_TCP_PROTOCOL_SSH
+
+	var options struct {
+		HostKeyFingerprint string `json:"hostKeyFingerprint"`
+	}
+	if monitor.Body != "" {
+		if err := json.Unmarshal([]byte(monitor.Body), &options); err != nil {
+			addParseError(ctx, "ssh_body_unmarshal", err)
+		}
+	}
+	secret := parseMonitorSecret(ctx, monitor.Secret)
+	tcp.Ssh = &private_locationv1.SSHOptions{
+		HostKeyFingerprint: options.HostKeyFingerprint,
+		PrivateKey:         secret.PrivateKey,
+		Passphrase:         secret.Passphrase,
+	}
+
+	u, err := url.Parse(monitor.URL)
+	if err != nil || u.Hostname() == "" {
+		if err == nil {
+			err = fmt.Errorf("missing host in %q", u.Redacted())
+		}
+		addParseError(ctx, "ssh_url_parse", err)
+		return tcp
+	}
+	if u.User != nil {
+		tcp.Ssh.Username = u.User.Username()
+	}
+	port := u.Port()
+	if port == "" {
+		port = "22"
+	}
+	tcp.Uri = net.JoinHostPort(u.Hostname(), port)
+
+	return tcp
+}
+
+// toMQTTMonitor reads the broker, username and test topic from a URL such as
+// mqtt://user@broker:1883/fleet/health and the password from the secret;
+// mqtts selects TLS.
+func toMQTTMonitor(ctx context.Context, monitor database.Monitor) *private_locationv1.TCPMonitor {
+	tcp := toTCPMonitor(ctx, monitor)
+	tcp.Protocol = private_locationv1.TCPProtocol_TCP_PROTOCOL_MQTT
+
+	u, err := url.Parse(monitor.URL)
+	if err != nil || u.Hostname() == "" {
+		if err == nil {
+			err = fmt.Errorf("missing host in %q", u.Redacted())
+		}
+		addParseError(ctx, "mqtt_url_parse", err)
+		return tcp
+	}
+
+	mqtt := &private_locationv1.MQTTOptions{
+		Topic: strings.TrimPrefix(u.Path, "/"),
+		Tls:   u.Scheme == "mqtts",
+	}
+	if u.User != nil {
+		mqtt.Username = u.User.Username()
+		mqtt.Password = parseMonitorSecret(ctx, monitor.Secret).Password
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "1883"
+		if mqtt.Tls {
+			port = "8883"
+		}
+	}
+	tcp.Uri = net.JoinHostPort(u.Hostname(), port)
+	tcp.Mqtt = mqtt
+
+	return tcp
+}
+
 func toDNSMonitor(ctx context.Context, monitor database.Monitor) *private_locationv1.DNSMonitor {
 	return &private_locationv1.DNSMonitor{
 		Id:               strconv.Itoa(monitor.ID),
@@ -295,6 +781,39 @@ func toDNSMonitor(ctx context.Context, monitor database.Monitor) *private_locati
 	}
 }
 
+func toNTPMonitor(ctx context.Context, monitor database.Monitor) *private_locationv1.NTPMonitor {
+	offsetAssertions, stratumAssertions := ParseNTPAssertions(ctx, monitor.Assertions)
+	return &private_locationv1.NTPMonitor{
+		Id:                strconv.Itoa(monitor.ID),
+		Uri:               monitor.URL,
+		Timeout:           monitor.Timeout,
+		DegradedAt:        &monitor.DegradedAfter.Int64,
+		Periodicity:       monitor.Periodicity,
+		Retry:             int64(monitor.Retry),
+		OffsetAssertions:  offsetAssertions,
+		StratumAssertions: stratumAssertions,
+		OtelConfig:        buildOtelConfig(ctx, monitor),
+	}
+}
+
+// toDomainMonitor takes the domain name from the URL column; rdap_base_url
+// may pin an RDAP server instead of the bootstrap one.
+func toDomainMonitor(ctx context.Context, monitor database.Monitor) *private_locationv1.DomainMonitor {
+	expiryAssertions, statusAssertions := ParseDomainAssertions(ctx, monitor.Assertions)
+	return &private_locationv1.DomainMonitor{
+		Id:               strconv.Itoa(monitor.ID),
+		Uri:              monitor.URL,
+		Timeout:          monitor.Timeout,
+		DegradedAt:       &monitor.DegradedAfter.Int64,
+		Periodicity:      monitor.Periodicity,
+		Retry:            int64(monitor.Retry),
+		RdapBaseUrl:      monitor.RdapBaseURL.String,
+		ExpiryAssertions: expiryAssertions,
+		StatusAssertions: statusAssertions,
+		OtelConfig:       buildOtelConfig(ctx, monitor),
+	}
+}
+
 // buildOtelConfig maps a monitor's stored OTel settings to the proto config,
 // returning nil when no endpoint is configured so the checker skips OTel.
 func buildOtelConfig(ctx context.Context, monitor database.Monitor) *private_locationv1.OtelConfig {
@@ -305,6 +824,7 @@ func buildOtelConfig(ctx context.Context, monitor database.Monitor) *private_loc
 	return &private_locationv1.OtelConfig{
 		Endpoint: monitor.OtelEndpoint.String,
 		Headers:  ParseOtelHeaders(ctx, monitor.OtelHeaders),
+		Traces:   monitor.OtelTraces.Bool,
 	}
 }
 
@@ -52,6 +52,27 @@ func TestMonitors_HTTPMonitorOtelConfig(t *testing.T) {
 	}
 }
 
+func TestMonitors_HTTPMonitorOtelTraces(t *testing.T) {
+	if monitorsResponse(t).HttpMonitors[0].OtelConfig.Traces {
+		t.Errorf("expected traces to be off by default")
+	}
+
+	db := testDB()
+	db.MustExec(`UPDATE monitor SET otel_traces = 1 WHERE id = 5`)
+	h := server.NewPrivateLocationServer(db, getTBClient(context.Background()))
+
+	req := connect.NewRequest(&private_locationv1.MonitorsRequest{})
+	req.Header().Set("openstatus-token", "my-secret-key")
+
+	resp, err := h.Monitors(context.Background(), req)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !resp.Msg.HttpMonitors[0].OtelConfig.Traces {
+		t.Errorf("expected traces to be enabled")
+	}
+}
+
 func TestMonitors_TCPMonitorNoOtelConfig(t *testing.T) {
 	msg := monitorsResponse(t)
 	if len(msg.TcpMonitors) != 1 {
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/openstatushq/openstatus/apps/checker/pkg/statuspolicy"
+	"github.com/openstatushq/openstatus/apps/private-location/internal/database"
+)
+
+// statusChange is a status workflows has to be told about.
+type statusChange struct {
+	LocationID int
+	Status     string
+	// Transition is set for the location's own transition, decided by its
+	// status policy, and Quorum for a change of the monitor's consensus.
+	Transition bool
+	Quorum     bool
+}
+
+// consensus is the outcome of a monitor's quorum.
+type consensus struct {
+	// Enabled is false when the monitor has no quorum; its locations are
+	// then reported on their own.
+	Enabled bool
+	// Changed is set while the consensus differs from the one last reported.
+	Changed bool
+	Status  string
+	// LocationIDs are the private locations the monitor is assigned to.
+	LocationIDs []int
+}
+
+// recordConsensus evaluates the monitor's quorum across the reported status
+// of each of its private locations and reports whether the consensus has to
+// be reported. report is set when the location's status policy decided to
+// report its status: the quorum takes it over instead of workflows. Locations
+// without a reported status within the quorum window, or flapping, are left
+// out, but still count towards the number of locations the quorum needs.
+func (h *privateLocationHandler) recordConsensus(ctx context.Context, monitorID, locationID int, report bool, now time.Time) (consensus, error) {
+	tx, err := h.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return consensus{}, err
+	}
+	defer tx.Rollback()
+
+	var settings database.QuorumPolicy
+	err = tx.GetContext(ctx, &settings, `SELECT quorum, quorum_count, quorum_window_seconds
+		FROM monitor_status_policy WHERE monitor_id = ?`, monitorID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && settings.Quorum == "") {
+		return consensus{}, nil
+	}
+	if err != nil {
+		return consensus{}, err
+	}
+	quorum, err := statuspolicy.ParseQuorum(settings.Quorum, settings.QuorumCount)
+	if err != nil {
+		return consensus{}, err
+	}
+
+	if report {
+		if _, err := tx.ExecContext(ctx, `UPDATE private_location_status SET reported_status = status
+			WHERE monitor_id = ? AND private_location_id = ?`, monitorID, locationID); err != nil {
+			return consensus{}, err
+		}
+	}
+
+	var since int64
+	if settings.QuorumWindowSeconds > 0 {
+		since = now.Add(-time.Duration(settings.QuorumWindowSeconds) * time.Second).UnixMilli()
+	}
+
+	var locations []struct {
+		ID     int            `db:"private_location_id"`
+		Status sql.NullString `db:"reported_status"`
+	}
+	if err := tx.SelectContext(ctx, &locations, `SELECT private_location_to_monitor.private_location_id, private_location_status.reported_status
+		FROM private_location_to_monitor
+		LEFT JOIN private_location_status ON private_location_status.monitor_id = private_location_to_monitor.monitor_id
+			AND private_location_status.private_location_id = private_location_to_monitor.private_location_id
+			AND private_location_status.reported_status != '' AND private_location_status.flapping = 0
+			AND private_location_status.observed_at >= ?
+		WHERE private_location_to_monitor.monitor_id = ? AND private_location_to_monitor.deleted_at IS NULL
+		ORDER BY private_location_to_monitor.private_location_id`, since, monitorID); err != nil {
+		return consensus{}, err
+	}
+
+	result := consensus{Enabled: true}
+	var statuses []string
+	for _, location := range locations {
+		result.LocationIDs = append(result.LocationIDs, location.ID)
+		if location.Status.Valid {
+			statuses = append(statuses, location.Status.String)
+		}
+	}
+
+	result.Status = quorum.Status(statuses, len(locations))
+	if result.Status == "" {
+		return result, tx.Commit()
+	}
+
+	// The first consensus is taken as reported: workflows already knows the
+	// monitor's status from before the quorum, or from its defaults.
+	if _, err := tx.ExecContext(ctx, `INSERT INTO monitor_quorum_status (monitor_id, status, reported_status, changed_at) VALUES (?, ?, ?, ?)
+		ON CONFLICT (monitor_id) DO UPDATE SET status = excluded.status, changed_at = excluded.changed_at
+		WHERE monitor_quorum_status.status != excluded.status`,
+		monitorID, result.Status, result.Status, now.UnixMilli()); err != nil {
+		return consensus{}, err
+	}
+	var reported string
+	if err := tx.GetContext(ctx, &reported, `SELECT reported_status FROM monitor_quorum_status WHERE monitor_id = ?`, monitorID); err != nil {
+		return consensus{}, err
+	}
+	result.Changed = reported != result.Status
+
+	if err := tx.Commit(); err != nil {
+		return consensus{}, err
+	}
+	return result, nil
+}
+
+// statusChanges records the status observed at the location and returns what
+// workflows has to be told. With a quorum, a change of the consensus is
+// reported for every location of the monitor, so that workflows sees the
+// consensus whichever location it looks at, until it is marked reported. When
+// the quorum cannot be evaluated the location is reported on its own.
+func (h *privateLocationHandler) statusChanges(ctx context.Context, monitorID, locationID int, observed string, now time.Time) []statusChange {
+	status, report := h.transitioned(ctx, monitorID, locationID, observed, now)
+
+	result, err := h.recordConsensus(ctx, monitorID, locationID, report, now)
+	if err != nil {
+		slog.Error("failed to evaluate monitor quorum",
+			"monitor_id", monitorID,
+			"error", err.Error(),
+		)
+	}
+
+	if err == nil && result.Enabled {
+		if !result.Changed {
+			return nil
+		}
+		changes := make([]statusChange, 0, len(result.LocationIDs))
+		for _, id := range result.LocationIDs {
+			changes = append(changes, statusChange{LocationID: id, Status: result.Status, Quorum: true})
+		}
+		return changes
+	}
+
+	if !report {
+		return nil
+	}
+	return []statusChange{{LocationID: locationID, Status: status, Transition: true}}
+}
@@ -0,0 +1,158 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/private-location/internal/database"
+)
+
+// quorumTestHandler assigns monitor 7 to private locations 1 to 3 with the
+// given quorum.
+func quorumTestHandler(t *testing.T, quorum string, count int, windowSeconds int64) *privateLocationHandler {
+	t.Helper()
+	h, _ := heartbeatTestHandler(t)
+	h.db.MustExec(`INSERT INTO private_location_to_monitor (private_location_id, monitor_id) VALUES (2, 7), (3, 7)`)
+	h.db.MustExec(`INSERT INTO monitor_status_policy (monitor_id, quorum, quorum_count, quorum_window_seconds) VALUES (7, ?, ?, ?)`,
+		quorum, count, windowSeconds)
+	return h
+}
+
+func TestStatusChangesWithoutQuorum(t *testing.T) {
+	h, _ := heartbeatTestHandler(t)
+	now := time.UnixMilli(1700000000000)
+
+	changes := h.statusChanges(context.Background(), 7, 1, "error", now)
+	assert.Equal(t, []statusChange{{LocationID: 1, Status: "error", Transition: true}}, changes)
+
+	// Until it is marked reported, the transition is reported again.
+	assert.Equal(t, changes, h.statusChanges(context.Background(), 7, 1, "error", now.Add(time.Minute)))
+	h.markReported(context.Background(), 7, changes[0])
+	assert.Empty(t, h.statusChanges(context.Background(), 7, 1, "error", now.Add(2*time.Minute)))
+}
+
+func TestStatusChangesWithMajorityQuorum(t *testing.T) {
+	h := quorumTestHandler(t, "majority", 0, 0)
+	ctx := context.Background()
+	now := time.UnixMilli(1700000000000)
+
+	// The first consensus is not reported.
+	for _, location := range []int{1, 2, 3} {
+		assert.Empty(t, h.statusChanges(ctx, 7, location, "active", now))
+	}
+
+	// A single failing location is outvoted.
+	assert.Empty(t, h.statusChanges(ctx, 7, 1, "error", now.Add(time.Minute)))
+
+	changes := h.statusChanges(ctx, 7, 2, "error", now.Add(time.Minute))
+	assert.Equal(t, []statusChange{
+		{LocationID: 1, Status: "error", Quorum: true},
+		{LocationID: 2, Status: "error", Quorum: true},
+		{LocationID: 3, Status: "error", Quorum: true},
+	}, changes)
+
+	// Until it is marked reported, the consensus is reported again.
+	assert.Equal(t, changes, h.statusChanges(ctx, 7, 3, "error", now.Add(time.Minute)))
+	for _, change := range changes {
+		h.markReported(ctx, 7, change)
+	}
+	assert.Empty(t, h.statusChanges(ctx, 7, 3, "error", now.Add(2*time.Minute)))
+
+	// The results of each location are still stored.
+	var statuses []string
+	require.NoError(t, h.db.Select(&statuses, `SELECT status FROM private_location_status WHERE monitor_id = 7 ORDER BY private_location_id`))
+	assert.Equal(t, []string{"error", "error", "error"}, statuses)
+}
+
+func TestStatusChangesCountsAssignedLocations(t *testing.T) {
+	h := quorumTestHandler(t, "majority", 0, 300)
+	ctx := context.Background()
+	now := time.UnixMilli(1700000000000)
+
+	h.statusChanges(ctx, 7, 3, "active", now)
+	h.statusChanges(ctx, 7, 1, "error", now)
+	changes := h.statusChanges(ctx, 7, 2, "error", now)
+	require.Len(t, changes, 3)
+	for _, change := range changes {
+		h.markReported(ctx, 7, change)
+	}
+
+	// The other locations stop reporting; once their results left the
+	// window, a single failing location is no majority of three.
+	assert.Equal(t, []statusChange{
+		{LocationID: 1, Status: "active", Quorum: true},
+		{LocationID: 2, Status: "active", Quorum: true},
+		{LocationID: 3, Status: "active", Quorum: true},
+	}, h.statusChanges(ctx, 7, 1, "error", now.Add(10*time.Minute)))
+}
+
+func TestStatusChangesIgnoresUnreportedAndFlappingLocations(t *testing.T) {
+	h := quorumTestHandler(t, "any", 0, 0)
+	ctx := context.Background()
+	h.db.MustExec(`UPDATE monitor_status_policy SET failure_threshold = 2, flap_threshold = 1, flap_window_seconds = 3600 WHERE monitor_id = 7`)
+	now := time.UnixMilli(1700000000000)
+
+	assert.Empty(t, h.statusChanges(ctx, 7, 1, "active", now))
+
+	// Location 2 is below its failure threshold: it has nothing to report.
+	assert.Empty(t, h.statusChanges(ctx, 7, 2, "error", now))
+
+	changes := h.statusChanges(ctx, 7, 1, "error", now.Add(time.Minute))
+	assert.Empty(t, changes)
+	changes = h.statusChanges(ctx, 7, 1, "error", now.Add(2*time.Minute))
+	require.Len(t, changes, 3)
+	assert.Equal(t, "error", changes[0].Status)
+	for _, change := range changes {
+		h.markReported(ctx, 7, change)
+	}
+
+	// Location 1 recovers and fails again within the flap window: while it
+	// is flapping, it does not move the consensus.
+	assert.Empty(t, h.statusChanges(ctx, 7, 1, "active", now.Add(3*time.Minute)))
+	assert.Empty(t, h.statusChanges(ctx, 7, 1, "error", now.Add(4*time.Minute)))
+	assert.Empty(t, h.statusChanges(ctx, 7, 1, "active", now.Add(5*time.Minute)))
+
+	var flapping bool
+	require.NoError(t, h.db.Get(&flapping, `SELECT flapping FROM private_location_status WHERE monitor_id = 7 AND private_location_id = 1`))
+	assert.True(t, flapping)
+	var consensus string
+	require.NoError(t, h.db.Get(&consensus, `SELECT status FROM monitor_quorum_status WHERE monitor_id = 7`))
+	assert.Equal(t, "error", consensus)
+}
+
+func TestStatusChangesWithInvalidQuorum(t *testing.T) {
+	h := quorumTestHandler(t, "n_of_m", 0, 0)
+
+	// The location is reported on its own rather than not at all.
+	assert.Equal(t, []statusChange{{LocationID: 1, Status: "error", Transition: true}},
+		h.statusChanges(context.Background(), 7, 1, "error", time.UnixMilli(1700000000000)))
+}
+
+func TestForwardStatusUpdateKeepsTheResultToItsLocation(t *testing.T) {
+	h, called := heartbeatTestHandler(t)
+	h.db.MustExec(`INSERT INTO private_location_to_monitor (private_location_id, monitor_id) VALUES (2, 7), (3, 7)`)
+	h.db.MustExec(`INSERT INTO monitor_status_policy (monitor_id, quorum) VALUES (7, 'any')`)
+	ic := &ingestContext{
+		Monitor: database.Monitor{ID: 7},
+		Region:  database.PrivateLocation{ID: 1},
+	}
+
+	h.forwardStatusUpdate(context.Background(), ic, statusUpdateInput{RequestStatus: "active"})
+	h.forwardStatusUpdate(context.Background(), ic, statusUpdateInput{RequestStatus: "error", Message: "connection refused", StatusCode: 502})
+
+	messages := map[string]string{}
+	for len(messages) < 3 {
+		select {
+		case payload := <-called:
+			assert.Equal(t, "error", payload.Status)
+			messages[payload.PrivateLocationID] = payload.Message
+		case <-time.After(2 * time.Second):
+			t.Fatalf("expected three status updates, got %v", messages)
+		}
+	}
+	assert.Equal(t, map[string]string{"1": "connection refused", "2": "", "3": ""}, messages)
+}
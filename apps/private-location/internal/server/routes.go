@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
@@ -12,8 +13,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/joho/godotenv/autoload"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/sink"
+	"github.com/openstatushq/openstatus/apps/checker/pkg/tinybird"
 	"github.com/openstatushq/openstatus/apps/private-location/internal/logs"
-	"github.com/openstatushq/openstatus/apps/private-location/internal/tinybird"
 	"github.com/openstatushq/openstatus/apps/private-location/internal/workflows"
 	v1 "github.com/openstatushq/openstatus/apps/private-location/proto/private_location/v1"
 )
@@ -60,14 +62,15 @@ func Logger() func(next http.Handler) http.Handler {
 			if r.TLS != nil {
 				scheme = "https"
 			}
-			fullURL := scheme + "://" + r.Host + r.RequestURI
+			// Heartbeat tokens are secrets and must not reach the logs.
+			fullURL := scheme + "://" + r.Host + redactHeartbeatToken(r.RequestURI)
 
 			holder := &EventHolder{
 				Event: map[string]any{
 					"timestamp":    startTime.Format(time.RFC3339),
 					"request_id":   requestID,
 					"method":       r.Method,
-					"path":         r.URL.Path,
+					"path":         redactHeartbeatToken(r.URL.Path),
 					"url":          fullURL,
 					"user_agent":   r.Header.Get("User-Agent"),
 					"content_type": r.Header.Get("Content-Type"),
@@ -131,17 +134,28 @@ func GetEvent(ctx context.Context) *EventHolder {
 
 type privateLocationHandler struct {
 	db              *sqlx.DB
-	TbClient        tinybird.Client
+	Sink            sink.Sink
 	WorkflowsClient workflows.Client
+	// Outbox persists the status updates for WorkflowsClient. When nil they
+	// are sent directly.
+	Outbox *workflows.Outbox
+	// cronSecret authenticates the dashboard reading monitor statuses.
+	cronSecret string
 }
 
-func NewPrivateLocationServer(db *sqlx.DB, tbClient tinybird.Client) *privateLocationHandler {
+func NewPrivateLocationServer(db *sqlx.DB, resultSink sink.Sink) *privateLocationHandler {
 	return &privateLocationHandler{
-		db:       db,
-		TbClient: tbClient,
+		db:   db,
+		Sink: resultSink,
 	}
 }
 
+// logDroppedBatch logs the tinybird batches given up on with slog, like the
+// rest of the private location.
+func logDroppedBatch(dataSourceName string, events int, err error) {
+	slog.Error("dropping tinybird batch", "datasource", dataSourceName, "events", events, "error", err.Error())
+}
+
 // RegisterRoutes sets up the HTTP routes for the server.
 func (s *Server) RegisterRoutes() http.Handler {
 	r := chi.NewRouter()
@@ -156,15 +170,33 @@ func (s *Server) RegisterRoutes() http.Handler {
 		Timeout: 45 * time.Second,
 	}
 
-	tinybirdClient := tinybird.NewClient(httpClient, tinyBirdToken)
-
-	privateLocationServer := NewPrivateLocationServer(s.db, tinybirdClient)
-	privateLocationServer.WorkflowsClient = workflows.NewClient(httpClient, os.Getenv("CRON_SECRET"))
+	resultSink, err := sink.FromEnv(context.Background(), httpClient, tinyBirdToken, tinybird.BatchOptions{OnDrop: logDroppedBatch})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to create result sink: %v\n", err)
+		os.Exit(1)
+	}
+	s.sink = resultSink
+
+	privateLocationServer := NewPrivateLocationServer(s.db, resultSink)
+	privateLocationServer.cronSecret = os.Getenv("CRON_SECRET")
+	privateLocationServer.WorkflowsClient = workflows.NewClient(httpClient, privateLocationServer.cronSecret)
+	s.outbox = workflows.NewOutbox(s.db, privateLocationServer.WorkflowsClient, s.metrics, workflows.OutboxOptions{})
+	privateLocationServer.Outbox = s.outbox
+	s.privateLocation = privateLocationServer
 	path, handler := v1.NewPrivateLocationServiceHandler(privateLocationServer)
 
 	r.Group(func(r chi.Router) {
 		r.Mount(path, handler)
 	})
+
+	// Push monitors: jobs prove liveness by calling their ping URL.
+	r.Get("/heartbeat/{token}", privateLocationServer.heartbeatHandler)
+	r.Post("/heartbeat/{token}", privateLocationServer.heartbeatHandler)
+	r.Get("/heartbeat/{token}/{signal}", privateLocationServer.heartbeatHandler)
+	r.Post("/heartbeat/{token}/{signal}", privateLocationServer.heartbeatHandler)
+
+	r.Get("/monitors/{monitorID}/status", privateLocationServer.statusHandler)
+
 	return r
 }
 
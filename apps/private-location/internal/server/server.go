@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -12,6 +13,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/joho/godotenv/autoload"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.opentelemetry.io/contrib/bridges/otelslog"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
@@ -20,7 +24,9 @@ import (
 	"go.opentelemetry.io/otel/sdk/resource"
 	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
 
+	"github.com/openstatushq/openstatus/apps/checker/pkg/sink"
 	"github.com/openstatushq/openstatus/apps/private-location/internal/database"
+	"github.com/openstatushq/openstatus/apps/private-location/internal/workflows"
 )
 
 
@@ -29,6 +35,13 @@ type Server struct {
 	db          *sqlx.DB
 	logger      *slog.Logger
 	logProvider *sdklog.LoggerProvider
+	// sink is flushed on shutdown.
+	sink sink.Sink
+	// outbox is drained on shutdown.
+	outbox  *workflows.Outbox
+	metrics *prometheus.Registry
+
+	privateLocation *privateLocationHandler
 }
 
 // instanceID is generated once at startup
@@ -53,7 +66,9 @@ func NewServer() (*http.Server, func(context.Context)) {
 		db:          database.New(),
 		logger:      logger,
 		logProvider: logProvider,
+		metrics:     prometheus.NewRegistry(),
 	}
+	newServer.metrics.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
 
 	// Declare Server config
 	server := &http.Server{
@@ -64,6 +79,21 @@ func NewServer() (*http.Server, func(context.Context)) {
 		WriteTimeout: 30 * time.Second,
 	}
 
+	// Metrics are served on their own listener, so that they stay off the
+	// public port.
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("GET /metrics", promhttp.HandlerFor(newServer.metrics, promhttp.HandlerOpts{}))
+	metricsServer := &http.Server{
+		Addr:              env("METRICS_ADDR", ":9090"),
+		Handler:           metricsMux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("metrics server stopped", "error", err.Error())
+		}
+	}()
+
 	// Startup logging
 	slog.Info("server starting",
 		"port", port,
@@ -72,8 +102,21 @@ func NewServer() (*http.Server, func(context.Context)) {
 		"tinybird_configured", os.Getenv("TINYBIRD_TOKEN") != "",
 	)
 
+	sweeperCtx, stopSweeper := context.WithCancel(context.Background())
+	go newServer.privateLocation.runHeartbeatSweeper(sweeperCtx, 30*time.Second)
+
 	// Return cleanup function for graceful shutdown
 	cleanup := func(ctx context.Context) {
+		stopSweeper()
+		if err := metricsServer.Shutdown(ctx); err != nil {
+			slog.Error("failed to stop the metrics server", "error", err.Error())
+		}
+		if err := newServer.outbox.Close(ctx); err != nil {
+			slog.Error("failed to drain status updates", "error", err.Error())
+		}
+		if err := sink.Close(ctx, newServer.sink); err != nil {
+			slog.Error("failed to flush results", "error", err.Error())
+		}
 		if logProvider != nil {
 			logProvider.Shutdown(ctx)
 		}
@@ -0,0 +1,168 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/openstatushq/openstatus/apps/checker/pkg/statuspolicy"
+	"github.com/openstatushq/openstatus/apps/private-location/internal/database"
+)
+
+// recordStatus applies the status observed by a check to the monitor at the
+// location, following the monitor's status policy. It returns the status
+// decided by the policy and whether workflows has to be told about it.
+func (h *privateLocationHandler) recordStatus(ctx context.Context, monitorID, locationID int, observed string, now time.Time) (string, bool, error) {
+	tx, err := h.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return "", false, err
+	}
+	defer tx.Rollback()
+
+	var settings database.StatusPolicy
+	err = tx.GetContext(ctx, &settings, `SELECT failure_threshold, recovery_threshold, flap_threshold, flap_window_seconds
+		FROM monitor_status_policy WHERE monitor_id = ?`, monitorID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", false, err
+	}
+	policy := statuspolicy.Policy{
+		FailureThreshold:  settings.FailureThreshold,
+		RecoveryThreshold: settings.RecoveryThreshold,
+		FlapThreshold:     settings.FlapThreshold,
+		FlapWindow:        time.Duration(settings.FlapWindowSeconds) * time.Second,
+	}
+
+	current := database.MonitorStatus{MonitorID: monitorID, PrivateLocationID: locationID}
+	err = tx.GetContext(ctx, &current, `SELECT monitor_id, private_location_id, status, previous_status, changed_at, flapping,
+		reported_status, consecutive_failures, consecutive_successes, flap_count, flap_window_start
+		FROM private_location_status WHERE monitor_id = ? AND private_location_id = ?`, monitorID, locationID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", false, err
+	}
+
+	state, report := policy.Next(statuspolicy.State{
+		Status:          current.Status,
+		Reported:        current.ReportedStatus,
+		Failures:        current.ConsecutiveFailures,
+		Successes:       current.ConsecutiveSuccesses,
+		FlapCount:       current.FlapCount,
+		FlapWindowStart: time.UnixMilli(current.FlapWindowStart),
+		Flapping:        current.Flapping,
+	}, observed, now)
+
+	next := current
+	if state.Status != current.Status {
+		next.PreviousStatus = current.Status
+		next.ChangedAt = now.UnixMilli()
+	}
+	// The reported status is left to markReported, once workflows has the
+	// transition.
+	next.Status = state.Status
+	next.ConsecutiveFailures = state.Failures
+	next.ConsecutiveSuccesses = state.Successes
+	next.FlapCount = state.FlapCount
+	next.FlapWindowStart = state.FlapWindowStart.UnixMilli()
+	next.Flapping = state.Flapping
+	next.ObservedAt = now.UnixMilli()
+
+	if _, err := tx.NamedExecContext(ctx, `INSERT INTO private_location_status (monitor_id, private_location_id, status, previous_status, changed_at, flapping,
+			reported_status, consecutive_failures, consecutive_successes, flap_count, flap_window_start, observed_at)
+		VALUES (:monitor_id, :private_location_id, :status, :previous_status, :changed_at, :flapping,
+			:reported_status, :consecutive_failures, :consecutive_successes, :flap_count, :flap_window_start, :observed_at)
+		ON CONFLICT (monitor_id, private_location_id) DO UPDATE SET status = excluded.status, previous_status = excluded.previous_status,
+			changed_at = excluded.changed_at, flapping = excluded.flapping, reported_status = excluded.reported_status,
+			consecutive_failures = excluded.consecutive_failures, consecutive_successes = excluded.consecutive_successes,
+			flap_count = excluded.flap_count, flap_window_start = excluded.flap_window_start, observed_at = excluded.observed_at`, next); err != nil {
+		return "", false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", false, err
+	}
+	return state.Status, report, nil
+}
+
+// transitioned records the observed status and returns the status to report
+// and whether workflows has to be told about it. When the status cannot be
+// recorded the observed one is forwarded anyway: a duplicate report is
+// cheaper than a missed transition.
+func (h *privateLocationHandler) transitioned(ctx context.Context, monitorID, locationID int, observed string, now time.Time) (string, bool) {
+	status, report, err := h.recordStatus(ctx, monitorID, locationID, observed, now)
+	if err != nil {
+		slog.Error("failed to record monitor status",
+			"monitor_id", monitorID,
+			"private_location_id", locationID,
+			"error", err.Error(),
+		)
+		return observed, true
+	}
+	return status, report
+}
+
+// markReported records that workflows was told about the transition of the
+// location, or about the monitor's consensus. Changes that were superseded by
+// a newer status meanwhile are left alone.
+func (h *privateLocationHandler) markReported(ctx context.Context, monitorID int, change statusChange) {
+	var err error
+	switch {
+	case change.Transition:
+		_, err = h.db.ExecContext(ctx, `UPDATE private_location_status SET reported_status = ?
+			WHERE monitor_id = ? AND private_location_id = ? AND status = ?`,
+			change.Status, monitorID, change.LocationID, change.Status)
+	case change.Quorum:
+		_, err = h.db.ExecContext(ctx, `UPDATE monitor_quorum_status SET reported_status = ?
+			WHERE monitor_id = ? AND status = ?`,
+			change.Status, monitorID, change.Status)
+	}
+	if err != nil {
+		slog.Error("failed to mark monitor status reported",
+			"monitor_id", monitorID,
+			"private_location_id", change.LocationID,
+			"error", err.Error(),
+		)
+	}
+}
+
+// statusHandler returns the current status of a monitor at each of its
+// private locations, for the dashboard. It authenticates with the
+// CRON_SECRET shared with the other openstatus services.
+func (h *privateLocationHandler) statusHandler(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	if h.cronSecret == "" || subtle.ConstantTimeCompare([]byte(auth), []byte("Basic "+h.cronSecret)) != 1 {
+		render.Status(r, http.StatusUnauthorized)
+		render.PlainText(w, r, "unauthorized")
+		return
+	}
+
+	monitorID, err := strconv.Atoi(chi.URLParam(r, "monitorID"))
+	if err != nil {
+		render.Status(r, http.StatusNotFound)
+		render.PlainText(w, r, "not found")
+		return
+	}
+
+	statuses := []database.MonitorStatus{}
+	if err := h.db.SelectContext(r.Context(), &statuses, `SELECT monitor_id, private_location_id, status, previous_status, changed_at, flapping, observed_at
+		FROM private_location_status WHERE monitor_id = ? AND status != '' ORDER BY private_location_id`, monitorID); err != nil {
+		if holder := GetEvent(r.Context()); holder != nil {
+			holder.Event["error"] = map[string]any{
+				"message": err.Error(),
+				"source":  "database",
+				"type":    "monitor_status_lookup",
+			}
+		}
+		render.Status(r, http.StatusInternalServerError)
+		render.PlainText(w, r, "internal error")
+		return
+	}
+
+	render.JSON(w, r, statuses)
+}
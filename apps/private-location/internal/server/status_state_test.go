@@ -0,0 +1,170 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/private-location/internal/database"
+	"github.com/openstatushq/openstatus/apps/private-location/internal/workflows"
+)
+
+func TestRecordStatus(t *testing.T) {
+	h, _ := heartbeatTestHandler(t)
+	start := time.UnixMilli(1700000000000)
+
+	steps := []struct {
+		status  string
+		changed bool
+	}{
+		{"active", true},
+		{"active", false},
+		{"degraded", true},
+		{"degraded", false},
+		{"error", true},
+		{"active", true},
+	}
+	for i, step := range steps {
+		status, changed, err := h.recordStatus(context.Background(), 1, 2, step.status, start.Add(time.Duration(i)*time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, step.status, status, "step %d", i)
+		assert.Equal(t, step.changed, changed, "step %d: %s", i, step.status)
+		if changed {
+			h.markReported(context.Background(), 1, statusChange{LocationID: 2, Status: status, Transition: true})
+		}
+	}
+
+	var status database.MonitorStatus
+	require.NoError(t, h.db.Get(&status, `SELECT monitor_id, private_location_id, status, previous_status, changed_at FROM private_location_status`))
+	assert.Equal(t, database.MonitorStatus{
+		MonitorID:         1,
+		PrivateLocationID: 2,
+		Status:            "active",
+		PreviousStatus:    "error",
+		ChangedAt:         start.Add(5 * time.Minute).UnixMilli(),
+	}, status)
+}
+
+func TestRecordStatusFollowsPolicy(t *testing.T) {
+	h, _ := heartbeatTestHandler(t)
+	_, err := h.db.Exec(`INSERT INTO monitor_status_policy (monitor_id, failure_threshold, recovery_threshold, flap_threshold, flap_window_seconds)
+		VALUES (1, 2, 2, 2, 600)`)
+	require.NoError(t, err)
+	start := time.UnixMilli(1700000000000)
+
+	steps := []struct {
+		observed string
+		status   string
+		report   bool
+	}{
+		{"active", "active", true},
+		{"error", "active", false},
+		{"error", "error", true},
+		{"active", "error", false},
+		{"active", "active", true},
+		{"error", "active", false},
+		{"error", "error", false}, // third change within ten minutes
+	}
+	for i, step := range steps {
+		status, report, err := h.recordStatus(context.Background(), 1, 2, step.observed, start.Add(time.Duration(i)*time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, step.status, status, "step %d: status", i)
+		assert.Equal(t, step.report, report, "step %d: report", i)
+		if report {
+			h.markReported(context.Background(), 1, statusChange{LocationID: 2, Status: status, Transition: true})
+		}
+	}
+
+	var status database.MonitorStatus
+	require.NoError(t, h.db.Get(&status, `SELECT status, reported_status, flapping FROM private_location_status`))
+	assert.Equal(t, "error", status.Status)
+	assert.Equal(t, "active", status.ReportedStatus)
+	assert.True(t, status.Flapping)
+}
+
+func TestRecordStatusReportsUntilMarked(t *testing.T) {
+	h, _ := heartbeatTestHandler(t)
+	start := time.UnixMilli(1700000000000)
+
+	// The transition to error was not queued or sent: the next check reports
+	// it again.
+	for i := range 2 {
+		status, report, err := h.recordStatus(context.Background(), 1, 2, "error", start.Add(time.Duration(i)*time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, "error", status)
+		assert.True(t, report, "check %d", i)
+	}
+
+	// A newer status is not marked by a stale report.
+	_, _, err := h.recordStatus(context.Background(), 1, 2, "active", start.Add(2*time.Minute))
+	require.NoError(t, err)
+	h.markReported(context.Background(), 1, statusChange{LocationID: 2, Status: "error", Transition: true})
+
+	var reported string
+	require.NoError(t, h.db.Get(&reported, `SELECT reported_status FROM private_location_status`))
+	assert.Empty(t, reported)
+}
+
+func TestForwardStatusUpdateOnlyForwardsTransitions(t *testing.T) {
+	h, called := heartbeatTestHandler(t)
+	h.Outbox = workflows.NewOutbox(h.db, h.WorkflowsClient, nil, workflows.OutboxOptions{PollInterval: 10 * time.Millisecond})
+	t.Cleanup(func() { h.Outbox.Close(context.Background()) })
+	ic := &ingestContext{
+		Monitor: database.Monitor{ID: 7},
+		Region:  database.PrivateLocation{ID: 9},
+	}
+
+	for _, status := range []string{"error", "error", "active"} {
+		h.forwardStatusUpdate(context.Background(), ic, statusUpdateInput{RequestStatus: status})
+	}
+
+	var statuses []string
+	for len(statuses) < 2 {
+		select {
+		case payload := <-called:
+			statuses = append(statuses, payload.Status)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("expected two status updates, got %v", statuses)
+		}
+	}
+	assert.Equal(t, []string{"error", "active"}, statuses)
+
+	select {
+	case payload := <-called:
+		t.Fatalf("unexpected status update: %+v", payload)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestStatusHandler(t *testing.T) {
+	h, _ := heartbeatTestHandler(t)
+	h.cronSecret = "secret"
+	_, _, err := h.recordStatus(context.Background(), 7, 9, "degraded", time.UnixMilli(1700000000000))
+	require.NoError(t, err)
+
+	r := chi.NewRouter()
+	r.Get("/monitors/{monitorID}/status", h.statusHandler)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/monitors/7/status", nil))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	req := httptest.NewRequest(http.MethodGet, "/monitors/7/status", nil)
+	req.Header.Set("Authorization", "Basic secret")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var statuses []database.MonitorStatus
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &statuses))
+	assert.Equal(t, []database.MonitorStatus{
+		{MonitorID: 7, PrivateLocationID: 9, Status: "degraded", ChangedAt: 1700000000000, ObservedAt: 1700000000000},
+	}, statuses)
+}
@@ -34,22 +34,52 @@ func classifyStatus(requestStatus string, errorFlag uint8) string {
 	}
 }
 
+// forwardStatusUpdate tells workflows when the monitor changed status at
+// the location, as decided by its status policy and quorum. Checks that keep
+// the status are not forwarded.
 func (h *privateLocationHandler) forwardStatusUpdate(ctx context.Context, ic *ingestContext, input statusUpdateInput) {
+	changes := h.statusChanges(ctx, ic.Monitor.ID, ic.Region.ID, classifyStatus(input.RequestStatus, input.ErrorFlag), time.Now())
 	if h.WorkflowsClient == nil {
 		return
 	}
 
-	payload := workflows.Payload{
-		MonitorID:         strconv.Itoa(ic.Monitor.ID),
-		PrivateLocationID: strconv.Itoa(ic.Region.ID),
-		Status:            classifyStatus(input.RequestStatus, input.ErrorFlag),
-		Message:           input.Message,
-		CronTimestamp:     input.CronTimestamp,
-		Latency:           input.Latency,
-		StatusCode:        input.StatusCode,
+	for _, change := range changes {
+		payload := workflows.Payload{
+			MonitorID:         strconv.Itoa(ic.Monitor.ID),
+			PrivateLocationID: strconv.Itoa(change.LocationID),
+			Status:            change.Status,
+			CronTimestamp:     input.CronTimestamp,
+		}
+		// A consensus change is reported for locations that did not run this
+		// check; they do not get its result.
+		if change.LocationID == ic.Region.ID {
+			payload.Message = input.Message
+			payload.Latency = input.Latency
+			payload.StatusCode = input.StatusCode
+		}
+		h.report(ctx, ic.Monitor.ID, change, payload)
+	}
+}
+
+// report hands the payload to the outbox, which retries it until workflows
+// accepts it. Without an outbox, or when it cannot be written to, the payload
+// is sent once, detached from the caller. A transition is only marked
+// reported once it is queued or sent, so that a lost one is reported again
+// with the next check.
+func (h *privateLocationHandler) report(ctx context.Context, monitorID int, change statusChange, payload workflows.Payload) {
+	if h.Outbox != nil {
+		err := h.Outbox.Enqueue(ctx, payload)
+		if err == nil {
+			h.markReported(ctx, monitorID, change)
+			return
+		}
+		slog.Error("failed to enqueue status update",
+			"monitor_id", payload.MonitorID,
+			"private_location_id", payload.PrivateLocationID,
+			"error", err.Error(),
+		)
 	}
 
-	// Detached from the ingest RPC: a lost report self-heals on the next check.
 	go func() {
 		detachedCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
 		defer cancel()
@@ -59,6 +89,8 @@ func (h *privateLocationHandler) forwardStatusUpdate(ctx context.Context, ic *in
 				"private_location_id", payload.PrivateLocationID,
 				"error", err.Error(),
 			)
+			return
 		}
+		h.markReported(detachedCtx, monitorID, change)
 	}()
 }
@@ -52,7 +52,8 @@ func (c recordingWorkflowsClient) Report(ctx context.Context, payload workflows.
 }
 
 func TestForwardStatusUpdateNilClientIsNoop(t *testing.T) {
-	h := &privateLocationHandler{}
+	h, _ := heartbeatTestHandler(t)
+	h.WorkflowsClient = nil
 	ic := &ingestContext{
 		Monitor: database.Monitor{ID: 1},
 		Region:  database.PrivateLocation{ID: 2},
@@ -63,7 +64,8 @@ func TestForwardStatusUpdateNilClientIsNoop(t *testing.T) {
 
 func TestForwardStatusUpdateDoesNotBlock(t *testing.T) {
 	client := recordingWorkflowsClient{called: make(chan workflows.Payload, 1), blockFor: 200 * time.Millisecond}
-	h := &privateLocationHandler{WorkflowsClient: client}
+	h, _ := heartbeatTestHandler(t)
+	h.WorkflowsClient = client
 	ic := &ingestContext{
 		Monitor: database.Monitor{ID: 7},
 		Region:  database.PrivateLocation{ID: 9},
@@ -88,3 +90,25 @@ func TestForwardStatusUpdateDoesNotBlock(t *testing.T) {
 		t.Fatal("Report was never called by the detached goroutine")
 	}
 }
+
+func TestForwardStatusUpdateUsesOutbox(t *testing.T) {
+	h, _ := heartbeatTestHandler(t)
+	client := recordingWorkflowsClient{called: make(chan workflows.Payload, 1)}
+	h.Outbox = workflows.NewOutbox(h.db, client, nil, workflows.OutboxOptions{})
+	defer h.Outbox.Close(context.Background())
+
+	ic := &ingestContext{
+		Monitor: database.Monitor{ID: 7},
+		Region:  database.PrivateLocation{ID: 9},
+	}
+	h.forwardStatusUpdate(context.Background(), ic, statusUpdateInput{RequestStatus: "error"})
+
+	select {
+	case payload := <-client.called:
+		if payload.MonitorID != "7" || payload.Status != "error" {
+			t.Fatalf("unexpected payload: %+v", payload)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("the outbox never sent the status update")
+	}
+}
@@ -0,0 +1,350 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// OutboxOptions tunes the dispatcher. Zero values pick the defaults.
+type OutboxOptions struct {
+	// PollInterval is how often due updates are looked for. Defaults to 1s.
+	PollInterval time.Duration
+	// BatchSize caps the updates sent per pass. Defaults to 100.
+	BatchSize int
+	// Concurrency caps the updates in flight. Defaults to 8.
+	Concurrency int
+	// MinBackoff and MaxBackoff bound the exponential retry delay. They
+	// default to 1s and 5m.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// MaxAge drops updates that could not be sent for that long. Defaults
+	// to 24h.
+	MaxAge time.Duration
+	// ReportTimeout bounds a single delivery. Defaults to 5s.
+	ReportTimeout time.Duration
+}
+
+// Outbox persists status updates in the workflow_outbox table before they
+// are sent, so an unreachable workflows service or a restart delays the
+// alerting of a monitor instead of losing it.
+//
+// Updates of a monitor are sent in the order they were enqueued: only the
+// oldest update of every monitor is sent, the next one once it went through.
+// Several instances can share the table: an update is claimed before it is
+// sent, and the claim expires if its instance dies in between.
+type Outbox struct {
+	db      *sqlx.DB
+	client  Client
+	opts    OutboxOptions
+	metrics *outboxMetrics
+
+	kick      chan struct{}
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+type outboxEntry struct {
+	ID        int64  `db:"id"`
+	MonitorID string `db:"monitor_id"`
+	Payload   string `db:"payload"`
+	Attempts  int    `db:"attempts"`
+}
+
+type outboxMetrics struct {
+	depth     prometheus.Gauge
+	oldestAge prometheus.Gauge
+	delivered prometheus.Counter
+	failures  prometheus.Counter
+	dropped   prometheus.Counter
+}
+
+func newOutboxMetrics(reg prometheus.Registerer) *outboxMetrics {
+	m := &outboxMetrics{
+		depth: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "openstatus_workflow_outbox_depth",
+			Help: "Status updates waiting in the outbox.",
+		}),
+		oldestAge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "openstatus_workflow_outbox_oldest_age_seconds",
+			Help: "Age of the oldest status update waiting in the outbox.",
+		}),
+		delivered: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "openstatus_workflow_outbox_delivered_total",
+			Help: "Status updates sent to workflows.",
+		}),
+		failures: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "openstatus_workflow_outbox_failures_total",
+			Help: "Failed attempts to send a status update to workflows.",
+		}),
+		dropped: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "openstatus_workflow_outbox_dropped_total",
+			Help: "Status updates dropped after exceeding the maximum age.",
+		}),
+	}
+	if reg != nil {
+		reg.MustRegister(m.depth, m.oldestAge, m.delivered, m.failures, m.dropped)
+	}
+	return m
+}
+
+// NewOutbox starts dispatching the updates of the outbox, including the ones
+// left over from a previous run. reg may be nil.
+func NewOutbox(db *sqlx.DB, client Client, reg prometheus.Registerer, opts OutboxOptions) *Outbox {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = time.Second
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 100
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 8
+	}
+	if opts.MinBackoff <= 0 {
+		opts.MinBackoff = time.Second
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = 5 * time.Minute
+	}
+	if opts.MaxAge <= 0 {
+		opts.MaxAge = 24 * time.Hour
+	}
+	if opts.ReportTimeout <= 0 {
+		opts.ReportTimeout = 5 * time.Second
+	}
+
+	o := &Outbox{
+		db:      db,
+		client:  client,
+		opts:    opts,
+		metrics: newOutboxMetrics(reg),
+		kick:    make(chan struct{}, 1),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go o.run()
+
+	return o
+}
+
+// Enqueue persists the update. It is sent in the background.
+func (o *Outbox) Enqueue(ctx context.Context, payload Payload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("unable to encode payload: %w", err)
+	}
+
+	now := time.Now().UnixMilli()
+	if _, err := o.db.ExecContext(ctx, `INSERT INTO workflow_outbox (monitor_id, payload, next_attempt_at, created_at) VALUES (?, ?, ?, ?)`,
+		payload.MonitorID, string(data), now, now); err != nil {
+		return fmt.Errorf("unable to enqueue status update: %w", err)
+	}
+
+	select {
+	case o.kick <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Close stops the dispatcher and tries to send what is left in the outbox,
+// regardless of backoff, until ctx is done. Updates that could not be sent
+// stay in the outbox for the next start.
+func (o *Outbox) Close(ctx context.Context) error {
+	o.closeOnce.Do(func() { close(o.stop) })
+	select {
+	case <-o.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	for ctx.Err() == nil {
+		delivered, err := o.dispatch(ctx, math.MaxInt64)
+		if err != nil {
+			return err
+		}
+		if delivered == 0 {
+			break
+		}
+	}
+
+	var depth int
+	if err := o.db.GetContext(context.WithoutCancel(ctx), &depth, `SELECT count(*) FROM workflow_outbox`); err != nil {
+		return fmt.Errorf("unable to count status updates: %w", err)
+	}
+	if depth > 0 {
+		return fmt.Errorf("%d status updates left in the outbox", depth)
+	}
+	return nil
+}
+
+func (o *Outbox) run() {
+	defer close(o.done)
+
+	ticker := time.NewTicker(o.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-o.stop:
+			return
+		case <-ticker.C:
+		case <-o.kick:
+		}
+
+		// A delivered update can make the next one of its monitor due.
+		for {
+			delivered, err := o.dispatch(context.Background(), time.Now().UnixMilli())
+			if err != nil {
+				slog.Error("failed to dispatch status updates", "error", err.Error())
+			}
+			if delivered == 0 {
+				break
+			}
+		}
+	}
+}
+
+// dispatch sends the oldest update of every monitor that is due by dueBy,
+// in unix milliseconds, and returns how many were delivered. Updates claimed
+// by another dispatcher are skipped.
+func (o *Outbox) dispatch(ctx context.Context, dueBy int64) (int, error) {
+	now := time.Now()
+	defer o.observe(ctx, now)
+
+	res, err := o.db.ExecContext(ctx, `DELETE FROM workflow_outbox WHERE created_at < ?`, now.Add(-o.opts.MaxAge).UnixMilli())
+	if err != nil {
+		return 0, fmt.Errorf("unable to drop expired status updates: %w", err)
+	}
+	if dropped, err := res.RowsAffected(); err == nil && dropped > 0 {
+		o.metrics.dropped.Add(float64(dropped))
+		slog.Error("dropped expired status updates", "count", dropped, "max_age", o.opts.MaxAge.String())
+	}
+
+	var entries []outboxEntry
+	if err := o.db.SelectContext(ctx, &entries, `SELECT id, monitor_id, payload, attempts FROM workflow_outbox
+		WHERE id IN (SELECT min(id) FROM workflow_outbox GROUP BY monitor_id) AND next_attempt_at <= ? AND claimed_until <= ?
+		ORDER BY id LIMIT ?`, dueBy, now.UnixMilli(), o.opts.BatchSize); err != nil {
+		return 0, fmt.Errorf("unable to select status updates: %w", err)
+	}
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		delivered int
+	)
+	slots := make(chan struct{}, o.opts.Concurrency)
+	for _, entry := range entries {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() { <-slots; wg.Done() }()
+			if o.deliver(ctx, entry) {
+				mu.Lock()
+				delivered++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return delivered, nil
+}
+
+// claim reserves the entry for this dispatcher. It fails when another one
+// claimed it since it was selected.
+func (o *Outbox) claim(ctx context.Context, entry outboxEntry) (bool, error) {
+	now := time.Now()
+	// The claim outlives the report and its bookkeeping.
+	res, err := o.db.ExecContext(ctx, `UPDATE workflow_outbox SET claimed_until = ? WHERE id = ? AND claimed_until <= ?`,
+		now.Add(2*o.opts.ReportTimeout).UnixMilli(), entry.ID, now.UnixMilli())
+	if err != nil {
+		return false, err
+	}
+	claimed, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return claimed > 0, nil
+}
+
+// deliver sends the entry and removes it, or schedules its next attempt.
+func (o *Outbox) deliver(ctx context.Context, entry outboxEntry) bool {
+	claimed, err := o.claim(ctx, entry)
+	if err != nil {
+		slog.Error("failed to claim status update", "id", entry.ID, "error", err.Error())
+		return false
+	}
+	if !claimed {
+		return false
+	}
+
+	var payload Payload
+	err = json.Unmarshal([]byte(entry.Payload), &payload)
+	if err == nil {
+		reportCtx, cancel := context.WithTimeout(ctx, o.opts.ReportTimeout)
+		err = o.client.Report(reportCtx, payload)
+		cancel()
+	}
+
+	// The bookkeeping must not be skipped when ctx ends with the report.
+	ctx = context.WithoutCancel(ctx)
+
+	if err == nil {
+		o.metrics.delivered.Inc()
+		if _, err := o.db.ExecContext(ctx, `DELETE FROM workflow_outbox WHERE id = ?`, entry.ID); err != nil {
+			slog.Error("failed to remove delivered status update", "id", entry.ID, "error", err.Error())
+		}
+		return true
+	}
+
+	o.metrics.failures.Inc()
+	attempts := entry.Attempts + 1
+	nextAttempt := time.Now().Add(o.backoff(attempts))
+	slog.Warn("failed to forward status update to workflows",
+		"monitor_id", entry.MonitorID,
+		"attempts", attempts,
+		"next_attempt_at", nextAttempt.Format(time.RFC3339),
+		"error", err.Error(),
+	)
+	if _, err := o.db.ExecContext(ctx, `UPDATE workflow_outbox SET attempts = ?, next_attempt_at = ?, last_error = ?, claimed_until = 0 WHERE id = ?`,
+		attempts, nextAttempt.UnixMilli(), err.Error(), entry.ID); err != nil {
+		slog.Error("failed to reschedule status update", "id", entry.ID, "error", err.Error())
+	}
+	return false
+}
+
+// backoff doubles the delay with every failed attempt.
+func (o *Outbox) backoff(attempts int) time.Duration {
+	delay := o.opts.MinBackoff
+	for i := 1; i < attempts && delay < o.opts.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, o.opts.MaxBackoff)
+}
+
+func (o *Outbox) observe(ctx context.Context, now time.Time) {
+	var stats struct {
+		Depth  int64 `db:"depth"`
+		Oldest int64 `db:"oldest"`
+	}
+	if err := o.db.GetContext(context.WithoutCancel(ctx), &stats, `SELECT count(*) AS depth, COALESCE(min(created_at), 0) AS oldest FROM workflow_outbox`); err != nil {
+		slog.Error("failed to measure the outbox", "error", err.Error())
+		return
+	}
+
+	o.metrics.depth.Set(float64(stats.Depth))
+	if stats.Depth == 0 {
+		o.metrics.oldestAge.Set(0)
+		return
+	}
+	o.metrics.oldestAge.Set(now.Sub(time.UnixMilli(stats.Oldest)).Seconds())
+}
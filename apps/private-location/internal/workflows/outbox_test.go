@@ -0,0 +1,178 @@
+package workflows_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openstatushq/openstatus/apps/private-location/internal/workflows"
+)
+
+func outboxDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+	db, err := sqlx.Connect("sqlite3", t.TempDir()+"/db")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	dat, err := os.ReadFile("./db_testdata")
+	require.NoError(t, err)
+	db.MustExec(string(dat))
+	return db
+}
+
+// flakyClient fails the first failures reports, then records the payloads.
+type flakyClient struct {
+	mu       sync.Mutex
+	failures int
+	reports  []workflows.Payload
+}
+
+func (c *flakyClient) Report(_ context.Context, payload workflows.Payload) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failures > 0 {
+		c.failures--
+		return errors.New("unexpected status code: 503")
+	}
+	c.reports = append(c.reports, payload)
+	return nil
+}
+
+func (c *flakyClient) delivered() []workflows.Payload {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]workflows.Payload(nil), c.reports...)
+}
+
+func TestOutbox_RetriesInOrder(t *testing.T) {
+	db := outboxDB(t)
+	client := &flakyClient{failures: 2}
+	outbox := workflows.NewOutbox(db, client, nil, workflows.OutboxOptions{
+		PollInterval: 10 * time.Millisecond,
+		MinBackoff:   10 * time.Millisecond,
+	})
+	defer outbox.Close(context.Background())
+
+	for _, status := range []string{"error", "degraded", "active"} {
+		require.NoError(t, outbox.Enqueue(context.Background(), workflows.Payload{MonitorID: "1", Status: status}))
+	}
+
+	require.Eventually(t, func() bool { return len(client.delivered()) == 3 }, 2*time.Second, 10*time.Millisecond)
+
+	var statuses []string
+	for _, p := range client.delivered() {
+		statuses = append(statuses, p.Status)
+	}
+	require.Equal(t, []string{"error", "degraded", "active"}, statuses)
+}
+
+func TestOutbox_Backoff(t *testing.T) {
+	db := outboxDB(t)
+	client := &flakyClient{failures: 100}
+	outbox := workflows.NewOutbox(db, client, nil, workflows.OutboxOptions{
+		PollInterval: 10 * time.Millisecond,
+		MinBackoff:   time.Hour,
+	})
+	defer outbox.Close(context.Background())
+
+	require.NoError(t, outbox.Enqueue(context.Background(), workflows.Payload{MonitorID: "1", Status: "error"}))
+
+	require.Eventually(t, func() bool {
+		var attempts int
+		require.NoError(t, db.Get(&attempts, `SELECT attempts FROM workflow_outbox`))
+		return attempts == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// The next attempt is an hour away.
+	time.Sleep(50 * time.Millisecond)
+	var attempts int
+	require.NoError(t, db.Get(&attempts, `SELECT attempts FROM workflow_outbox`))
+	require.Equal(t, 1, attempts)
+}
+
+func TestOutbox_CloseDrains(t *testing.T) {
+	db := outboxDB(t)
+	// Pending updates of a previous run are picked up as well.
+	db.MustExec(`INSERT INTO workflow_outbox (monitor_id, payload, next_attempt_at, created_at) VALUES ('2', '{"monitorId":"2","status":"error"}', ?, ?)`,
+		time.Now().Add(time.Hour).UnixMilli(), time.Now().UnixMilli())
+
+	client := &flakyClient{}
+	reg := prometheus.NewRegistry()
+	outbox := workflows.NewOutbox(db, client, reg, workflows.OutboxOptions{PollInterval: time.Hour})
+
+	require.NoError(t, outbox.Enqueue(context.Background(), workflows.Payload{MonitorID: "1", Status: "error"}))
+	require.Eventually(t, func() bool {
+		return testutil.GatherAndCompare(reg, strings.NewReader(`
+# HELP openstatus_workflow_outbox_delivered_total Status updates sent to workflows.
+# TYPE openstatus_workflow_outbox_delivered_total counter
+openstatus_workflow_outbox_delivered_total 1
+# HELP openstatus_workflow_outbox_depth Status updates waiting in the outbox.
+# TYPE openstatus_workflow_outbox_depth gauge
+openstatus_workflow_outbox_depth 1
+`), "openstatus_workflow_outbox_delivered_total", "openstatus_workflow_outbox_depth") == nil
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, outbox.Close(context.Background()))
+	require.Len(t, client.delivered(), 2)
+	require.Equal(t, "2", client.delivered()[1].MonitorID)
+}
+
+func TestOutbox_CloseKeepsUndelivered(t *testing.T) {
+	db := outboxDB(t)
+	client := &flakyClient{failures: 100}
+	outbox := workflows.NewOutbox(db, client, nil, workflows.OutboxOptions{PollInterval: time.Hour})
+
+	require.NoError(t, outbox.Enqueue(context.Background(), workflows.Payload{MonitorID: "1", Status: "error"}))
+
+	require.ErrorContains(t, outbox.Close(context.Background()), "1 status updates left")
+	var depth int
+	require.NoError(t, db.Get(&depth, `SELECT count(*) FROM workflow_outbox`))
+	require.Equal(t, 1, depth)
+}
+
+func TestOutbox_DropsExpired(t *testing.T) {
+	db := outboxDB(t)
+	db.MustExec(`INSERT INTO workflow_outbox (monitor_id, payload, next_attempt_at, created_at) VALUES ('1', '{}', 0, ?)`,
+		time.Now().Add(-2*time.Hour).UnixMilli())
+
+	client := &flakyClient{}
+	outbox := workflows.NewOutbox(db, client, nil, workflows.OutboxOptions{
+		PollInterval: 10 * time.Millisecond,
+		MaxAge:       time.Hour,
+	})
+
+	require.Eventually(t, func() bool {
+		var depth int
+		require.NoError(t, db.Get(&depth, `SELECT count(*) FROM workflow_outbox`))
+		return depth == 0
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, outbox.Close(context.Background()))
+	require.Empty(t, client.delivered())
+}
+
+func TestOutbox_SkipsClaimed(t *testing.T) {
+	db := outboxDB(t)
+	// Another instance is sending the update.
+	db.MustExec(`INSERT INTO workflow_outbox (monitor_id, payload, next_attempt_at, claimed_until, created_at) VALUES ('1', '{"monitorId":"1","status":"error"}', 0, ?, ?)`,
+		time.Now().Add(time.Hour).UnixMilli(), time.Now().UnixMilli())
+
+	client := &flakyClient{}
+	outbox := workflows.NewOutbox(db, client, nil, workflows.OutboxOptions{PollInterval: 10 * time.Millisecond})
+
+	time.Sleep(50 * time.Millisecond)
+	require.Empty(t, client.delivered())
+
+	// Once the claim expired, the update is sent here.
+	db.MustExec(`UPDATE workflow_outbox SET claimed_until = 0`)
+	require.Eventually(t, func() bool { return len(client.delivered()) == 1 }, time.Second, 10*time.Millisecond)
+	require.NoError(t, outbox.Close(context.Background()))
+}
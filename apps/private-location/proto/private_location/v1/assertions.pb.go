@@ -434,6 +434,328 @@ func (x *RecordAssertion) GetTarget() string {
 	return ""
 }
 
+type JsonBodyAssertion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Comparator    StringComparator       `protobuf:"varint,2,opt,name=comparator,proto3,enum=private_location.v1.StringComparator" json:"comparator,omitempty"`
+	Target        string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JsonBodyAssertion) Reset() {
+	*x = JsonBodyAssertion{}
+	mi := &file_private_location_v1_assertions_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JsonBodyAssertion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JsonBodyAssertion) ProtoMessage() {}
+
+func (x *JsonBodyAssertion) ProtoReflect() protoreflect.Message {
+	mi := &file_private_location_v1_assertions_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JsonBodyAssertion.ProtoReflect.Descriptor instead.
+func (*JsonBodyAssertion) Descriptor() ([]byte, []int) {
+	return file_private_location_v1_assertions_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *JsonBodyAssertion) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *JsonBodyAssertion) GetComparator() StringComparator {
+	if x != nil {
+		return x.Comparator
+	}
+	return StringComparator_STRING_COMPARATOR_UNSPECIFIED
+}
+
+func (x *JsonBodyAssertion) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type RowCountAssertion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        int64                  `protobuf:"varint,1,opt,name=target,proto3" json:"target,omitempty"`
+	Comparator    NumberComparator       `protobuf:"varint,2,opt,name=comparator,proto3,enum=private_location.v1.NumberComparator" json:"comparator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RowCountAssertion) Reset() {
+	*x = RowCountAssertion{}
+	mi := &file_private_location_v1_assertions_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RowCountAssertion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowCountAssertion) ProtoMessage() {}
+
+func (x *RowCountAssertion) ProtoReflect() protoreflect.Message {
+	mi := &file_private_location_v1_assertions_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowCountAssertion.ProtoReflect.Descriptor instead.
+func (*RowCountAssertion) Descriptor() ([]byte, []int) {
+	return file_private_location_v1_assertions_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RowCountAssertion) GetTarget() int64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *RowCountAssertion) GetComparator() NumberComparator {
+	if x != nil {
+		return x.Comparator
+	}
+	return NumberComparator_NUMBER_COMPARATOR_UNSPECIFIED
+}
+
+type ValueAssertion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Comparator    StringComparator       `protobuf:"varint,2,opt,name=comparator,proto3,enum=private_location.v1.StringComparator" json:"comparator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValueAssertion) Reset() {
+	*x = ValueAssertion{}
+	mi := &file_private_location_v1_assertions_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValueAssertion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValueAssertion) ProtoMessage() {}
+
+func (x *ValueAssertion) ProtoReflect() protoreflect.Message {
+	mi := &file_private_location_v1_assertions_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValueAssertion.ProtoReflect.Descriptor instead.
+func (*ValueAssertion) Descriptor() ([]byte, []int) {
+	return file_private_location_v1_assertions_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ValueAssertion) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ValueAssertion) GetComparator() StringComparator {
+	if x != nil {
+		return x.Comparator
+	}
+	return StringComparator_STRING_COMPARATOR_UNSPECIFIED
+}
+
+// Evaluated against the absolute clock offset in milliseconds.
+type OffsetAssertion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        int64                  `protobuf:"varint,1,opt,name=target,proto3" json:"target,omitempty"`
+	Comparator    NumberComparator       `protobuf:"varint,2,opt,name=comparator,proto3,enum=private_location.v1.NumberComparator" json:"comparator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OffsetAssertion) Reset() {
+	*x = OffsetAssertion{}
+	mi := &file_private_location_v1_assertions_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OffsetAssertion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffsetAssertion) ProtoMessage() {}
+
+func (x *OffsetAssertion) ProtoReflect() protoreflect.Message {
+	mi := &file_private_location_v1_assertions_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffsetAssertion.ProtoReflect.Descriptor instead.
+func (*OffsetAssertion) Descriptor() ([]byte, []int) {
+	return file_private_location_v1_assertions_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OffsetAssertion) GetTarget() int64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *OffsetAssertion) GetComparator() NumberComparator {
+	if x != nil {
+		return x.Comparator
+	}
+	return NumberComparator_NUMBER_COMPARATOR_UNSPECIFIED
+}
+
+type StratumAssertion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        int64                  `protobuf:"varint,1,opt,name=target,proto3" json:"target,omitempty"`
+	Comparator    NumberComparator       `protobuf:"varint,2,opt,name=comparator,proto3,enum=private_location.v1.NumberComparator" json:"comparator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StratumAssertion) Reset() {
+	*x = StratumAssertion{}
+	mi := &file_private_location_v1_assertions_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StratumAssertion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StratumAssertion) ProtoMessage() {}
+
+func (x *StratumAssertion) ProtoReflect() protoreflect.Message {
+	mi := &file_private_location_v1_assertions_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StratumAssertion.ProtoReflect.Descriptor instead.
+func (*StratumAssertion) Descriptor() ([]byte, []int) {
+	return file_private_location_v1_assertions_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StratumAssertion) GetTarget() int64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *StratumAssertion) GetComparator() NumberComparator {
+	if x != nil {
+		return x.Comparator
+	}
+	return NumberComparator_NUMBER_COMPARATOR_UNSPECIFIED
+}
+
+// Evaluated against the whole days left until the registration expires.
+type ExpiryAssertion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        int64                  `protobuf:"varint,1,opt,name=target,proto3" json:"target,omitempty"`
+	Comparator    NumberComparator       `protobuf:"varint,2,opt,name=comparator,proto3,enum=private_location.v1.NumberComparator" json:"comparator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpiryAssertion) Reset() {
+	*x = ExpiryAssertion{}
+	mi := &file_private_location_v1_assertions_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpiryAssertion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpiryAssertion) ProtoMessage() {}
+
+func (x *ExpiryAssertion) ProtoReflect() protoreflect.Message {
+	mi := &file_private_location_v1_assertions_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpiryAssertion.ProtoReflect.Descriptor instead.
+func (*ExpiryAssertion) Descriptor() ([]byte, []int) {
+	return file_private_location_v1_assertions_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExpiryAssertion) GetTarget() int64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *ExpiryAssertion) GetComparator() NumberComparator {
+	if x != nil {
+		return x.Comparator
+	}
+	return NumberComparator_NUMBER_COMPARATOR_UNSPECIFIED
+}
+
 var File_private_location_v1_assertions_proto protoreflect.FileDescriptor
 
 const file_private_location_v1_assertions_proto_rawDesc = "" +
@@ -460,7 +782,38 @@ const file_private_location_v1_assertions_proto_rawDesc = "" +
 	"\n" +
 	"comparator\x18\x02 \x01(\x0e2%.private_location.v1.RecordComparatorR\n" +
 	"comparator\x12\x16\n" +
-	"\x06target\x18\x03 \x01(\tR\x06target*\x8f\x02\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\"\x86\x01\n" +
+	"\x11JsonBodyAssertion\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12E\n" +
+	"\n" +
+	"comparator\x18\x02 \x01(\x0e2%.private_location.v1.StringComparatorR\n" +
+	"comparator\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\"r\n" +
+	"\x11RowCountAssertion\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\x03R\x06target\x12E\n" +
+	"\n" +
+	"comparator\x18\x02 \x01(\x0e2%.private_location.v1.NumberComparatorR\n" +
+	"comparator\"o\n" +
+	"\x0eValueAssertion\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12E\n" +
+	"\n" +
+	"comparator\x18\x02 \x01(\x0e2%.private_location.v1.StringComparatorR\n" +
+	"comparator\"p\n" +
+	"\x0fOffsetAssertion\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\x03R\x06target\x12E\n" +
+	"\n" +
+	"comparator\x18\x02 \x01(\x0e2%.private_location.v1.NumberComparatorR\n" +
+	"comparator\"q\n" +
+	"\x10StratumAssertion\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\x03R\x06target\x12E\n" +
+	"\n" +
+	"comparator\x18\x02 \x01(\x0e2%.private_location.v1.NumberComparatorR\n" +
+	"comparator\"p\n" +
+	"\x0fExpiryAssertion\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\x03R\x06target\x12E\n" +
+	"\n" +
+	"comparator\x18\x02 \x01(\x0e2%.private_location.v1.NumberComparatorR\n" +
+	"comparator*\x8f\x02\n" +
 	"\x10NumberComparator\x12!\n" +
 	"\x1dNUMBER_COMPARATOR_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17NUMBER_COMPARATOR_EQUAL\x10\x01\x12\x1f\n" +
@@ -502,7 +855,7 @@ func file_private_location_v1_assertions_proto_rawDescGZIP() []byte {
 }
 
 var file_private_location_v1_assertions_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_private_location_v1_assertions_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_private_location_v1_assertions_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_private_location_v1_assertions_proto_goTypes = []any{
 	(NumberComparator)(0),       // 0: private_location.v1.NumberComparator
 	(StringComparator)(0),       // 1: private_location.v1.StringComparator
@@ -511,17 +864,29 @@ var file_private_location_v1_assertions_proto_goTypes = []any{
 	(*BodyAssertion)(nil),       // 4: private_location.v1.BodyAssertion
 	(*HeaderAssertion)(nil),     // 5: private_location.v1.HeaderAssertion
 	(*RecordAssertion)(nil),     // 6: private_location.v1.RecordAssertion
+	(*JsonBodyAssertion)(nil),   // 7: private_location.v1.JsonBodyAssertion
+	(*RowCountAssertion)(nil),   // 8: private_location.v1.RowCountAssertion
+	(*ValueAssertion)(nil),      // 9: private_location.v1.ValueAssertion
+	(*OffsetAssertion)(nil),     // 10: private_location.v1.OffsetAssertion
+	(*StratumAssertion)(nil),    // 11: private_location.v1.StratumAssertion
+	(*ExpiryAssertion)(nil),     // 12: private_location.v1.ExpiryAssertion
 }
 var file_private_location_v1_assertions_proto_depIdxs = []int32{
-	0, // 0: private_location.v1.StatusCodeAssertion.comparator:type_name -> private_location.v1.NumberComparator
-	1, // 1: private_location.v1.BodyAssertion.comparator:type_name -> private_location.v1.StringComparator
-	1, // 2: private_location.v1.HeaderAssertion.comparator:type_name -> private_location.v1.StringComparator
-	2, // 3: private_location.v1.RecordAssertion.comparator:type_name -> private_location.v1.RecordComparator
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: private_location.v1.StatusCodeAssertion.comparator:type_name -> private_location.v1.NumberComparator
+	1,  // 1: private_location.v1.BodyAssertion.comparator:type_name -> private_location.v1.StringComparator
+	1,  // 2: private_location.v1.HeaderAssertion.comparator:type_name -> private_location.v1.StringComparator
+	2,  // 3: private_location.v1.RecordAssertion.comparator:type_name -> private_location.v1.RecordComparator
+	1,  // 4: private_location.v1.JsonBodyAssertion.comparator:type_name -> private_location.v1.StringComparator
+	0,  // 5: private_location.v1.RowCountAssertion.comparator:type_name -> private_location.v1.NumberComparator
+	1,  // 6: private_location.v1.ValueAssertion.comparator:type_name -> private_location.v1.StringComparator
+	0,  // 7: private_location.v1.OffsetAssertion.comparator:type_name -> private_location.v1.NumberComparator
+	0,  // 8: private_location.v1.StratumAssertion.comparator:type_name -> private_location.v1.NumberComparator
+	0,  // 9: private_location.v1.ExpiryAssertion.comparator:type_name -> private_location.v1.NumberComparator
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_private_location_v1_assertions_proto_init() }
@@ -535,7 +900,7 @@ func file_private_location_v1_assertions_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_private_location_v1_assertions_proto_rawDesc), len(file_private_location_v1_assertions_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   4,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
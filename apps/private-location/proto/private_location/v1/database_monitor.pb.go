@@ -0,0 +1,290 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: private_location/v1/database_monitor.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DatabaseEngine int32
+
+const (
+	DatabaseEngine_DATABASE_ENGINE_UNSPECIFIED DatabaseEngine = 0
+	DatabaseEngine_DATABASE_ENGINE_POSTGRES    DatabaseEngine = 1
+	DatabaseEngine_DATABASE_ENGINE_MYSQL       DatabaseEngine = 2
+	DatabaseEngine_DATABASE_ENGINE_REDIS       DatabaseEngine = 3
+)
+
+// Enum value maps for DatabaseEngine.
+var (
+	DatabaseEngine_name = map[int32]string{
+		0: "DATABASE_ENGINE_UNSPECIFIED",
+		1: "DATABASE_ENGINE_POSTGRES",
+		2: "DATABASE_ENGINE_MYSQL",
+		3: "DATABASE_ENGINE_REDIS",
+	}
+	DatabaseEngine_value = map[string]int32{
+		"DATABASE_ENGINE_UNSPECIFIED": 0,
+		"DATABASE_ENGINE_POSTGRES":    1,
+		"DATABASE_ENGINE_MYSQL":       2,
+		"DATABASE_ENGINE_REDIS":       3,
+	}
+)
+
+func (x DatabaseEngine) Enum() *DatabaseEngine {
+	p := new(DatabaseEngine)
+	*p = x
+	return p
+}
+
+func (x DatabaseEngine) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatabaseEngine) Descriptor() protoreflect.EnumDescriptor {
+	return file_private_location_v1_database_monitor_proto_enumTypes[0].Descriptor()
+}
+
+func (DatabaseEngine) Type() protoreflect.EnumType {
+	return &file_private_location_v1_database_monitor_proto_enumTypes[0]
+}
+
+func (x DatabaseEngine) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DatabaseEngine.Descriptor instead.
+func (DatabaseEngine) EnumDescriptor() ([]byte, []int) {
+	return file_private_location_v1_database_monitor_proto_rawDescGZIP(), []int{0}
+}
+
+type DatabaseMonitor struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Engine DatabaseEngine         `protobuf:"varint,2,opt,name=engine,proto3,enum=private_location.v1.DatabaseEngine" json:"engine,omitempty"`
+	// Connection URL including credentials, e.g.
+	// postgres://user:pass@db:5432/app?sslmode=disable or redis://:pass@cache:6379/0.
+	Uri string `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	// Defaults to `SELECT 1` for SQL engines and `PING` for Redis.
+	Query              string               `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	Periodicity        string               `protobuf:"bytes,5,opt,name=periodicity,proto3" json:"periodicity,omitempty"`
+	Timeout            int64                `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	DegradedAt         *int64               `protobuf:"varint,7,opt,name=degraded_at,json=degradedAt,proto3,oneof" json:"degraded_at,omitempty"`
+	Retry              int64                `protobuf:"varint,8,opt,name=retry,proto3" json:"retry,omitempty"`
+	RowCountAssertions []*RowCountAssertion `protobuf:"bytes,9,rep,name=row_count_assertions,json=rowCountAssertions,proto3" json:"row_count_assertions,omitempty"`
+	// Evaluated against the first column of the first row (the reply for Redis).
+	ValueAssertions []*ValueAssertion `protobuf:"bytes,10,rep,name=value_assertions,json=valueAssertions,proto3" json:"value_assertions,omitempty"`
+	OtelConfig      *OtelConfig       `protobuf:"bytes,20,opt,name=otel_config,json=otelConfig,proto3" json:"otel_config,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DatabaseMonitor) Reset() {
+	*x = DatabaseMonitor{}
+	mi := &file_private_location_v1_database_monitor_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseMonitor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseMonitor) ProtoMessage() {}
+
+func (x *DatabaseMonitor) ProtoReflect() protoreflect.Message {
+	mi := &file_private_location_v1_database_monitor_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseMonitor.ProtoReflect.Descriptor instead.
+func (*DatabaseMonitor) Descriptor() ([]byte, []int) {
+	return file_private_location_v1_database_monitor_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DatabaseMonitor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DatabaseMonitor) GetEngine() DatabaseEngine {
+	if x != nil {
+		return x.Engine
+	}
+	return DatabaseEngine_DATABASE_ENGINE_UNSPECIFIED
+}
+
+func (x *DatabaseMonitor) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *DatabaseMonitor) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *DatabaseMonitor) GetPeriodicity() string {
+	if x != nil {
+		return x.Periodicity
+	}
+	return ""
+}
+
+func (x *DatabaseMonitor) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *DatabaseMonitor) GetDegradedAt() int64 {
+	if x != nil && x.DegradedAt != nil {
+		return *x.DegradedAt
+	}
+	return 0
+}
+
+func (x *DatabaseMonitor) GetRetry() int64 {
+	if x != nil {
+		return x.Retry
+	}
+	return 0
+}
+
+func (x *DatabaseMonitor) GetRowCountAssertions() []*RowCountAssertion {
+	if x != nil {
+		return x.RowCountAssertions
+	}
+	return nil
+}
+
+func (x *DatabaseMonitor) GetValueAssertions() []*ValueAssertion {
+	if x != nil {
+		return x.ValueAssertions
+	}
+	return nil
+}
+
+func (x *DatabaseMonitor) GetOtelConfig() *OtelConfig {
+	if x != nil {
+		return x.OtelConfig
+	}
+	return nil
+}
+
+var File_private_location_v1_database_monitor_proto protoreflect.FileDescriptor
+
+const file_private_location_v1_database_monitor_proto_rawDesc = "" +
+	"\n" +
+	"*private_location/v1/database_monitor.proto\x12\x13private_location.v1\x1a$private_location/v1/assertions.proto\x1a\x1eprivate_location/v1/otel.proto\"\xfa\x03\n" +
+	"\x0fDatabaseMonitor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
+	"\x06engine\x18\x02 \x01(\x0e2#.private_location.v1.DatabaseEngineR\x06engine\x12\x10\n" +
+	"\x03uri\x18\x03 \x01(\tR\x03uri\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\x12 \n" +
+	"\vperiodicity\x18\x05 \x01(\tR\vperiodicity\x12\x18\n" +
+	"\atimeout\x18\x06 \x01(\x03R\atimeout\x12$\n" +
+	"\vdegraded_at\x18\a \x01(\x03H\x00R\n" +
+	"degradedAt\x88\x01\x01\x12\x14\n" +
+	"\x05retry\x18\b \x01(\x03R\x05retry\x12X\n" +
+	"\x14row_count_assertions\x18\t \x03(\v2&.private_location.v1.RowCountAssertionR\x12rowCountAssertions\x12N\n" +
+	"\x10value_assertions\x18\n" +
+	" \x03(\v2#.private_location.v1.ValueAssertionR\x0fvalueAssertions\x12@\n" +
+	"\votel_config\x18\x14 \x01(\v2\x1f.private_location.v1.OtelConfigR\n" +
+	"otelConfigB\x0e\n" +
+	"\f_degraded_at*\x85\x01\n" +
+	"\x0eDatabaseEngine\x12\x1f\n" +
+	"\x1bDATABASE_ENGINE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18DATABASE_ENGINE_POSTGRES\x10\x01\x12\x19\n" +
+	"\x15DATABASE_ENGINE_MYSQL\x10\x02\x12\x19\n" +
+	"\x15DATABASE_ENGINE_REDIS\x10\x03BJZHgithub.com/openstatushq/openstatus/packages/proto/private_location/v1;v1b\x06proto3"
+
+var (
+	file_private_location_v1_database_monitor_proto_rawDescOnce sync.Once
+	file_private_location_v1_database_monitor_proto_rawDescData []byte
+)
+
+func file_private_location_v1_database_monitor_proto_rawDescGZIP() []byte {
+	file_private_location_v1_database_monitor_proto_rawDescOnce.Do(func() {
+		file_private_location_v1_database_monitor_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_private_location_v1_database_monitor_proto_rawDesc), len(file_private_location_v1_database_monitor_proto_rawDesc)))
+	})
+	return file_private_location_v1_database_monitor_proto_rawDescData
+}
+
+var file_private_location_v1_database_monitor_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_private_location_v1_database_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_private_location_v1_database_monitor_proto_goTypes = []any{
+	(DatabaseEngine)(0),       // 0: private_location.v1.DatabaseEngine
+	(*DatabaseMonitor)(nil),   // 1: private_location.v1.DatabaseMonitor
+	(*RowCountAssertion)(nil), // 2: private_location.v1.RowCountAssertion
+	(*ValueAssertion)(nil),    // 3: private_location.v1.ValueAssertion
+	(*OtelConfig)(nil),        // 4: private_location.v1.OtelConfig
+}
+var file_private_location_v1_database_monitor_proto_depIdxs = []int32{
+	0, // 0: private_location.v1.DatabaseMonitor.engine:type_name -> private_location.v1.DatabaseEngine
+	2, // 1: private_location.v1.DatabaseMonitor.row_count_assertions:type_name -> private_location.v1.RowCountAssertion
+	3, // 2: private_location.v1.DatabaseMonitor.value_assertions:type_name -> private_location.v1.ValueAssertion
+	4, // 3: private_location.v1.DatabaseMonitor.otel_config:type_name -> private_location.v1.OtelConfig
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_private_location_v1_database_monitor_proto_init() }
+func file_private_location_v1_database_monitor_proto_init() {
+	if File_private_location_v1_database_monitor_proto != nil {
+		return
+	}
+	file_private_location_v1_assertions_proto_init()
+	file_private_location_v1_otel_proto_init()
+	file_private_location_v1_database_monitor_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_private_location_v1_database_monitor_proto_rawDesc), len(file_private_location_v1_database_monitor_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_private_location_v1_database_monitor_proto_goTypes,
+		DependencyIndexes: file_private_location_v1_database_monitor_proto_depIdxs,
+		EnumInfos:         file_private_location_v1_database_monitor_proto_enumTypes,
+		MessageInfos:      file_private_location_v1_database_monitor_proto_msgTypes,
+	}.Build()
+	File_private_location_v1_database_monitor_proto = out.File
+	file_private_location_v1_database_monitor_proto_goTypes = nil
+	file_private_location_v1_database_monitor_proto_depIdxs = nil
+}
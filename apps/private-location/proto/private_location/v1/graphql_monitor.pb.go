@@ -0,0 +1,280 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: private_location/v1/graphql_monitor.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GraphQLMonitor struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url         string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Periodicity string                 `protobuf:"bytes,3,opt,name=periodicity,proto3" json:"periodicity,omitempty"`
+	Query       string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	// JSON-encoded variables object, empty when the operation takes none.
+	Variables            string                 `protobuf:"bytes,5,opt,name=variables,proto3" json:"variables,omitempty"`
+	OperationName        string                 `protobuf:"bytes,6,opt,name=operation_name,json=operationName,proto3" json:"operation_name,omitempty"`
+	Timeout              int64                  `protobuf:"varint,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	DegradedAt           *int64                 `protobuf:"varint,8,opt,name=degraded_at,json=degradedAt,proto3,oneof" json:"degraded_at,omitempty"`
+	Retry                int64                  `protobuf:"varint,9,opt,name=retry,proto3" json:"retry,omitempty"`
+	FollowRedirects      bool                   `protobuf:"varint,10,opt,name=follow_redirects,json=followRedirects,proto3" json:"follow_redirects,omitempty"`
+	Headers              []*Headers             `protobuf:"bytes,11,rep,name=headers,proto3" json:"headers,omitempty"`
+	StatusCodeAssertions []*StatusCodeAssertion `protobuf:"bytes,12,rep,name=status_code_assertions,json=statusCodeAssertions,proto3" json:"status_code_assertions,omitempty"`
+	HeaderAssertions     []*HeaderAssertion     `protobuf:"bytes,13,rep,name=header_assertions,json=headerAssertions,proto3" json:"header_assertions,omitempty"`
+	// Evaluated against the `data` object of the GraphQL response.
+	DataAssertions []*JsonBodyAssertion `protobuf:"bytes,14,rep,name=data_assertions,json=dataAssertions,proto3" json:"data_assertions,omitempty"`
+	// Authenticates the request the same way as for HTTP monitors.
+	Auth          *HTTPAuth   `protobuf:"bytes,15,opt,name=auth,proto3" json:"auth,omitempty"`
+	OtelConfig    *OtelConfig `protobuf:"bytes,20,opt,name=otel_config,json=otelConfig,proto3" json:"otel_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GraphQLMonitor) Reset() {
+	*x = GraphQLMonitor{}
+	mi := &file_private_location_v1_graphql_monitor_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphQLMonitor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphQLMonitor) ProtoMessage() {}
+
+func (x *GraphQLMonitor) ProtoReflect() protoreflect.Message {
+	mi := &file_private_location_v1_graphql_monitor_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphQLMonitor.ProtoReflect.Descriptor instead.
+func (*GraphQLMonitor) Descriptor() ([]byte, []int) {
+	return file_private_location_v1_graphql_monitor_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GraphQLMonitor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GraphQLMonitor) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GraphQLMonitor) GetPeriodicity() string {
+	if x != nil {
+		return x.Periodicity
+	}
+	return ""
+}
+
+func (x *GraphQLMonitor) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GraphQLMonitor) GetVariables() string {
+	if x != nil {
+		return x.Variables
+	}
+	return ""
+}
+
+func (x *GraphQLMonitor) GetOperationName() string {
+	if x != nil {
+		return x.OperationName
+	}
+	return ""
+}
+
+func (x *GraphQLMonitor) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *GraphQLMonitor) GetDegradedAt() int64 {
+	if x != nil && x.DegradedAt != nil {
+		return *x.DegradedAt
+	}
+	return 0
+}
+
+func (x *GraphQLMonitor) GetRetry() int64 {
+	if x != nil {
+		return x.Retry
+	}
+	return 0
+}
+
+func (x *GraphQLMonitor) GetFollowRedirects() bool {
+	if x != nil {
+		return x.FollowRedirects
+	}
+	return false
+}
+
+func (x *GraphQLMonitor) GetHeaders() []*Headers {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *GraphQLMonitor) GetStatusCodeAssertions() []*StatusCodeAssertion {
+	if x != nil {
+		return x.StatusCodeAssertions
+	}
+	return nil
+}
+
+func (x *GraphQLMonitor) GetHeaderAssertions() []*HeaderAssertion {
+	if x != nil {
+		return x.HeaderAssertions
+	}
+	return nil
+}
+
+func (x *GraphQLMonitor) GetDataAssertions() []*JsonBodyAssertion {
+	if x != nil {
+		return x.DataAssertions
+	}
+	return nil
+}
+
+func (x *GraphQLMonitor) GetAuth() *HTTPAuth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *GraphQLMonitor) GetOtelConfig() *OtelConfig {
+	if x != nil {
+		return x.OtelConfig
+	}
+	return nil
+}
+
+var File_private_location_v1_graphql_monitor_proto protoreflect.FileDescriptor
+
+const file_private_location_v1_graphql_monitor_proto_rawDesc = "" +
+	"\n" +
+	")private_location/v1/graphql_monitor.proto\x12\x13private_location.v1\x1a$private_location/v1/assertions.proto\x1a&private_location/v1/http_monitor.proto\x1a\x1eprivate_location/v1/otel.proto\"\xf1\x05\n" +
+	"\x0eGraphQLMonitor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12 \n" +
+	"\vperiodicity\x18\x03 \x01(\tR\vperiodicity\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\x12\x1c\n" +
+	"\tvariables\x18\x05 \x01(\tR\tvariables\x12%\n" +
+	"\x0eoperation_name\x18\x06 \x01(\tR\roperationName\x12\x18\n" +
+	"\atimeout\x18\a \x01(\x03R\atimeout\x12$\n" +
+	"\vdegraded_at\x18\b \x01(\x03H\x00R\n" +
+	"degradedAt\x88\x01\x01\x12\x14\n" +
+	"\x05retry\x18\t \x01(\x03R\x05retry\x12)\n" +
+	"\x10follow_redirects\x18\n" +
+	" \x01(\bR\x0ffollowRedirects\x126\n" +
+	"\aheaders\x18\v \x03(\v2\x1c.private_location.v1.HeadersR\aheaders\x12^\n" +
+	"\x16status_code_assertions\x18\f \x03(\v2(.private_location.v1.StatusCodeAssertionR\x14statusCodeAssertions\x12Q\n" +
+	"\x11header_assertions\x18\r \x03(\v2$.private_location.v1.HeaderAssertionR\x10headerAssertions\x12O\n" +
+	"\x0fdata_assertions\x18\x0e \x03(\v2&.private_location.v1.JsonBodyAssertionR\x0edataAssertions\x121\n" +
+	"\x04auth\x18\x0f \x01(\v2\x1d.private_location.v1.HTTPAuthR\x04auth\x12@\n" +
+	"\votel_config\x18\x14 \x01(\v2\x1f.private_location.v1.OtelConfigR\n" +
+	"otelConfigB\x0e\n" +
+	"\f_degraded_atBJZHgithub.com/openstatushq/openstatus/packages/proto/private_location/v1;v1b\x06proto3"
+
+var (
+	file_private_location_v1_graphql_monitor_proto_rawDescOnce sync.Once
+	file_private_location_v1_graphql_monitor_proto_rawDescData []byte
+)
+
+func file_private_location_v1_graphql_monitor_proto_rawDescGZIP() []byte {
+	file_private_location_v1_graphql_monitor_proto_rawDescOnce.Do(func() {
+		file_private_location_v1_graphql_monitor_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_private_location_v1_graphql_monitor_proto_rawDesc), len(file_private_location_v1_graphql_monitor_proto_rawDesc)))
+	})
+	return file_private_location_v1_graphql_monitor_proto_rawDescData
+}
+
+var file_private_location_v1_graphql_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_private_location_v1_graphql_monitor_proto_goTypes = []any{
+	(*GraphQLMonitor)(nil),      // 0: private_location.v1.GraphQLMonitor
+	(*Headers)(nil),             // 1: private_location.v1.Headers
+	(*StatusCodeAssertion)(nil), // 2: private_location.v1.StatusCodeAssertion
+	(*HeaderAssertion)(nil),     // 3: private_location.v1.HeaderAssertion
+	(*JsonBodyAssertion)(nil),   // 4: private_location.v1.JsonBodyAssertion
+	(*HTTPAuth)(nil),            // 5: private_location.v1.HTTPAuth
+	(*OtelConfig)(nil),          // 6: private_location.v1.OtelConfig
+}
+var file_private_location_v1_graphql_monitor_proto_depIdxs = []int32{
+	1, // 0: private_location.v1.GraphQLMonitor.headers:type_name -> private_location.v1.Headers
+	2, // 1: private_location.v1.GraphQLMonitor.status_code_assertions:type_name -> private_location.v1.StatusCodeAssertion
+	3, // 2: private_location.v1.GraphQLMonitor.header_assertions:type_name -> private_location.v1.HeaderAssertion
+	4, // 3: private_location.v1.GraphQLMonitor.data_assertions:type_name -> private_location.v1.JsonBodyAssertion
+	5, // 4: private_location.v1.GraphQLMonitor.auth:type_name -> private_location.v1.HTTPAuth
+	6, // 5: private_location.v1.GraphQLMonitor.otel_config:type_name -> private_location.v1.OtelConfig
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_private_location_v1_graphql_monitor_proto_init() }
+func file_private_location_v1_graphql_monitor_proto_init() {
+	if File_private_location_v1_graphql_monitor_proto != nil {
+		return
+	}
+	file_private_location_v1_assertions_proto_init()
+	file_private_location_v1_http_monitor_proto_init()
+	file_private_location_v1_otel_proto_init()
+	file_private_location_v1_graphql_monitor_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_private_location_v1_graphql_monitor_proto_rawDesc), len(file_private_location_v1_graphql_monitor_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_private_location_v1_graphql_monitor_proto_goTypes,
+		DependencyIndexes: file_private_location_v1_graphql_monitor_proto_depIdxs,
+		MessageInfos:      file_private_location_v1_graphql_monitor_proto_msgTypes,
+	}.Build()
+	File_private_location_v1_graphql_monitor_proto = out.File
+	file_private_location_v1_graphql_monitor_proto_goTypes = nil
+	file_private_location_v1_graphql_monitor_proto_depIdxs = nil
+}
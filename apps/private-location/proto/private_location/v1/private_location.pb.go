@@ -58,13 +58,17 @@ func (*MonitorsRequest) Descriptor() ([]byte, []int) {
 }
 
 type MonitorsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	HttpMonitors  []*HTTPMonitor         `protobuf:"bytes,1,rep,name=http_monitors,json=httpMonitors,proto3" json:"http_monitors,omitempty"`
-	TcpMonitors   []*TCPMonitor          `protobuf:"bytes,2,rep,name=tcp_monitors,json=tcpMonitors,proto3" json:"tcp_monitors,omitempty"`
-	DnsMonitors   []*DNSMonitor          `protobuf:"bytes,3,rep,name=dns_monitors,json=dnsMonitors,proto3" json:"dns_monitors,omitempty"`
-	Region        string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	HttpMonitors     []*HTTPMonitor         `protobuf:"bytes,1,rep,name=http_monitors,json=httpMonitors,proto3" json:"http_monitors,omitempty"`
+	TcpMonitors      []*TCPMonitor          `protobuf:"bytes,2,rep,name=tcp_monitors,json=tcpMonitors,proto3" json:"tcp_monitors,omitempty"`
+	DnsMonitors      []*DNSMonitor          `protobuf:"bytes,3,rep,name=dns_monitors,json=dnsMonitors,proto3" json:"dns_monitors,omitempty"`
+	Region           string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	GraphqlMonitors  []*GraphQLMonitor      `protobuf:"bytes,5,rep,name=graphql_monitors,json=graphqlMonitors,proto3" json:"graphql_monitors,omitempty"`
+	DatabaseMonitors []*DatabaseMonitor     `protobuf:"bytes,6,rep,name=database_monitors,json=databaseMonitors,proto3" json:"database_monitors,omitempty"`
+	NtpMonitors      []*NTPMonitor          `protobuf:"bytes,7,rep,name=ntp_monitors,json=ntpMonitors,proto3" json:"ntp_monitors,omitempty"`
+	DomainMonitors   []*DomainMonitor       `protobuf:"bytes,8,rep,name=domain_monitors,json=domainMonitors,proto3" json:"domain_monitors,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *MonitorsResponse) Reset() {
@@ -125,6 +129,34 @@ func (x *MonitorsResponse) GetRegion() string {
 	return ""
 }
 
+func (x *MonitorsResponse) GetGraphqlMonitors() []*GraphQLMonitor {
+	if x != nil {
+		return x.GraphqlMonitors
+	}
+	return nil
+}
+
+func (x *MonitorsResponse) GetDatabaseMonitors() []*DatabaseMonitor {
+	if x != nil {
+		return x.DatabaseMonitors
+	}
+	return nil
+}
+
+func (x *MonitorsResponse) GetNtpMonitors() []*NTPMonitor {
+	if x != nil {
+		return x.NtpMonitors
+	}
+	return nil
+}
+
+func (x *MonitorsResponse) GetDomainMonitors() []*DomainMonitor {
+	if x != nil {
+		return x.DomainMonitors
+	}
+	return nil
+}
+
 type IngestTCPRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -137,6 +169,8 @@ type IngestTCPRequest struct {
 	RequestStatus string                 `protobuf:"bytes,8,opt,name=requestStatus,proto3" json:"requestStatus,omitempty"`
 	Error         int64                  `protobuf:"varint,9,opt,name=error,proto3" json:"error,omitempty"`
 	Timing        string                 `protobuf:"bytes,10,opt,name=timing,proto3" json:"timing,omitempty"`
+	// Stable cause of a failed check, e.g. "connection_refused".
+	ErrorCode     string `protobuf:"bytes,11,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -241,6 +275,13 @@ func (x *IngestTCPRequest) GetTiming() string {
 	return ""
 }
 
+func (x *IngestTCPRequest) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
 type IngestTCPResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -292,6 +333,12 @@ type IngestHTTPRequest struct {
 	Timing        string                 `protobuf:"bytes,11,opt,name=timing,proto3" json:"timing,omitempty"`
 	StatusCode    int64                  `protobuf:"varint,12,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
 	Error         int64                  `protobuf:"varint,13,opt,name=error,proto3" json:"error,omitempty"`
+	// JSON diagnosis (DNS, TCP, TLS) of a check that failed without a response.
+	Diagnosis string `protobuf:"bytes,14,opt,name=diagnosis,proto3" json:"diagnosis,omitempty"`
+	// Stable cause of a failed check, e.g. "connection_refused".
+	ErrorCode string `protobuf:"bytes,15,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	// W3C trace ID sent in the traceparent header of the final attempt.
+	TraceId       string `protobuf:"bytes,16,opt,name=traceId,proto3" json:"traceId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -417,6 +464,27 @@ func (x *IngestHTTPRequest) GetError() int64 {
 	return 0
 }
 
+func (x *IngestHTTPRequest) GetDiagnosis() string {
+	if x != nil {
+		return x.Diagnosis
+	}
+	return ""
+}
+
+func (x *IngestHTTPRequest) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *IngestHTTPRequest) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
 type IngestHTTPResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -510,6 +578,8 @@ type IngestDNSRequest struct {
 	Records       map[string]*Records    `protobuf:"bytes,9,rep,name=records,proto3" json:"records,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	Timing        string                 `protobuf:"bytes,10,opt,name=timing,proto3" json:"timing,omitempty"`
 	Error         int64                  `protobuf:"varint,11,opt,name=error,proto3" json:"error,omitempty"`
+	// Stable cause of a failed check, e.g. "connection_refused".
+	ErrorCode     string `protobuf:"bytes,12,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -621,6 +691,13 @@ func (x *IngestDNSRequest) GetError() int64 {
 	return 0
 }
 
+func (x *IngestDNSRequest) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
 type IngestDNSResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -661,13 +738,17 @@ var File_private_location_v1_private_location_proto protoreflect.FileDescriptor
 
 const file_private_location_v1_private_location_proto_rawDesc = "" +
 	"\n" +
-	"*private_location/v1/private_location.proto\x12\x13private_location.v1\x1a%private_location/v1/dns_monitor.proto\x1a&private_location/v1/http_monitor.proto\x1a%private_location/v1/tcp_monitor.proto\"\x11\n" +
-	"\x0fMonitorsRequest\"\xf9\x01\n" +
+	"*private_location/v1/private_location.proto\x12\x13private_location.v1\x1a*private_location/v1/database_monitor.proto\x1a%private_location/v1/dns_monitor.proto\x1a(private_location/v1/domain_monitor.proto\x1a)private_location/v1/graphql_monitor.proto\x1a&private_location/v1/http_monitor.proto\x1a%private_location/v1/ntp_monitor.proto\x1a%private_location/v1/tcp_monitor.proto\"\x11\n" +
+	"\x0fMonitorsRequest\"\xad\x04\n" +
 	"\x10MonitorsResponse\x12E\n" +
 	"\rhttp_monitors\x18\x01 \x03(\v2 .private_location.v1.HTTPMonitorR\fhttpMonitors\x12B\n" +
 	"\ftcp_monitors\x18\x02 \x03(\v2\x1f.private_location.v1.TCPMonitorR\vtcpMonitors\x12B\n" +
 	"\fdns_monitors\x18\x03 \x03(\v2\x1f.private_location.v1.DNSMonitorR\vdnsMonitors\x12\x16\n" +
-	"\x06region\x18\x04 \x01(\tR\x06region\"\x9e\x02\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12N\n" +
+	"\x10graphql_monitors\x18\x05 \x03(\v2#.private_location.v1.GraphQLMonitorR\x0fgraphqlMonitors\x12Q\n" +
+	"\x11database_monitors\x18\x06 \x03(\v2$.private_location.v1.DatabaseMonitorR\x10databaseMonitors\x12B\n" +
+	"\fntp_monitors\x18\a \x03(\v2\x1f.private_location.v1.NTPMonitorR\vntpMonitors\x12K\n" +
+	"\x0fdomain_monitors\x18\b \x03(\v2\".private_location.v1.DomainMonitorR\x0edomainMonitors\"\xbc\x02\n" +
 	"\x10IngestTCPRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
 	"\tmonitorId\x18\x02 \x01(\tR\tmonitorId\x12\x18\n" +
@@ -679,8 +760,9 @@ const file_private_location_v1_private_location_proto_rawDesc = "" +
 	"\rrequestStatus\x18\b \x01(\tR\rrequestStatus\x12\x14\n" +
 	"\x05error\x18\t \x01(\x03R\x05error\x12\x16\n" +
 	"\x06timing\x18\n" +
-	" \x01(\tR\x06timing\"\x13\n" +
-	"\x11IngestTCPResponse\"\xed\x02\n" +
+	" \x01(\tR\x06timing\x12\x1c\n" +
+	"\terrorCode\x18\v \x01(\tR\terrorCode\"\x13\n" +
+	"\x11IngestTCPResponse\"\xc3\x03\n" +
 	"\x11IngestHTTPRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
 	"\tmonitorId\x18\x02 \x01(\tR\tmonitorId\x12\x18\n" +
@@ -697,10 +779,13 @@ const file_private_location_v1_private_location_proto_rawDesc = "" +
 	"\n" +
 	"statusCode\x18\f \x01(\x03R\n" +
 	"statusCode\x12\x14\n" +
-	"\x05error\x18\r \x01(\x03R\x05error\"\x14\n" +
+	"\x05error\x18\r \x01(\x03R\x05error\x12\x1c\n" +
+	"\tdiagnosis\x18\x0e \x01(\tR\tdiagnosis\x12\x1c\n" +
+	"\terrorCode\x18\x0f \x01(\tR\terrorCode\x12\x18\n" +
+	"\atraceId\x18\x10 \x01(\tR\atraceId\"\x14\n" +
 	"\x12IngestHTTPResponse\"!\n" +
 	"\aRecords\x12\x16\n" +
-	"\x06record\x18\x01 \x03(\tR\x06record\"\xc6\x03\n" +
+	"\x06record\x18\x01 \x03(\tR\x06record\"\xe4\x03\n" +
 	"\x10IngestDNSRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
 	"\tmonitorId\x18\x02 \x01(\tR\tmonitorId\x12\x18\n" +
@@ -713,7 +798,8 @@ const file_private_location_v1_private_location_proto_rawDesc = "" +
 	"\arecords\x18\t \x03(\v22.private_location.v1.IngestDNSRequest.RecordsEntryR\arecords\x12\x16\n" +
 	"\x06timing\x18\n" +
 	" \x01(\tR\x06timing\x12\x14\n" +
-	"\x05error\x18\v \x01(\x03R\x05error\x1aX\n" +
+	"\x05error\x18\v \x01(\x03R\x05error\x12\x1c\n" +
+	"\terrorCode\x18\f \x01(\tR\terrorCode\x1aX\n" +
 	"\fRecordsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
 	"\x05value\x18\x02 \x01(\v2\x1c.private_location.v1.RecordsR\x05value:\x028\x01\"\x13\n" +
@@ -752,26 +838,34 @@ var file_private_location_v1_private_location_proto_goTypes = []any{
 	(*HTTPMonitor)(nil),        // 10: private_location.v1.HTTPMonitor
 	(*TCPMonitor)(nil),         // 11: private_location.v1.TCPMonitor
 	(*DNSMonitor)(nil),         // 12: private_location.v1.DNSMonitor
+	(*GraphQLMonitor)(nil),     // 13: private_location.v1.GraphQLMonitor
+	(*DatabaseMonitor)(nil),    // 14: private_location.v1.DatabaseMonitor
+	(*NTPMonitor)(nil),         // 15: private_location.v1.NTPMonitor
+	(*DomainMonitor)(nil),      // 16: private_location.v1.DomainMonitor
 }
 var file_private_location_v1_private_location_proto_depIdxs = []int32{
 	10, // 0: private_location.v1.MonitorsResponse.http_monitors:type_name -> private_location.v1.HTTPMonitor
 	11, // 1: private_location.v1.MonitorsResponse.tcp_monitors:type_name -> private_location.v1.TCPMonitor
 	12, // 2: private_location.v1.MonitorsResponse.dns_monitors:type_name -> private_location.v1.DNSMonitor
-	9,  // 3: private_location.v1.IngestDNSRequest.records:type_name -> private_location.v1.IngestDNSRequest.RecordsEntry
-	6,  // 4: private_location.v1.IngestDNSRequest.RecordsEntry.value:type_name -> private_location.v1.Records
-	0,  // 5: private_location.v1.PrivateLocationService.Monitors:input_type -> private_location.v1.MonitorsRequest
-	2,  // 6: private_location.v1.PrivateLocationService.IngestTCP:input_type -> private_location.v1.IngestTCPRequest
-	4,  // 7: private_location.v1.PrivateLocationService.IngestHTTP:input_type -> private_location.v1.IngestHTTPRequest
-	7,  // 8: private_location.v1.PrivateLocationService.IngestDNS:input_type -> private_location.v1.IngestDNSRequest
-	1,  // 9: private_location.v1.PrivateLocationService.Monitors:output_type -> private_location.v1.MonitorsResponse
-	3,  // 10: private_location.v1.PrivateLocationService.IngestTCP:output_type -> private_location.v1.IngestTCPResponse
-	5,  // 11: private_location.v1.PrivateLocationService.IngestHTTP:output_type -> private_location.v1.IngestHTTPResponse
-	8,  // 12: private_location.v1.PrivateLocationService.IngestDNS:output_type -> private_location.v1.IngestDNSResponse
-	9,  // [9:13] is the sub-list for method output_type
-	5,  // [5:9] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	13, // 3: private_location.v1.MonitorsResponse.graphql_monitors:type_name -> private_location.v1.GraphQLMonitor
+	14, // 4: private_location.v1.MonitorsResponse.database_monitors:type_name -> private_location.v1.DatabaseMonitor
+	15, // 5: private_location.v1.MonitorsResponse.ntp_monitors:type_name -> private_location.v1.NTPMonitor
+	16, // 6: private_location.v1.MonitorsResponse.domain_monitors:type_name -> private_location.v1.DomainMonitor
+	9,  // 7: private_location.v1.IngestDNSRequest.records:type_name -> private_location.v1.IngestDNSRequest.RecordsEntry
+	6,  // 8: private_location.v1.IngestDNSRequest.RecordsEntry.value:type_name -> private_location.v1.Records
+	0,  // 9: private_location.v1.PrivateLocationService.Monitors:input_type -> private_location.v1.MonitorsRequest
+	2,  // 10: private_location.v1.PrivateLocationService.IngestTCP:input_type -> private_location.v1.IngestTCPRequest
+	4,  // 11: private_location.v1.PrivateLocationService.IngestHTTP:input_type -> private_location.v1.IngestHTTPRequest
+	7,  // 12: private_location.v1.PrivateLocationService.IngestDNS:input_type -> private_location.v1.IngestDNSRequest
+	1,  // 13: private_location.v1.PrivateLocationService.Monitors:output_type -> private_location.v1.MonitorsResponse
+	3,  // 14: private_location.v1.PrivateLocationService.IngestTCP:output_type -> private_location.v1.IngestTCPResponse
+	5,  // 15: private_location.v1.PrivateLocationService.IngestHTTP:output_type -> private_location.v1.IngestHTTPResponse
+	8,  // 16: private_location.v1.PrivateLocationService.IngestDNS:output_type -> private_location.v1.IngestDNSResponse
+	13, // [13:17] is the sub-list for method output_type
+	9,  // [9:13] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_private_location_v1_private_location_proto_init() }
@@ -779,8 +873,12 @@ func file_private_location_v1_private_location_proto_init() {
 	if File_private_location_v1_private_location_proto != nil {
 		return
 	}
+	file_private_location_v1_database_monitor_proto_init()
 	file_private_location_v1_dns_monitor_proto_init()
+	file_private_location_v1_domain_monitor_proto_init()
+	file_private_location_v1_graphql_monitor_proto_init()
 	file_private_location_v1_http_monitor_proto_init()
+	file_private_location_v1_ntp_monitor_proto_init()
 	file_private_location_v1_tcp_monitor_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -21,22 +21,310 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type TCPMonitor struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uri           string                 `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
-	Timeout       int64                  `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	DegradedAt    *int64                 `protobuf:"varint,4,opt,name=degraded_at,json=degradedAt,proto3,oneof" json:"degraded_at,omitempty"`
-	Periodicity   string                 `protobuf:"bytes,5,opt,name=periodicity,proto3" json:"periodicity,omitempty"`
-	Retry         int64                  `protobuf:"varint,6,opt,name=retry,proto3" json:"retry,omitempty"`
-	OtelConfig    *OtelConfig            `protobuf:"bytes,20,opt,name=otel_config,json=otelConfig,proto3" json:"otel_config,omitempty"`
+// Application protocol spoken after the TCP connect. Unspecified keeps the
+// plain connect check.
+type TCPProtocol int32
+
+const (
+	TCPProtocol_TCP_PROTOCOL_UNSPECIFIED TCPProtocol = 0
+	TCPProtocol_TCP_PROTOCOL_SMTP        TCPProtocol = 1
+	TCPProtocol_TCP_PROTOCOL_IMAP        TCPProtocol = 2
+	TCPProtocol_TCP_PROTOCOL_POP3        TCPProtocol = 3
+	TCPProtocol_TCP_PROTOCOL_SSH         TCPProtocol = 4
+	TCPProtocol_TCP_PROTOCOL_MQTT        TCPProtocol = 5
+)
+
+// Enum value maps for TCPProtocol.
+var (
+	TCPProtocol_name = map[int32]string{
+		0: "TCP_PROTOCOL_UNSPECIFIED",
+		1: "TCP_PROTOCOL_SMTP",
+		2: "TCP_PROTOCOL_IMAP",
+		3: "TCP_PROTOCOL_POP3",
+		4: "TCP_PROTOCOL_SSH",
+		5: "TCP_PROTOCOL_MQTT",
+	}
+	TCPProtocol_value = map[string]int32{
+		"TCP_PROTOCOL_UNSPECIFIED": 0,
+		"TCP_PROTOCOL_SMTP":        1,
+		"TCP_PROTOCOL_IMAP":        2,
+		"TCP_PROTOCOL_POP3":        3,
+		"TCP_PROTOCOL_SSH":         4,
+		"TCP_PROTOCOL_MQTT":        5,
+	}
+)
+
+func (x TCPProtocol) Enum() *TCPProtocol {
+	p := new(TCPProtocol)
+	*p = x
+	return p
+}
+
+func (x TCPProtocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TCPProtocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_private_location_v1_tcp_monitor_proto_enumTypes[0].Descriptor()
+}
+
+func (TCPProtocol) Type() protoreflect.EnumType {
+	return &file_private_location_v1_tcp_monitor_proto_enumTypes[0]
+}
+
+func (x TCPProtocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TCPProtocol.Descriptor instead.
+func (TCPProtocol) EnumDescriptor() ([]byte, []int) {
+	return file_private_location_v1_tcp_monitor_proto_rawDescGZIP(), []int{0}
+}
+
+type MailOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Upgrade the plain connection with STARTTLS (STLS for POP3).
+	Starttls bool `protobuf:"varint,1,opt,name=starttls,proto3" json:"starttls,omitempty"`
+	// Negotiate TLS right after the connect, e.g. ports 465, 993 and 995.
+	ImplicitTls bool   `protobuf:"varint,2,opt,name=implicit_tls,json=implicitTls,proto3" json:"implicit_tls,omitempty"`
+	Username    string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password    string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// Name sent with EHLO, defaults to the probe hostname.
+	EhloName      string `protobuf:"bytes,5,opt,name=ehlo_name,json=ehloName,proto3" json:"ehlo_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *MailOptions) Reset() {
+	*x = MailOptions{}
+	mi := &file_private_location_v1_tcp_monitor_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MailOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailOptions) ProtoMessage() {}
+
+func (x *MailOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_private_location_v1_tcp_monitor_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailOptions.ProtoReflect.Descriptor instead.
+func (*MailOptions) Descriptor() ([]byte, []int) {
+	return file_private_location_v1_tcp_monitor_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MailOptions) GetStarttls() bool {
+	if x != nil {
+		return x.Starttls
+	}
+	return false
+}
+
+func (x *MailOptions) GetImplicitTls() bool {
+	if x != nil {
+		return x.ImplicitTls
+	}
+	return false
+}
+
+func (x *MailOptions) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MailOptions) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *MailOptions) GetEhloName() string {
+	if x != nil {
+		return x.EhloName
+	}
+	return ""
+}
+
+type SSHOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pinned host key, as printed by `ssh-keygen -lf` (SHA256:...). A
+	// different key fails the check.
+	HostKeyFingerprint string `protobuf:"bytes,1,opt,name=host_key_fingerprint,json=hostKeyFingerprint,proto3" json:"host_key_fingerprint,omitempty"`
+	// Authenticate with private_key when username is set; otherwise the
+	// check stops after the key exchange.
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	PrivateKey    string `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	Passphrase    string `protobuf:"bytes,4,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SSHOptions) Reset() {
+	*x = SSHOptions{}
+	mi := &file_private_location_v1_tcp_monitor_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSHOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHOptions) ProtoMessage() {}
+
+func (x *SSHOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_private_location_v1_tcp_monitor_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHOptions.ProtoReflect.Descriptor instead.
+func (*SSHOptions) Descriptor() ([]byte, []int) {
+	return file_private_location_v1_tcp_monitor_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SSHOptions) GetHostKeyFingerprint() string {
+	if x != nil {
+		return x.HostKeyFingerprint
+	}
+	return ""
+}
+
+func (x *SSHOptions) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SSHOptions) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *SSHOptions) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+type MQTTOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Topic the probe publishes to and subscribes on.
+	Topic         string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Tls           bool   `protobuf:"varint,4,opt,name=tls,proto3" json:"tls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MQTTOptions) Reset() {
+	*x = MQTTOptions{}
+	mi := &file_private_location_v1_tcp_monitor_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MQTTOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MQTTOptions) ProtoMessage() {}
+
+func (x *MQTTOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_private_location_v1_tcp_monitor_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MQTTOptions.ProtoReflect.Descriptor instead.
+func (*MQTTOptions) Descriptor() ([]byte, []int) {
+	return file_private_location_v1_tcp_monitor_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MQTTOptions) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *MQTTOptions) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MQTTOptions) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *MQTTOptions) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+type TCPMonitor struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uri         string                 `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	Timeout     int64                  `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	DegradedAt  *int64                 `protobuf:"varint,4,opt,name=degraded_at,json=degradedAt,proto3,oneof" json:"degraded_at,omitempty"`
+	Periodicity string                 `protobuf:"bytes,5,opt,name=periodicity,proto3" json:"periodicity,omitempty"`
+	Retry       int64                  `protobuf:"varint,6,opt,name=retry,proto3" json:"retry,omitempty"`
+	Protocol    TCPProtocol            `protobuf:"varint,7,opt,name=protocol,proto3,enum=private_location.v1.TCPProtocol" json:"protocol,omitempty"`
+	Mail        *MailOptions           `protobuf:"bytes,8,opt,name=mail,proto3" json:"mail,omitempty"`
+	// Evaluated against the SMTP greeting code.
+	ReplyCodeAssertions []*StatusCodeAssertion `protobuf:"bytes,9,rep,name=reply_code_assertions,json=replyCodeAssertions,proto3" json:"reply_code_assertions,omitempty"`
+	// Evaluated against the space separated capabilities the server advertised.
+	CapabilityAssertions []*ValueAssertion `protobuf:"bytes,10,rep,name=capability_assertions,json=capabilityAssertions,proto3" json:"capability_assertions,omitempty"`
+	Ssh                  *SSHOptions       `protobuf:"bytes,11,opt,name=ssh,proto3" json:"ssh,omitempty"`
+	Mqtt                 *MQTTOptions      `protobuf:"bytes,12,opt,name=mqtt,proto3" json:"mqtt,omitempty"`
+	OtelConfig           *OtelConfig       `protobuf:"bytes,20,opt,name=otel_config,json=otelConfig,proto3" json:"otel_config,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
 func (x *TCPMonitor) Reset() {
 	*x = TCPMonitor{}
-	mi := &file_private_location_v1_tcp_monitor_proto_msgTypes[0]
+	mi := &file_private_location_v1_tcp_monitor_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48,7 +336,7 @@ func (x *TCPMonitor) String() string {
 func (*TCPMonitor) ProtoMessage() {}
 
 func (x *TCPMonitor) ProtoReflect() protoreflect.Message {
-	mi := &file_private_location_v1_tcp_monitor_proto_msgTypes[0]
+	mi := &file_private_location_v1_tcp_monitor_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -61,7 +349,7 @@ func (x *TCPMonitor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TCPMonitor.ProtoReflect.Descriptor instead.
 func (*TCPMonitor) Descriptor() ([]byte, []int) {
-	return file_private_location_v1_tcp_monitor_proto_rawDescGZIP(), []int{0}
+	return file_private_location_v1_tcp_monitor_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TCPMonitor) GetId() string {
@@ -106,6 +394,48 @@ func (x *TCPMonitor) GetRetry() int64 {
 	return 0
 }
 
+func (x *TCPMonitor) GetProtocol() TCPProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return TCPProtocol_TCP_PROTOCOL_UNSPECIFIED
+}
+
+func (x *TCPMonitor) GetMail() *MailOptions {
+	if x != nil {
+		return x.Mail
+	}
+	return nil
+}
+
+func (x *TCPMonitor) GetReplyCodeAssertions() []*StatusCodeAssertion {
+	if x != nil {
+		return x.ReplyCodeAssertions
+	}
+	return nil
+}
+
+func (x *TCPMonitor) GetCapabilityAssertions() []*ValueAssertion {
+	if x != nil {
+		return x.CapabilityAssertions
+	}
+	return nil
+}
+
+func (x *TCPMonitor) GetSsh() *SSHOptions {
+	if x != nil {
+		return x.Ssh
+	}
+	return nil
+}
+
+func (x *TCPMonitor) GetMqtt() *MQTTOptions {
+	if x != nil {
+		return x.Mqtt
+	}
+	return nil
+}
+
 func (x *TCPMonitor) GetOtelConfig() *OtelConfig {
 	if x != nil {
 		return x.OtelConfig
@@ -117,7 +447,27 @@ var File_private_location_v1_tcp_monitor_proto protoreflect.FileDescriptor
 
 const file_private_location_v1_tcp_monitor_proto_rawDesc = "" +
 	"\n" +
-	"%private_location/v1/tcp_monitor.proto\x12\x13private_location.v1\x1a\x1eprivate_location/v1/otel.proto\"\xf8\x01\n" +
+	"%private_location/v1/tcp_monitor.proto\x12\x13private_location.v1\x1a$private_location/v1/assertions.proto\x1a\x1eprivate_location/v1/otel.proto\"\xa1\x01\n" +
+	"\vMailOptions\x12\x1a\n" +
+	"\bstarttls\x18\x01 \x01(\bR\bstarttls\x12!\n" +
+	"\fimplicit_tls\x18\x02 \x01(\bR\vimplicitTls\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x1b\n" +
+	"\tehlo_name\x18\x05 \x01(\tR\behloName\"\x9b\x01\n" +
+	"\n" +
+	"SSHOptions\x120\n" +
+	"\x14host_key_fingerprint\x18\x01 \x01(\tR\x12hostKeyFingerprint\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1f\n" +
+	"\vprivate_key\x18\x03 \x01(\tR\n" +
+	"privateKey\x12\x1e\n" +
+	"\n" +
+	"passphrase\x18\x04 \x01(\tR\n" +
+	"passphrase\"m\n" +
+	"\vMQTTOptions\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x10\n" +
+	"\x03tls\x18\x04 \x01(\bR\x03tls\"\x8d\x05\n" +
 	"\n" +
 	"TCPMonitor\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
@@ -126,10 +476,24 @@ const file_private_location_v1_tcp_monitor_proto_rawDesc = "" +
 	"\vdegraded_at\x18\x04 \x01(\x03H\x00R\n" +
 	"degradedAt\x88\x01\x01\x12 \n" +
 	"\vperiodicity\x18\x05 \x01(\tR\vperiodicity\x12\x14\n" +
-	"\x05retry\x18\x06 \x01(\x03R\x05retry\x12@\n" +
+	"\x05retry\x18\x06 \x01(\x03R\x05retry\x12<\n" +
+	"\bprotocol\x18\a \x01(\x0e2 .private_location.v1.TCPProtocolR\bprotocol\x124\n" +
+	"\x04mail\x18\b \x01(\v2 .private_location.v1.MailOptionsR\x04mail\x12\\\n" +
+	"\x15reply_code_assertions\x18\t \x03(\v2(.private_location.v1.StatusCodeAssertionR\x13replyCodeAssertions\x12X\n" +
+	"\x15capability_assertions\x18\n" +
+	" \x03(\v2#.private_location.v1.ValueAssertionR\x14capabilityAssertions\x121\n" +
+	"\x03ssh\x18\v \x01(\v2\x1f.private_location.v1.SSHOptionsR\x03ssh\x124\n" +
+	"\x04mqtt\x18\f \x01(\v2 .private_location.v1.MQTTOptionsR\x04mqtt\x12@\n" +
 	"\votel_config\x18\x14 \x01(\v2\x1f.private_location.v1.OtelConfigR\n" +
 	"otelConfigB\x0e\n" +
-	"\f_degraded_atBJZHgithub.com/openstatushq/openstatus/packages/proto/private_location/v1;v1b\x06proto3"
+	"\f_degraded_at*\x9d\x01\n" +
+	"\vTCPProtocol\x12\x1c\n" +
+	"\x18TCP_PROTOCOL_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TCP_PROTOCOL_SMTP\x10\x01\x12\x15\n" +
+	"\x11TCP_PROTOCOL_IMAP\x10\x02\x12\x15\n" +
+	"\x11TCP_PROTOCOL_POP3\x10\x03\x12\x14\n" +
+	"\x10TCP_PROTOCOL_SSH\x10\x04\x12\x15\n" +
+	"\x11TCP_PROTOCOL_MQTT\x10\x05BJZHgithub.com/openstatushq/openstatus/packages/proto/private_location/v1;v1b\x06proto3"
 
 var (
 	file_private_location_v1_tcp_monitor_proto_rawDescOnce sync.Once
@@ -143,18 +507,31 @@ func file_private_location_v1_tcp_monitor_proto_rawDescGZIP() []byte {
 	return file_private_location_v1_tcp_monitor_proto_rawDescData
 }
 
-var file_private_location_v1_tcp_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_private_location_v1_tcp_monitor_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_private_location_v1_tcp_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_private_location_v1_tcp_monitor_proto_goTypes = []any{
-	(*TCPMonitor)(nil), // 0: private_location.v1.TCPMonitor
-	(*OtelConfig)(nil), // 1: private_location.v1.OtelConfig
+	(TCPProtocol)(0),            // 0: private_location.v1.TCPProtocol
+	(*MailOptions)(nil),         // 1: private_location.v1.MailOptions
+	(*SSHOptions)(nil),          // 2: private_location.v1.SSHOptions
+	(*MQTTOptions)(nil),         // 3: private_location.v1.MQTTOptions
+	(*TCPMonitor)(nil),          // 4: private_location.v1.TCPMonitor
+	(*StatusCodeAssertion)(nil), // 5: private_location.v1.StatusCodeAssertion
+	(*ValueAssertion)(nil),      // 6: private_location.v1.ValueAssertion
+	(*OtelConfig)(nil),          // 7: private_location.v1.OtelConfig
 }
 var file_private_location_v1_tcp_monitor_proto_depIdxs = []int32{
-	1, // 0: private_location.v1.TCPMonitor.otel_config:type_name -> private_location.v1.OtelConfig
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0, // 0: private_location.v1.TCPMonitor.protocol:type_name -> private_location.v1.TCPProtocol
+	1, // 1: private_location.v1.TCPMonitor.mail:type_name -> private_location.v1.MailOptions
+	5, // 2: private_location.v1.TCPMonitor.reply_code_assertions:type_name -> private_location.v1.StatusCodeAssertion
+	6, // 3: private_location.v1.TCPMonitor.capability_assertions:type_name -> private_location.v1.ValueAssertion
+	2, // 4: private_location.v1.TCPMonitor.ssh:type_name -> private_location.v1.SSHOptions
+	3, // 5: private_location.v1.TCPMonitor.mqtt:type_name -> private_location.v1.MQTTOptions
+	7, // 6: private_location.v1.TCPMonitor.otel_config:type_name -> private_location.v1.OtelConfig
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_private_location_v1_tcp_monitor_proto_init() }
@@ -162,20 +539,22 @@ func file_private_location_v1_tcp_monitor_proto_init() {
 	if File_private_location_v1_tcp_monitor_proto != nil {
 		return
 	}
+	file_private_location_v1_assertions_proto_init()
 	file_private_location_v1_otel_proto_init()
-	file_private_location_v1_tcp_monitor_proto_msgTypes[0].OneofWrappers = []any{}
+	file_private_location_v1_tcp_monitor_proto_msgTypes[3].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_private_location_v1_tcp_monitor_proto_rawDesc), len(file_private_location_v1_tcp_monitor_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_private_location_v1_tcp_monitor_proto_goTypes,
 		DependencyIndexes: file_private_location_v1_tcp_monitor_proto_depIdxs,
+		EnumInfos:         file_private_location_v1_tcp_monitor_proto_enumTypes,
 		MessageInfos:      file_private_location_v1_tcp_monitor_proto_msgTypes,
 	}.Build()
 	File_private_location_v1_tcp_monitor_proto = out.File
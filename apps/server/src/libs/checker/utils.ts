@@ -32,10 +32,12 @@ export function getCheckerPayload(
           ? {
               endpoint: monitor.otelEndpoint,
               headers: transformHeaders(monitor.otelHeaders),
+              traces: monitor.otelTraces ?? false,
             }
           : undefined,
         retry: monitor.retry ?? 0,
         followRedirects: monitor.followRedirects ?? false,
+        auth: monitor.auth ? JSON.parse(monitor.auth) : undefined,
       };
     case "tcp":
       return {
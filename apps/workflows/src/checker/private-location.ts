@@ -60,7 +60,7 @@ export async function updateStatusPrivate(c: Context<Env>) {
 
   // Set event data for OTel/Axiom structured logging (matches cloud checker pattern)
   // Note: Private location pings are ingested to Tinybird separately via Go code in
-  // apps/private-location/internal/tinybird/client.go, not through this event object
+  // the sink of apps/private-location, not through this event object
   if (event) {
     event.status_update = {
       status: status,
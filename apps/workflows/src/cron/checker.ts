@@ -11,9 +11,11 @@ import {
   notInArray,
 } from "@openstatus/db";
 import {
+  type MonitorJobType,
   type MonitorStatus,
   maintenance,
   monitor,
+  monitorStatusPolicy,
   monitorStatusTable,
   selectMonitorSchema,
   selectMonitorStatusSchema,
@@ -27,6 +29,7 @@ import {
 import { regionDict } from "@openstatus/regions";
 import {
   type DNSPayloadSchema,
+  type graphqlPayloadSchema,
   type httpPayloadSchema,
   type tpcPayloadSchema,
   transformHeaders,
@@ -42,6 +45,7 @@ type TaskInput = {
   timestamp: number;
   status: MonitorStatus;
   region: Region;
+  policy?: typeof monitorStatusPolicy.$inferSelect;
 };
 
 export const isAuthorizedDomain = (url: string) => {
@@ -50,6 +54,22 @@ export const isAuthorizedDomain = (url: string) => {
 
 const logger = getLogger("workflow");
 
+// Job types the public checker does not run: heartbeats are pushed to us,
+// the others only run on private locations.
+const unscheduledJobTypes: MonitorJobType[] = [
+  "heartbeat",
+  "postgres",
+  "mysql",
+  "redis",
+  "smtp",
+  "imap",
+  "pop3",
+  "ssh",
+  "mqtt",
+  "ntp",
+  "domain",
+];
+
 /**
  * Check if GCP Cloud Tasks is properly configured.
  * Returns false if credentials are missing or set to placeholder values.
@@ -134,6 +154,7 @@ export async function sendCheckerTasks(
       and(
         eq(monitor.periodicity, periodicity),
         eq(monitor.active, true),
+        notInArray(monitor.jobType, unscheduledJobTypes),
         notInArray(monitor.id, currentMaintenanceMonitors),
       ),
     )
@@ -182,8 +203,16 @@ export async function sendCheckerTasks(
     statusMap.set(raw.monitorId, list);
   }
 
+  const policies = await db
+    .select()
+    .from(monitorStatusPolicy)
+    .where(inArray(monitorStatusPolicy.monitorId, monitorIds))
+    .all();
+  const policyMap = new Map(policies.map((p) => [p.monitorId, p]));
+
   for (const row of monitors.data) {
     const monitorStatuses = statusMap.get(row.id) ?? [];
+    const policy = policyMap.get(row.id);
 
     for (const region of row.regions) {
       const status =
@@ -201,7 +230,7 @@ export async function sendCheckerTasks(
         logger.error(`Deprecated region ${region}`);
         continue;
       }
-      taskInputs.push({ row, timestamp, status, region });
+      taskInputs.push({ row, timestamp, status, region, policy });
       if (periodicity === "30s") {
         const scheduledAt = timestamp + 30 * 1000;
         taskInputs.push({
@@ -209,6 +238,7 @@ export async function sendCheckerTasks(
           timestamp: scheduledAt,
           status,
           region,
+          policy,
         });
       }
     }
@@ -273,7 +303,7 @@ export async function sendCheckerTasks(
 }
 // timestamp needs to be in ms
 const createCronTask = async (
-  { row, timestamp, status, region }: TaskInput,
+  { row, timestamp, status, region, policy }: TaskInput,
   parent: string,
 ) => {
   // This should never happen due to the guard in sendCheckerTasks, but TypeScript needs this
@@ -283,6 +313,7 @@ const createCronTask = async (
 
   let payload:
     | z.infer<typeof httpPayloadSchema>
+    | z.infer<typeof graphqlPayloadSchema>
     | z.infer<typeof tpcPayloadSchema>
     | z.infer<typeof DNSPayloadSchema>
     | null = null;
@@ -306,11 +337,43 @@ const createCronTask = async (
         ? {
             endpoint: row.otelEndpoint,
             headers: transformHeaders(row.otelHeaders),
+            traces: row.otelTraces ?? false,
           }
         : undefined,
       retry: row.retry || 3,
       followRedirects:
         row.followRedirects === null ? true : row.followRedirects,
+      auth: row.auth ? JSON.parse(row.auth) : undefined,
+    };
+  }
+  if (row.jobType === "graphql") {
+    const operation = row.body ? JSON.parse(row.body) : {};
+    payload = {
+      workspaceId: String(row.workspaceId),
+      monitorId: String(row.id),
+      url: row.url,
+      method: "POST",
+      cronTimestamp: timestamp,
+      query: operation.query ?? "",
+      variables: operation.variables,
+      operationName: operation.operationName,
+      headers: row.headers,
+      status: status,
+      assertions: row.assertions ? JSON.parse(row.assertions) : null,
+      degradedAfter: row.degradedAfter,
+      timeout: row.timeout,
+      trigger: "cron",
+      otelConfig: row.otelEndpoint
+        ? {
+            endpoint: row.otelEndpoint,
+            headers: transformHeaders(row.otelHeaders),
+            traces: row.otelTraces ?? false,
+          }
+        : undefined,
+      retry: row.retry || 3,
+      followRedirects:
+        row.followRedirects === null ? true : row.followRedirects,
+      auth: row.auth ? JSON.parse(row.auth) : undefined,
     };
   }
   if (row.jobType === "tcp") {
@@ -357,6 +420,15 @@ const createCronTask = async (
   if (!payload) {
     throw new Error("Invalid jobType");
   }
+  if (policy) {
+    payload = {
+      ...payload,
+      failureThreshold: policy.failureThreshold,
+      recoveryThreshold: policy.recoveryThreshold,
+      flapThreshold: policy.flapThreshold,
+      flapWindow: policy.flapWindowSeconds * 1000,
+    };
+  }
   const regionInfo = regionDict[region];
   let regionHeader = {};
   if (regionInfo.provider === "fly") {
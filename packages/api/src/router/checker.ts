@@ -406,6 +406,7 @@ export async function triggerChecker(
         : undefined,
       retry: input.retry || 3,
       followRedirects: input.followRedirects || true,
+      auth: input.auth ? JSON.parse(input.auth) : undefined,
     };
   }
   if (input.jobType === "tcp") {
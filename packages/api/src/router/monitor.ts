@@ -25,9 +25,11 @@ import {
   updateMonitorNotifiers,
   updateMonitorOtel,
   updateMonitorPublic,
+  updateMonitorQuorum,
   updateMonitorResponseTime,
   updateMonitorRetry,
   updateMonitorSchedulingRegions,
+  updateMonitorStatusPolicy,
   updateMonitorTags,
 } from "@openstatus/services/monitor";
 import { z } from "zod";
@@ -298,6 +300,60 @@ export const monitorRouter = createTRPCRouter({
       }
     }),
 
+  updateStatusPolicy: protectedProcedure
+    .meta({ track: Events.UpdateMonitor })
+    .input(
+      z.object({
+        id: z.number(),
+        failureThreshold: z.number(),
+        recoveryThreshold: z.number(),
+        flapThreshold: z.number(),
+        flapWindowSeconds: z.number(),
+      }),
+    )
+    .mutation(async ({ ctx, input }) => {
+      try {
+        await updateMonitorStatusPolicy({
+          ctx: toServiceCtx(ctx),
+          input: {
+            id: input.id,
+            failureThreshold: input.failureThreshold,
+            recoveryThreshold: input.recoveryThreshold,
+            flapThreshold: input.flapThreshold,
+            flapWindowSeconds: input.flapWindowSeconds,
+          },
+        });
+      } catch (err) {
+        toTRPCError(err);
+      }
+    }),
+
+  updateQuorum: protectedProcedure
+    .meta({ track: Events.UpdateMonitor })
+    .input(
+      z.object({
+        id: z.number(),
+        quorum: z.enum(["", "any", "majority", "all", "n_of_m"]),
+        quorumCount: z.number(),
+        quorumWindowSeconds: z.number(),
+      }),
+    )
+    .mutation(async ({ ctx, input }) => {
+      try {
+        await updateMonitorQuorum({
+          ctx: toServiceCtx(ctx),
+          input: {
+            id: input.id,
+            quorum: input.quorum,
+            quorumCount: input.quorumCount,
+            quorumWindowSeconds: input.quorumWindowSeconds,
+          },
+        });
+      } catch (err) {
+        toTRPCError(err);
+      }
+    }),
+
   updateTags: protectedProcedure
     .meta({ track: Events.UpdateMonitor })
     .input(z.object({ id: z.number(), tags: z.array(z.number()) }))
@@ -0,0 +1 @@
+ALTER TABLE `monitor` ADD `auth` text;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE `monitor` ADD `otel_traces` integer DEFAULT false;
\ No newline at end of file
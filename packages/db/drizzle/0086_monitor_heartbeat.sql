@@ -0,0 +1,23 @@
+CREATE TABLE `monitor_heartbeat` (
+	`monitor_id` integer PRIMARY KEY NOT NULL,
+	`token` text NOT NULL,
+	`grace_seconds` integer DEFAULT 60 NOT NULL,
+	`state` text DEFAULT 'new' NOT NULL,
+	`last_ping_at` integer,
+	`run_started_at` integer,
+	`created_at` integer DEFAULT (strftime('%s', 'now')) NOT NULL,
+	FOREIGN KEY (`monitor_id`) REFERENCES `monitor`(`id`) ON UPDATE no action ON DELETE cascade
+);
+--> statement-breakpoint
+CREATE UNIQUE INDEX `monitor_heartbeat_token_idx` ON `monitor_heartbeat` (`token`);--> statement-breakpoint
+CREATE TABLE `heartbeat_check_in` (
+	`id` text PRIMARY KEY NOT NULL,
+	`monitor_id` integer NOT NULL,
+	`kind` text NOT NULL,
+	`duration_ms` integer,
+	`message` text DEFAULT '' NOT NULL,
+	`created_at` integer NOT NULL,
+	FOREIGN KEY (`monitor_id`) REFERENCES `monitor`(`id`) ON UPDATE no action ON DELETE cascade
+);
+--> statement-breakpoint
+CREATE INDEX `heartbeat_check_in_monitor_idx` ON `heartbeat_check_in` (`monitor_id`,`created_at`);
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE `monitor` ADD `secret` text;
\ No newline at end of file
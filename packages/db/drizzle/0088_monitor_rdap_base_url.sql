@@ -0,0 +1 @@
+ALTER TABLE `monitor` ADD `rdap_base_url` text;
\ No newline at end of file
@@ -0,0 +1,12 @@
+CREATE TABLE `workflow_outbox` (
+	`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL,
+	`monitor_id` text NOT NULL,
+	`payload` text NOT NULL,
+	`attempts` integer DEFAULT 0 NOT NULL,
+	`next_attempt_at` integer NOT NULL,
+	`claimed_until` integer DEFAULT 0 NOT NULL,
+	`last_error` text DEFAULT '' NOT NULL,
+	`created_at` integer NOT NULL
+);
+--> statement-breakpoint
+CREATE INDEX `workflow_outbox_monitor_idx` ON `workflow_outbox` (`monitor_id`,`id`);
\ No newline at end of file
@@ -0,0 +1,10 @@
+CREATE TABLE `private_location_status` (
+	`monitor_id` integer NOT NULL,
+	`private_location_id` integer NOT NULL,
+	`status` text NOT NULL,
+	`previous_status` text DEFAULT '' NOT NULL,
+	`changed_at` integer NOT NULL,
+	PRIMARY KEY(`monitor_id`, `private_location_id`),
+	FOREIGN KEY (`monitor_id`) REFERENCES `monitor`(`id`) ON UPDATE no action ON DELETE cascade,
+	FOREIGN KEY (`private_location_id`) REFERENCES `private_location`(`id`) ON UPDATE no action ON DELETE cascade
+);
@@ -0,0 +1,15 @@
+CREATE TABLE `monitor_status_policy` (
+	`monitor_id` integer PRIMARY KEY NOT NULL,
+	`failure_threshold` integer DEFAULT 1 NOT NULL,
+	`recovery_threshold` integer DEFAULT 1 NOT NULL,
+	`flap_threshold` integer DEFAULT 0 NOT NULL,
+	`flap_window_seconds` integer DEFAULT 0 NOT NULL,
+	FOREIGN KEY (`monitor_id`) REFERENCES `monitor`(`id`) ON UPDATE no action ON DELETE cascade
+);
+--> statement-breakpoint
+ALTER TABLE `private_location_status` ADD `reported_status` text DEFAULT '' NOT NULL;--> statement-breakpoint
+ALTER TABLE `private_location_status` ADD `consecutive_failures` integer DEFAULT 0 NOT NULL;--> statement-breakpoint
+ALTER TABLE `private_location_status` ADD `consecutive_successes` integer DEFAULT 0 NOT NULL;--> statement-breakpoint
+ALTER TABLE `private_location_status` ADD `flap_count` integer DEFAULT 0 NOT NULL;--> statement-breakpoint
+ALTER TABLE `private_location_status` ADD `flap_window_start` integer DEFAULT 0 NOT NULL;--> statement-breakpoint
+ALTER TABLE `private_location_status` ADD `flapping` integer DEFAULT false NOT NULL;
\ No newline at end of file
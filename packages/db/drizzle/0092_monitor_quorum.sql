@@ -0,0 +1,12 @@
+CREATE TABLE `monitor_quorum_status` (
+	`monitor_id` integer PRIMARY KEY NOT NULL,
+	`status` text NOT NULL,
+	`reported_status` text DEFAULT '' NOT NULL,
+	`changed_at` integer NOT NULL,
+	FOREIGN KEY (`monitor_id`) REFERENCES `monitor`(`id`) ON UPDATE no action ON DELETE cascade
+);
+--> statement-breakpoint
+ALTER TABLE `monitor_status_policy` ADD `quorum` text DEFAULT '' NOT NULL;--> statement-breakpoint
+ALTER TABLE `monitor_status_policy` ADD `quorum_count` integer DEFAULT 0 NOT NULL;--> statement-breakpoint
+ALTER TABLE `monitor_status_policy` ADD `quorum_window_seconds` integer DEFAULT 0 NOT NULL;--> statement-breakpoint
+ALTER TABLE `private_location_status` ADD `observed_at` integer DEFAULT 0 NOT NULL;
\ No newline at end of file
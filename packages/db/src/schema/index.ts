@@ -16,6 +16,8 @@ export * from "./maintenances";
 export * from "./check";
 export * from "./monitor_run";
 export * from "./private_locations";
+export * from "./monitor_heartbeats";
+export * from "./monitor_status_policies";
 export * from "./monitor_groups";
 export * from "./viewers";
 export * from "./api-keys";
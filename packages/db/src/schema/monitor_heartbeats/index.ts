@@ -0,0 +1,2 @@
+export * from "./monitor_heartbeat";
+export * from "./validation";
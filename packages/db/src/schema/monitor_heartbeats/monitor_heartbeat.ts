@@ -0,0 +1,76 @@
+import { relations, sql } from "drizzle-orm";
+import {
+  index,
+  integer,
+  sqliteTable,
+  text,
+  uniqueIndex,
+} from "drizzle-orm/sqlite-core";
+
+import { monitor } from "../monitors";
+
+export const monitorHeartbeatState = ["new", "up", "down"] as const;
+
+export const heartbeatCheckInKind = ["success", "start", "fail"] as const;
+
+// Push monitors (job type `heartbeat`). The private location agent creates
+// the row with its ping token; the grace period can be set from the platform.
+export const monitorHeartbeat = sqliteTable(
+  "monitor_heartbeat",
+  {
+    monitorId: integer("monitor_id")
+      .primaryKey()
+      .references(() => monitor.id, { onDelete: "cascade" }),
+    token: text("token").notNull(),
+    graceSeconds: integer("grace_seconds").default(60).notNull(),
+    state: text("state", { enum: monitorHeartbeatState })
+      .default("new")
+      .notNull(),
+    lastPingAt: integer("last_ping_at", { mode: "timestamp" }),
+    runStartedAt: integer("run_started_at", { mode: "timestamp" }),
+    createdAt: integer("created_at", { mode: "timestamp" })
+      .default(sql`(strftime('%s', 'now'))`)
+      .notNull(),
+  },
+  (table) => [uniqueIndex("monitor_heartbeat_token_idx").on(table.token)],
+);
+
+export const heartbeatCheckIn = sqliteTable(
+  "heartbeat_check_in",
+  {
+    id: text("id").primaryKey(),
+    monitorId: integer("monitor_id")
+      .references(() => monitor.id, { onDelete: "cascade" })
+      .notNull(),
+    kind: text("kind", { enum: heartbeatCheckInKind }).notNull(),
+    durationMs: integer("duration_ms"),
+    message: text("message").default("").notNull(),
+    createdAt: integer("created_at", { mode: "timestamp" }).notNull(),
+  },
+  (table) => [
+    index("heartbeat_check_in_monitor_idx").on(
+      table.monitorId,
+      table.createdAt,
+    ),
+  ],
+);
+
+export const monitorHeartbeatRelations = relations(
+  monitorHeartbeat,
+  ({ one }) => ({
+    monitor: one(monitor, {
+      fields: [monitorHeartbeat.monitorId],
+      references: [monitor.id],
+    }),
+  }),
+);
+
+export const heartbeatCheckInRelations = relations(
+  heartbeatCheckIn,
+  ({ one }) => ({
+    monitor: one(monitor, {
+      fields: [heartbeatCheckIn.monitorId],
+      references: [monitor.id],
+    }),
+  }),
+);
@@ -0,0 +1,52 @@
+import { createInsertSchema, createSelectSchema } from "drizzle-zod";
+import { z } from "zod";
+
+import {
+  heartbeatCheckIn,
+  heartbeatCheckInKind,
+  monitorHeartbeat,
+  monitorHeartbeatState,
+} from "./monitor_heartbeat";
+
+export const monitorHeartbeatStateSchema = z.enum(monitorHeartbeatState);
+
+export const heartbeatCheckInKindSchema = z.enum(heartbeatCheckInKind);
+
+// A day of grace covers the slowest cron jobs without hiding a dead one.
+export const heartbeatGraceSecondsSchema = z.number().int().min(0).max(86400);
+
+export const insertMonitorHeartbeatSchema = createInsertSchema(
+  monitorHeartbeat,
+  {
+    graceSeconds: heartbeatGraceSecondsSchema.prefault(60),
+    state: monitorHeartbeatStateSchema.prefault("new"),
+  },
+);
+
+export const selectMonitorHeartbeatSchema = createSelectSchema(
+  monitorHeartbeat,
+  {
+    state: monitorHeartbeatStateSchema,
+  },
+);
+
+export const updateMonitorHeartbeatSchema = z.object({
+  monitorId: z.number().int(),
+  graceSeconds: heartbeatGraceSecondsSchema,
+});
+
+export const selectHeartbeatCheckInSchema = createSelectSchema(
+  heartbeatCheckIn,
+  {
+    kind: heartbeatCheckInKindSchema,
+  },
+);
+
+export type InsertMonitorHeartbeat = z.infer<
+  typeof insertMonitorHeartbeatSchema
+>;
+export type MonitorHeartbeat = z.infer<typeof selectMonitorHeartbeatSchema>;
+export type UpdateMonitorHeartbeat = z.infer<
+  typeof updateMonitorHeartbeatSchema
+>;
+export type HeartbeatCheckIn = z.infer<typeof selectHeartbeatCheckInSchema>;
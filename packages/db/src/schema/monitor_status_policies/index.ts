@@ -0,0 +1,2 @@
+export * from "./monitor_status_policy";
+export * from "./validation";
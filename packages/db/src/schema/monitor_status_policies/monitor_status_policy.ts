@@ -0,0 +1,56 @@
+import { relations } from "drizzle-orm";
+import { integer, sqliteTable, text } from "drizzle-orm/sqlite-core";
+
+import { monitor } from "../monitors";
+
+export const monitorQuorum = ["any", "majority", "all", "n_of_m"] as const;
+
+// When check results change the status of a monitor. Monitors without a row
+// change their status on the first check that differs from it.
+export const monitorStatusPolicy = sqliteTable("monitor_status_policy", {
+  monitorId: integer("monitor_id")
+    .primaryKey()
+    .references(() => monitor.id, { onDelete: "cascade" }),
+  failureThreshold: integer("failure_threshold").default(1).notNull(),
+  recoveryThreshold: integer("recovery_threshold").default(1).notNull(),
+  // More than flapThreshold status changes within the window mark the
+  // monitor flapping. 0 disables flap detection.
+  flapThreshold: integer("flap_threshold").default(0).notNull(),
+  flapWindowSeconds: integer("flap_window_seconds").default(0).notNull(),
+  // How many private locations have to agree on the status. Empty reports
+  // every location on its own; quorumCount is only used by n_of_m.
+  quorum: text("quorum").default("").notNull(),
+  quorumCount: integer("quorum_count").default(0).notNull(),
+  quorumWindowSeconds: integer("quorum_window_seconds").default(0).notNull(),
+});
+
+// The consensus of the quorum, and the one workflows was last told about.
+export const monitorQuorumStatus = sqliteTable("monitor_quorum_status", {
+  monitorId: integer("monitor_id")
+    .primaryKey()
+    .references(() => monitor.id, { onDelete: "cascade" }),
+  status: text("status").notNull(),
+  reportedStatus: text("reported_status").default("").notNull(),
+  // Unix milliseconds.
+  changedAt: integer("changed_at").notNull(),
+});
+
+export const monitorStatusPolicyRelations = relations(
+  monitorStatusPolicy,
+  ({ one }) => ({
+    monitor: one(monitor, {
+      fields: [monitorStatusPolicy.monitorId],
+      references: [monitor.id],
+    }),
+  }),
+);
+
+export const monitorQuorumStatusRelations = relations(
+  monitorQuorumStatus,
+  ({ one }) => ({
+    monitor: one(monitor, {
+      fields: [monitorQuorumStatus.monitorId],
+      references: [monitor.id],
+    }),
+  }),
+);
@@ -0,0 +1,75 @@
+import { createInsertSchema, createSelectSchema } from "drizzle-zod";
+import { z } from "zod";
+
+import {
+  monitorQuorum,
+  monitorQuorumStatus,
+  monitorStatusPolicy,
+} from "./monitor_status_policy";
+
+export const statusPolicyThresholdSchema = z.number().int().min(1).max(100);
+
+// A day covers the slowest monitors without keeping one flapping forever.
+export const flapWindowSecondsSchema = z.number().int().min(0).max(86400);
+
+// Empty reports every private location on its own.
+export const monitorQuorumSchema = z.enum(monitorQuorum).or(z.literal(""));
+
+export const quorumWindowSecondsSchema = z.number().int().min(0).max(86400);
+
+export const insertMonitorStatusPolicySchema = createInsertSchema(
+  monitorStatusPolicy,
+  {
+    failureThreshold: statusPolicyThresholdSchema.prefault(1),
+    recoveryThreshold: statusPolicyThresholdSchema.prefault(1),
+    flapThreshold: z.number().int().min(0).max(100).prefault(0),
+    flapWindowSeconds: flapWindowSecondsSchema.prefault(0),
+    quorum: monitorQuorumSchema.prefault(""),
+    quorumCount: z.number().int().min(0).prefault(0),
+    quorumWindowSeconds: quorumWindowSecondsSchema.prefault(0),
+  },
+);
+
+export const selectMonitorStatusPolicySchema = createSelectSchema(
+  monitorStatusPolicy,
+  {
+    quorum: monitorQuorumSchema,
+  },
+);
+
+export const updateMonitorStatusPolicySchema = z.object({
+  monitorId: z.number().int(),
+  failureThreshold: statusPolicyThresholdSchema,
+  recoveryThreshold: statusPolicyThresholdSchema,
+  flapThreshold: z.number().int().min(0).max(100),
+  flapWindowSeconds: flapWindowSecondsSchema,
+});
+
+export const updateMonitorQuorumSchema = z
+  .object({
+    monitorId: z.number().int(),
+    quorum: monitorQuorumSchema,
+    quorumCount: z.number().int().min(0),
+    quorumWindowSeconds: quorumWindowSecondsSchema,
+  })
+  .refine((value) => value.quorum !== "n_of_m" || value.quorumCount >= 1, {
+    message: "n_of_m needs a quorum count of at least 1",
+    path: ["quorumCount"],
+  });
+
+export const selectMonitorQuorumStatusSchema =
+  createSelectSchema(monitorQuorumStatus);
+
+export type InsertMonitorStatusPolicy = z.infer<
+  typeof insertMonitorStatusPolicySchema
+>;
+export type MonitorStatusPolicy = z.infer<
+  typeof selectMonitorStatusPolicySchema
+>;
+export type UpdateMonitorStatusPolicy = z.infer<
+  typeof updateMonitorStatusPolicySchema
+>;
+export type UpdateMonitorQuorum = z.infer<typeof updateMonitorQuorumSchema>;
+export type MonitorQuorumStatus = z.infer<
+  typeof selectMonitorQuorumStatusSchema
+>;
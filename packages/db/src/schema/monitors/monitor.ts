@@ -48,6 +48,9 @@ export const monitor = sqliteTable(
 
     otelHeaders: text("otel_headers"),
 
+    // Export every check as a trace with phase spans to the otel endpoint
+    otelTraces: integer("otel_traces", { mode: "boolean" }).default(false),
+
     public: integer("public", { mode: "boolean" }).default(false),
 
     retry: integer("retry").default(3),
@@ -56,6 +59,16 @@ export const monitor = sqliteTable(
       true,
     ),
 
+    // JSON auth block of HTTP monitors, e.g. OAuth2 client credentials
+    auth: text("auth"),
+
+    // JSON credentials of protocol monitors (password, SSH private key and
+    // passphrase), kept out of the url and body
+    secret: text("secret"),
+
+    // RDAP server of domain monitors, instead of the IANA bootstrap one
+    rdapBaseUrl: text("rdap_base_url"),
+
     createdAt: integer("created_at", { mode: "timestamp" }).default(
       sql`(strftime('%s', 'now'))`,
     ),
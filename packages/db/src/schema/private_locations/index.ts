@@ -1,3 +1,5 @@
 export * from "./private_location_monitor_status";
+export * from "./private_location_status";
 export * from "./private_locations";
 export * from "./validation";
+export * from "./workflow_outbox";
@@ -0,0 +1,60 @@
+import { relations } from "drizzle-orm";
+import {
+  integer,
+  primaryKey,
+  sqliteTable,
+  text,
+} from "drizzle-orm/sqlite-core";
+
+import { monitor } from "../monitors";
+import { privateLocation } from "./private_locations";
+
+// The status the private location server last decided for a monitor at each
+// of its private locations. Only transitions are forwarded to workflows.
+export const privateLocationStatus = sqliteTable(
+  "private_location_status",
+  {
+    monitorId: integer("monitor_id")
+      .references(() => monitor.id, { onDelete: "cascade" })
+      .notNull(),
+    privateLocationId: integer("private_location_id")
+      .references(() => privateLocation.id, { onDelete: "cascade" })
+      .notNull(),
+    status: text("status").notNull(),
+    previousStatus: text("previous_status").default("").notNull(),
+    // Unix milliseconds.
+    changedAt: integer("changed_at").notNull(),
+    // The status workflows was last told about, and what the monitor's
+    // status policy remembers between checks.
+    reportedStatus: text("reported_status").default("").notNull(),
+    consecutiveFailures: integer("consecutive_failures").default(0).notNull(),
+    consecutiveSuccesses: integer("consecutive_successes")
+      .default(0)
+      .notNull(),
+    flapCount: integer("flap_count").default(0).notNull(),
+    // Unix milliseconds.
+    flapWindowStart: integer("flap_window_start").default(0).notNull(),
+    flapping: integer("flapping", { mode: "boolean" })
+      .default(false)
+      .notNull(),
+    // Unix milliseconds of the last check, for the quorum window.
+    observedAt: integer("observed_at").default(0).notNull(),
+  },
+  (table) => [
+    primaryKey({ columns: [table.monitorId, table.privateLocationId] }),
+  ],
+);
+
+export const privateLocationStatusRelations = relations(
+  privateLocationStatus,
+  ({ one }) => ({
+    monitor: one(monitor, {
+      fields: [privateLocationStatus.monitorId],
+      references: [monitor.id],
+    }),
+    privateLocation: one(privateLocation, {
+      fields: [privateLocationStatus.privateLocationId],
+      references: [privateLocation.id],
+    }),
+  }),
+);
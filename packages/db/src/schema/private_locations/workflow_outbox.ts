@@ -0,0 +1,21 @@
+import { index, integer, sqliteTable, text } from "drizzle-orm/sqlite-core";
+
+// Status updates the private location server still has to forward to the
+// workflows service. Rows are claimed before they are sent so that several
+// instances can share the table.
+export const workflowOutbox = sqliteTable(
+  "workflow_outbox",
+  {
+    id: integer("id").primaryKey({ autoIncrement: true }),
+    monitorId: text("monitor_id").notNull(),
+    payload: text("payload").notNull(),
+    attempts: integer("attempts").default(0).notNull(),
+    nextAttemptAt: integer("next_attempt_at").notNull(),
+    claimedUntil: integer("claimed_until").default(0).notNull(),
+    lastError: text("last_error").default("").notNull(),
+    createdAt: integer("created_at").notNull(),
+  },
+  (table) => [
+    index("workflow_outbox_monitor_idx").on(table.monitorId, table.id),
+  ],
+);
@@ -27,7 +27,9 @@ export {
 } from "./list";
 export {
   updateMonitorNotifiers,
+  updateMonitorQuorum,
   updateMonitorSchedulingRegions,
+  updateMonitorStatusPolicy,
   updateMonitorTags,
 } from "./relations";
 export { redactSensitiveHeaders } from "./response-logs-internal";
@@ -76,8 +78,10 @@ export {
   UpdateMonitorNotifiersInput,
   UpdateMonitorOtelInput,
   UpdateMonitorPublicInput,
+  UpdateMonitorQuorumInput,
   UpdateMonitorResponseTimeInput,
   UpdateMonitorRetryInput,
   UpdateMonitorSchedulingRegionsInput,
+  UpdateMonitorStatusPolicyInput,
   UpdateMonitorTagsInput,
 } from "./schemas";
@@ -1,6 +1,7 @@
 import { eq } from "@openstatus/db";
 import {
   monitor,
+  monitorStatusPolicy,
   monitorTagsToMonitors,
   notificationsToMonitors,
   privateLocationToMonitors,
@@ -18,7 +19,9 @@ import {
 } from "./internal";
 import {
   UpdateMonitorNotifiersInput,
+  UpdateMonitorQuorumInput,
   UpdateMonitorSchedulingRegionsInput,
+  UpdateMonitorStatusPolicyInput,
   UpdateMonitorTagsInput,
 } from "./schemas";
 
@@ -207,3 +210,98 @@ export async function updateMonitorNotifiers(args: {
     });
   });
 }
+
+/**
+ * Set when check results change the monitor's status. The policy lives in
+ * its own table so that monitors without one keep the default behaviour.
+ */
+export async function updateMonitorStatusPolicy(args: {
+  ctx: ServiceContext;
+  input: UpdateMonitorStatusPolicyInput;
+}): Promise<void> {
+  const { ctx } = args;
+  requireScope(ctx, "write");
+  const input = UpdateMonitorStatusPolicyInput.parse(args.input);
+
+  await withTransaction(ctx, async (tx) => {
+    const existing = await getMonitorInWorkspace({
+      tx,
+      id: input.id,
+      workspaceId: ctx.workspace.id,
+    });
+
+    const before = await tx
+      .select()
+      .from(monitorStatusPolicy)
+      .where(eq(monitorStatusPolicy.monitorId, existing.id))
+      .get();
+
+    const values = {
+      failureThreshold: input.failureThreshold,
+      recoveryThreshold: input.recoveryThreshold,
+      flapThreshold: input.flapThreshold,
+      flapWindowSeconds: input.flapWindowSeconds,
+    };
+    const after = await tx
+      .insert(monitorStatusPolicy)
+      .values({ monitorId: existing.id, ...values })
+      .onConflictDoUpdate({ target: monitorStatusPolicy.monitorId, set: values })
+      .returning()
+      .get();
+
+    await emitAudit(tx, ctx, {
+      action: "monitor.update",
+      entityType: "monitor",
+      entityId: existing.id,
+      before: { statusPolicy: before ?? null },
+      after: { statusPolicy: after },
+    });
+  });
+}
+
+/**
+ * Set how many of the monitor's private locations have to agree on its
+ * status. An empty quorum reports every location on its own.
+ */
+export async function updateMonitorQuorum(args: {
+  ctx: ServiceContext;
+  input: UpdateMonitorQuorumInput;
+}): Promise<void> {
+  const { ctx } = args;
+  requireScope(ctx, "write");
+  const input = UpdateMonitorQuorumInput.parse(args.input);
+
+  await withTransaction(ctx, async (tx) => {
+    const existing = await getMonitorInWorkspace({
+      tx,
+      id: input.id,
+      workspaceId: ctx.workspace.id,
+    });
+
+    const before = await tx
+      .select()
+      .from(monitorStatusPolicy)
+      .where(eq(monitorStatusPolicy.monitorId, existing.id))
+      .get();
+
+    const values = {
+      quorum: input.quorum,
+      quorumCount: input.quorumCount,
+      quorumWindowSeconds: input.quorumWindowSeconds,
+    };
+    const after = await tx
+      .insert(monitorStatusPolicy)
+      .values({ monitorId: existing.id, ...values })
+      .onConflictDoUpdate({ target: monitorStatusPolicy.monitorId, set: values })
+      .returning()
+      .get();
+
+    await emitAudit(tx, ctx, {
+      action: "monitor.update",
+      entityType: "monitor",
+      entityId: existing.id,
+      before: { statusPolicy: before ?? null },
+      after: { statusPolicy: after },
+    });
+  });
+}
@@ -10,6 +10,12 @@ import {
   monitorJobTypes,
   monitorMethods,
 } from "@openstatus/db/src/schema/monitors/constants";
+import {
+  flapWindowSecondsSchema,
+  monitorQuorumSchema,
+  quorumWindowSecondsSchema,
+  statusPolicyThresholdSchema,
+} from "@openstatus/db/src/schema/monitor_status_policies/validation";
 import { z } from "zod";
 
 export { monitorJobTypes, monitorMethods, monitorPeriodicity };
@@ -160,6 +166,30 @@ export const UpdateMonitorTagsInput = z.object({
 });
 export type UpdateMonitorTagsInput = z.infer<typeof UpdateMonitorTagsInput>;
 
+export const UpdateMonitorStatusPolicyInput = z.object({
+  id: z.number().int(),
+  failureThreshold: statusPolicyThresholdSchema,
+  recoveryThreshold: statusPolicyThresholdSchema,
+  flapThreshold: z.number().int().min(0).max(100),
+  flapWindowSeconds: flapWindowSecondsSchema,
+});
+export type UpdateMonitorStatusPolicyInput = z.infer<
+  typeof UpdateMonitorStatusPolicyInput
+>;
+
+export const UpdateMonitorQuorumInput = z
+  .object({
+    id: z.number().int(),
+    quorum: monitorQuorumSchema,
+    quorumCount: z.number().int().min(0),
+    quorumWindowSeconds: quorumWindowSecondsSchema,
+  })
+  .refine((value) => value.quorum !== "n_of_m" || value.quorumCount >= 1, {
+    message: "n_of_m needs a quorum count of at least 1",
+    path: ["quorumCount"],
+  });
+export type UpdateMonitorQuorumInput = z.infer<typeof UpdateMonitorQuorumInput>;
+
 export const UpdateMonitorNotifiersInput = z.object({
   id: z.number().int(),
   notifiers: z.array(z.number().int()),
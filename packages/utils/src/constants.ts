@@ -19,4 +19,16 @@ export const MONITOR_JOB_TYPES = [
   "udp",
   "dns",
   "ssl",
+  "graphql",
+  "heartbeat",
+  "postgres",
+  "mysql",
+  "redis",
+  "smtp",
+  "imap",
+  "pop3",
+  "ssh",
+  "mqtt",
+  "ntp",
+  "domain",
 ] as const;
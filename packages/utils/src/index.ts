@@ -2,6 +2,8 @@ export { assertSafeUrl, assertSafeUrlSync, safeUrlSchema } from "./ssrf";
 export {
   httpPayloadSchema,
   type HttpPayload,
+  graphqlPayloadSchema,
+  type GraphQLPayload,
   tpcPayloadSchema,
   type TcpPayload,
   DNSPayloadSchema,
@@ -3,6 +3,45 @@ import { z } from "zod";
 
 import { MONITOR_METHODS, MONITOR_STATUSES } from "./constants";
 
+// When check results change the monitor's status, see monitor_status_policy.
+// flapWindow is in milliseconds.
+const statusPolicyPayload = {
+  failureThreshold: z.number().optional(),
+  recoveryThreshold: z.number().optional(),
+  flapThreshold: z.number().optional(),
+  flapWindow: z.number().optional(),
+};
+
+// The monitor.auth block, sent as is to the checker.
+const httpAuthPayloadSchema = z.object({
+  oauth2: z
+    .object({
+      tokenUrl: z.string(),
+      clientId: z.string(),
+      clientSecret: z.string(),
+      scopes: z.array(z.string()).optional(),
+      audience: z.string().optional(),
+    })
+    .optional(),
+  hmac: z
+    .object({
+      secret: z.string(),
+      algorithm: z.enum(["sha256", "sha512"]).optional(),
+      signatureHeader: z.string().optional(),
+      timestampHeader: z.string().optional(),
+    })
+    .optional(),
+  sigv4: z
+    .object({
+      accessKeyId: z.string(),
+      secretAccessKey: z.string(),
+      sessionToken: z.string().optional(),
+      region: z.string(),
+      service: z.string().optional(),
+    })
+    .optional(),
+});
+
 export const httpPayloadSchema = z.object({
   workspaceId: z.string(),
   monitorId: z.string(),
@@ -20,14 +59,27 @@ export const httpPayloadSchema = z.object({
     .object({
       endpoint: z.string(),
       headers: z.record(z.string(), z.string()),
+      traces: z.boolean().optional(),
     })
     .optional(),
   retry: z.number().prefault(3),
   followRedirects: z.boolean().prefault(true),
+  auth: httpAuthPayloadSchema.optional(),
+  ...statusPolicyPayload,
 });
 
 export type HttpPayload = z.infer<typeof httpPayloadSchema>;
 
+// GraphQL monitors store the {query, variables, operationName} envelope in
+// their body; the checker always sends it as a JSON POST.
+export const graphqlPayloadSchema = httpPayloadSchema.extend({
+  query: z.string(),
+  variables: z.record(z.string(), z.unknown()).optional().nullable(),
+  operationName: z.string().optional(),
+});
+
+export type GraphQLPayload = z.infer<typeof graphqlPayloadSchema>;
+
 export const tpcPayloadSchema = z.object({
   status: z.enum(MONITOR_STATUSES),
   workspaceId: z.string(),
@@ -45,6 +97,7 @@ export const tpcPayloadSchema = z.object({
     })
     .optional(),
   retry: z.number().prefault(3),
+  ...statusPolicyPayload,
 });
 
 export type TcpPayload = z.infer<typeof tpcPayloadSchema>;
@@ -66,6 +119,7 @@ export const DNSPayloadSchema = z.object({
     })
     .optional(),
   retry: z.number().prefault(3),
+  ...statusPolicyPayload,
 });
 
 export type DNSPayload = z.infer<typeof DNSPayloadSchema>;